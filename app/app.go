@@ -724,7 +724,11 @@ func NewChainApp(
 		appCodec,
 		runtime.NewKVStoreService(keys[sourcehubtypes.StoreKey]),
 		app.ICAControllerKeeper,
+		app.BankKeeper,
+		app.DistrKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	).SetHooks(
+	// register the sourcehub hooks
 	)
 
 	// NOTE: we are adding all available EVM extensions.
@@ -762,8 +766,9 @@ func NewChainApp(
 	var icaControllerStack porttypes.IBCModule
 	// integration point for custom authentication modules
 	// see https://medium.com/the-interchain-foundation/ibc-go-v6-changes-to-interchain-accounts-and-how-it-impacts-your-chain-806c185300d7
-	// var noAuthzModule porttypes.IBCModule
-	icaControllerStack = icacontroller.NewIBCMiddleware(app.ICAControllerKeeper)
+	// the sourcehub module is the authentication module, tracking acks and timeouts of its ICA packets
+	icaControllerStack = sourcehub.NewIBCModule(app.SourcehubKeeper)
+	icaControllerStack = icacontroller.NewIBCMiddlewareWithAuth(icaControllerStack, app.ICAControllerKeeper)
 	// icaControllerStack = ibcfee.NewIBCMiddleware(icaControllerStack, app.IBCFeeKeeper)
 
 	// RecvPacket, message that originates from core IBC and goes down to app, the flow is:
@@ -777,6 +782,8 @@ func NewChainApp(
 	ibcRouter.AddRoute(ibctransfertypes.ModuleName, transferStack)
 	ibcRouter.AddRoute(icacontrollertypes.SubModuleName, icaControllerStack)
 	ibcRouter.AddRoute(icahosttypes.SubModuleName, icaHostStack)
+	// Outpost contracts on other chains report stream access payments on the outpost port
+	ibcRouter.AddRoute(sourcehubtypes.OutpostPortID, sourcehub.NewOutpostIBCModule(app.SourcehubKeeper, app.IBCKeeper.ChannelKeeper))
 	app.IBCKeeper.SetRouter(ibcRouter)
 
 	clientKeeper := app.IBCKeeper.ClientKeeper
@@ -951,6 +958,9 @@ func NewChainApp(
 	// app.ModuleManager.SetOrderMigrations(custom order)
 
 	app.ModuleManager.RegisterInvariants(app.CrisisKeeper)
+	// The module manager no longer registers invariants with the crisis
+	// keeper, so the sourcehub ones are registered directly.
+	sourcehubkeeper.RegisterInvariants(app.CrisisKeeper, app.SourcehubKeeper)
 	app.configurator = module.NewConfigurator(app.appCodec, app.MsgServiceRouter(), app.GRPCQueryRouter())
 	err = app.ModuleManager.RegisterServices(app.configurator)
 	if err != nil {
@@ -15,7 +15,7 @@ EntityRegistryI constant ENTITY_REGISTRY_CONTRACT =
 ///      - Verifying `peerKeyPubkey` / `peerKeySignature`.
 ///      - Verifying `nodeIdentityKeyPubkey` / `nodeIdentityKeySignature`.
 ///      - Both signatures are over the same domain-separated payload:
-///        (chain, precompile, msg.sender, entity, message, etc.).
+///        a RegistrationMessage (domain, chain ID, msg.sender, entity, nonce, expiry height).
 ///      - Enforcing one-address-one-DID and any entity-specific rules.
 interface EntityRegistryI {
     /// @notice Register an entity for msg.sender using two key proofs.
@@ -25,8 +25,8 @@ interface EntityRegistryI {
     ///  - `nodeIdentityKeyPubkey` / `nodeIdentityKeySignature`:
     ///        DefraDB node-identity-key.
     ///  - `message`:
-    ///        A 32-byte value used for domain separation and replay protection.
-    ///        The keeper defines and validates the exact payload that is signed.
+    ///        A protobuf encoded RegistrationMessage binding the chain ID, msg.sender,
+    ///        `entity`, a nonce and an expiry height. Each message is accepted once.
     /// @param peerKeyPubkey            Peer key public key bytes.
     /// @param peerKeySignature         Signature by `peerKeyPubkey` over the keeper-defined payload.
     /// @param nodeIdentityKeyPubkey    Node identity key public key bytes.
@@ -42,6 +42,35 @@ interface EntityRegistryI {
         uint8 entity
     ) external;
 
+    /// @notice Unregister the entity msg.sender registered for `entity`.
+    /// @dev Removes the DID/PID records and the SourceHub group membership.
+    /// @param entity Entity tag (e.g. 0 = indexer, 1 = host).
+    function unregister(uint8 entity) external;
+
+    /// @notice Rotate the peer and node identity keys of msg.sender's entity.
+    /// @dev
+    ///  - The old keys, as recorded at registration, and the new keys all sign `message`.
+    ///  - `message` is a fresh RegistrationMessage, as for `register`.
+    ///  - When the DID changes, the SourceHub group membership moves to the new DID.
+    /// @param oldPeerKeySignature         Signature by the registered peer key over `message`.
+    /// @param oldNodeIdentityKeySignature Signature by the registered node identity key over `message`.
+    /// @param newPeerKeyPubkey            New peer key public key bytes.
+    /// @param newPeerKeySignature         Signature by `newPeerKeyPubkey` over `message`.
+    /// @param newNodeIdentityKeyPubkey    New node identity key public key bytes.
+    /// @param newNodeIdentityKeySignature Signature by `newNodeIdentityKeyPubkey` over `message`.
+    /// @param message                     message for replay protection / domain separation.
+    /// @param entity                      Entity tag (e.g. 0 = indexer, 1 = host).
+    function rotateKeys(
+        bytes calldata oldPeerKeySignature,
+        bytes calldata oldNodeIdentityKeySignature,
+        bytes calldata newPeerKeyPubkey,
+        bytes calldata newPeerKeySignature,
+        bytes calldata newNodeIdentityKeyPubkey,
+        bytes calldata newNodeIdentityKeySignature,
+        bytes calldata message,
+        uint8 entity
+    ) external;
+
     /// @notice Emitted when a DID/PID is registered for an owner.
     /// @dev
     ///  - `key` = keccak256(abi.encodePacked(owner, did))
@@ -59,4 +88,32 @@ interface EntityRegistryI {
         bytes pid,
         uint8 entity
     );
+
+    /// @notice Emitted when an owner unregisters an entity.
+    /// @param key     keccak256(abi.encodePacked(owner, did)).
+    /// @param owner   Address that unregistered the entity.
+    /// @param did     The DID bytes that were removed.
+    /// @param entity  Entity tag (e.g. 0 = indexer, 1 = host).
+    event EntityUnregistered(
+        bytes32 indexed key,
+        address indexed owner,
+        bytes did,
+        uint8 entity
+    );
+
+    /// @notice Emitted when an owner rotates the keys of an entity.
+    /// @param key     keccak256(abi.encodePacked(owner, did)) of the new DID.
+    /// @param owner   Address that owns the entity.
+    /// @param oldDid  The DID bytes before the rotation.
+    /// @param did     The DID bytes after the rotation.
+    /// @param pid     The Peer ID bytes after the rotation.
+    /// @param entity  Entity tag (e.g. 0 = indexer, 1 = host).
+    event EntityKeysRotated(
+        bytes32 indexed key,
+        address indexed owner,
+        bytes oldDid,
+        bytes did,
+        bytes pid,
+        uint8 entity
+    );
 }
@@ -67,6 +67,10 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 		return cmn.ReturnRevertError(evm, err)
 	}
 
+	// Registering locks the entity bond, so track the native balance changes
+	// made through the bank keeper.
+	p.GetBalanceHandler().BeforeBalanceChange(ctx)
+
 	// This handles any out of gas errors that may occur during the execution of a precompile tx or query.
 	// It avoids panics and returns the out of gas error so the EVM can continue gracefully.
 	defer cmn.HandleGasError(ctx, contract, initialGas, &err)()
@@ -82,12 +86,16 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 		return nil, vm.ErrOutOfGas
 	}
 
+	if err = p.GetBalanceHandler().AfterBalanceChange(ctx, stateDB); err != nil {
+		return cmn.ReturnRevertError(evm, err)
+	}
+
 	return bz, nil
 }
 
 func (Precompile) IsTransaction(method *abi.Method) bool {
 	switch method.Name {
-	case EntityRegistryRegisterMethod:
+	case EntityRegistryRegisterMethod, EntityRegistryUnregisterMethod, EntityRegistryRotateKeysMethod:
 		return true
 	default:
 		return false
@@ -105,6 +113,10 @@ func (p *Precompile) HandleMethod(
 	switch method.Name {
 	case EntityRegistryRegisterMethod:
 		bz, err = p.EntityRegistryRegister(ctx, contract, stateDB, method, args)
+	case EntityRegistryUnregisterMethod:
+		bz, err = p.EntityRegistryUnregister(ctx, contract, stateDB, method, args)
+	case EntityRegistryRotateKeysMethod:
+		bz, err = p.EntityRegistryRotateKeys(ctx, contract, stateDB, method, args)
 	default:
 		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
 	}
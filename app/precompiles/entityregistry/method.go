@@ -9,10 +9,14 @@ import (
 	gethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
+
+	sourcehubtypes "github.com/shinzonetwork/shinzohub/x/sourcehub/types"
 )
 
 const (
-	EntityRegistryRegisterMethod = "register"
+	EntityRegistryRegisterMethod   = "register"
+	EntityRegistryUnregisterMethod = "unregister"
+	EntityRegistryRotateKeysMethod = "rotateKeys"
 )
 
 func (p Precompile) EntityRegistryRegister(
@@ -90,16 +94,159 @@ func (p Precompile) EntityRegistryRegister(
 		Data: data,
 	})
 
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			"EntityRegistered",
-			sdk.NewAttribute("key", key.Hex()),
-			sdk.NewAttribute("owner", sdk.AccAddress(caller).String()),
-			sdk.NewAttribute("did", string(did)),
-			sdk.NewAttribute("pid", string(pid)),
-			sdk.NewAttribute("entity", string(entity)),
-		),
+	if err := ctx.EventManager().EmitTypedEvent(&sourcehubtypes.EventEntityRegistered{
+		Key:   key.Hex(),
+		Owner: sdk.AccAddress(caller).String(),
+		Did:   string(did),
+		Pid:   string(pid),
+		Role:  sourcehubtypes.EntityRole(entity),
+	}); err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+func (p Precompile) EntityRegistryUnregister(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	_ *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	entity, ok := args[0].(uint8)
+	if !ok {
+		return nil, fmt.Errorf("invalid entity")
+	}
+
+	caller := contract.Caller().Bytes()
+
+	did, err := p.sourcehubKeeper.UnregisterEntity(ctx, entity, caller)
+	if err != nil {
+		return nil, err
+	}
+
+	key := crypto.Keccak256Hash(caller, did)
+
+	// topic0 = keccak256("EntityUnregistered(bytes32,address,bytes,uint8)")
+	topic0 := crypto.Keccak256Hash([]byte("EntityUnregistered(bytes32,address,bytes,uint8)"))
+
+	event := p.ABI.Events["EntityUnregistered"]
+
+	data, packErr := event.Inputs.NonIndexed().Pack(did, entity)
+	if packErr != nil {
+		return nil, fmt.Errorf("failed to pack EntityUnregistered event: %w", packErr)
+	}
+
+	stateDB.AddLog(&gethtypes.Log{
+		Address: contract.Address(),
+		Topics: []common.Hash{
+			topic0,
+			key,
+			common.BytesToHash(caller),
+		},
+		Data: data,
+	})
+
+	if err := ctx.EventManager().EmitTypedEvent(&sourcehubtypes.EventEntityUnregistered{
+		Key:   key.Hex(),
+		Owner: sdk.AccAddress(caller).String(),
+		Did:   string(did),
+		Role:  sourcehubtypes.EntityRole(entity),
+	}); err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+func (p Precompile) EntityRegistryRotateKeys(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	_ *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	names := []string{
+		"oldPeerKeySignature",
+		"oldNodeIdentityKeySignature",
+		"newPeerKeyPubkey",
+		"newPeerKeySignature",
+		"newNodeIdentityKeyPubkey",
+		"newNodeIdentityKeySignature",
+		"message",
+	}
+
+	byteArgs := make([][]byte, len(names))
+	for i, name := range names {
+		bz, ok := args[i].([]byte)
+		if !ok || len(bz) == 0 {
+			return nil, fmt.Errorf("invalid %s", name)
+		}
+		byteArgs[i] = bz
+	}
+
+	entity, ok := args[7].(uint8)
+	if !ok {
+		return nil, fmt.Errorf("invalid entity")
+	}
+
+	caller := contract.Caller().Bytes()
+
+	previous, found := p.sourcehubKeeper.GetEntity(ctx, caller, sourcehubtypes.EntityRole(entity))
+	if !found {
+		return nil, fmt.Errorf("no entity registered for caller")
+	}
+	oldDid := []byte(previous.Did)
+
+	did, pid, err := p.sourcehubKeeper.RotateEntityKeys(
+		ctx,
+		byteArgs[0],
+		byteArgs[1],
+		byteArgs[2],
+		byteArgs[3],
+		byteArgs[4],
+		byteArgs[5],
+		byteArgs[6],
+		entity,
+		caller,
 	)
+	if err != nil {
+		return nil, err
+	}
+
+	key := crypto.Keccak256Hash(caller, did)
+
+	// topic0 = keccak256("EntityKeysRotated(bytes32,address,bytes,bytes,bytes,uint8)")
+	topic0 := crypto.Keccak256Hash([]byte("EntityKeysRotated(bytes32,address,bytes,bytes,bytes,uint8)"))
+
+	event := p.ABI.Events["EntityKeysRotated"]
+
+	data, packErr := event.Inputs.NonIndexed().Pack(oldDid, did, pid, entity)
+	if packErr != nil {
+		return nil, fmt.Errorf("failed to pack EntityKeysRotated event: %w", packErr)
+	}
+
+	stateDB.AddLog(&gethtypes.Log{
+		Address: contract.Address(),
+		Topics: []common.Hash{
+			topic0,
+			key,
+			common.BytesToHash(caller),
+		},
+		Data: data,
+	})
+
+	if err := ctx.EventManager().EmitTypedEvent(&sourcehubtypes.EventEntityKeysRotated{
+		Key:    key.Hex(),
+		Owner:  sdk.AccAddress(caller).String(),
+		OldDid: string(oldDid),
+		Did:    string(did),
+		Pid:    string(pid),
+		Role:   sourcehubtypes.EntityRole(entity),
+	}); err != nil {
+		return nil, err
+	}
 
 	return nil, nil
 }
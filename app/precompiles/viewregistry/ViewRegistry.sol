@@ -17,11 +17,24 @@ interface ViewRegistryI {
     /// @param value The blob to store.
     function register(bytes calldata value) external;
 
+    /// @notice Registers a value in the ViewRegistry on a given SourceHub target.
+    /// @dev A view belongs to a single target, registering it on another one reverts.
+    /// @param value The blob to store.
+    /// @param target The chain ID of the SourceHub target, the default target if empty.
+    function register(bytes calldata value, string calldata target) external;
+
     /// @notice Retrieves a stored value using its key.
     /// @param key The key used to store the value (typically keccak256(sender, value)).
     /// @return result The stored blob.
     function get(bytes32 key) external view returns (bytes memory result);
 
+    /// @notice Reports whether SourceHub confirmed a DID as a subscriber of a view.
+    /// @dev Answered from the relationships ShinzoHub mirrors, without querying SourceHub.
+    /// @param objectId The view ID, as emitted on registration.
+    /// @param did The DID of the subscriber.
+    /// @return subscribed True if the subscription is confirmed.
+    function isSubscriber(string calldata objectId, string calldata did) external view returns (bool subscribed);
+
     /// @notice Emitted when a value is registered.
     /// @param key The derived key of the stored value.
     /// @param sender The address that called register().
@@ -12,11 +12,14 @@ import (
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/shinzonetwork/viewbundle-go"
+
+	sourcehubtypes "github.com/shinzonetwork/shinzohub/x/sourcehub/types"
 )
 
 const (
-	ViewRegistryRegisterMethod = "register"
-	ViewRegistryGetMethod      = "get"
+	ViewRegistryRegisterMethod     = "register"
+	ViewRegistryGetMethod          = "get"
+	ViewRegistryIsSubscriberMethod = "isSubscriber"
 )
 
 func (p Precompile) ViewRegistryRegister(
@@ -40,6 +43,16 @@ func (p Precompile) ViewRegistryRegister(
 		return nil, fmt.Errorf("invalid type for value")
 	}
 
+	// register(bytes,string) names the SourceHub target, register(bytes) uses
+	// the default one.
+	var target string
+	if len(args) > 1 {
+		if target, ok = args[1].(string); !ok {
+			log.Error("invalid arg type for target", "got", fmt.Sprintf("%T", args[1]))
+			return nil, fmt.Errorf("invalid type for target")
+		}
+	}
+
 	// Don’t log bytes, log size + hash.
 	log.Info("register received", "bytes", len(encodedValue), "hash", crypto.Keccak256Hash(encodedValue).Hex())
 
@@ -75,8 +88,8 @@ func (p Precompile) ViewRegistryRegister(
 	}
 
 	// Keeper call
-	if err := p.sourcehubKeeper.RegisterObject(ctx, id); err != nil {
-		log.Error("RegisterObject failed", "err", err, "id", id)
+	if err := p.sourcehubKeeper.RegisterObject(ctx, target, id, contract.Caller().Bytes(), decodedValue.Header.Query); err != nil {
+		log.Error("RegisterObject failed", "err", err, "id", id, "target", target)
 		return nil, vm.ErrExecutionReverted
 	}
 
@@ -97,14 +110,15 @@ func (p Precompile) ViewRegistryRegister(
 		Data:    newEncodedValue,
 	})
 
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			"Registered",
-			sdk.NewAttribute("key", key.Hex()),
-			sdk.NewAttribute("creator", sdk.AccAddress(contract.Caller().Bytes()).String()),
-			sdk.NewAttribute("view", base64.StdEncoding.EncodeToString(newEncodedValue)),
-		),
-	)
+	if err := ctx.EventManager().EmitTypedEvent(&sourcehubtypes.EventObjectRegistered{
+		Key:      key.Hex(),
+		Creator:  sdk.AccAddress(contract.Caller().Bytes()).String(),
+		ObjectId: id,
+		View:     newEncodedValue,
+	}); err != nil {
+		log.Error("emit EventObjectRegistered failed", "err", err)
+		return nil, vm.ErrExecutionReverted
+	}
 
 	log.Info("register success", "id", id, "key", key.Hex(), "payload_bytes", len(base64.StdEncoding.EncodeToString(newEncodedValue)))
 
@@ -122,3 +136,25 @@ func (p Precompile) ViewRegistryGet(ctx sdk.Context, contract *vm.Contract, stat
 
 	return valueHash.Bytes(), nil
 }
+
+func (p Precompile) ViewRegistryIsSubscriber(ctx sdk.Context, method *abi.Method, args []interface{}) ([]byte, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf("invalid number of arguments, expected 2, got %d", len(args))
+	}
+	objectId, ok := args[0].(string)
+	if !ok {
+		return nil, fmt.Errorf("invalid type for objectId")
+	}
+	did, ok := args[1].(string)
+	if !ok {
+		return nil, fmt.Errorf("invalid type for did")
+	}
+
+	// The subscription is mirrored to the target the view is registered on.
+	target := ""
+	if v, err := p.sourcehubKeeper.Views.Get(ctx, objectId); err == nil {
+		target = v.Target
+	}
+	subscribed := p.sourcehubKeeper.HasRelationship(ctx, target, sourcehubtypes.ViewResourceName, objectId, "subscriber", did)
+	return method.Outputs.Pack(subscribed)
+}
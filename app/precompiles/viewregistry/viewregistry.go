@@ -85,11 +85,13 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 	return bz, nil
 }
 
+// IsTransaction and HandleMethod dispatch on the raw name, which both register
+// overloads share.
 func (Precompile) IsTransaction(method *abi.Method) bool {
-	switch method.Name {
+	switch method.RawName {
 	case ViewRegistryRegisterMethod:
 		return true
-	case ViewRegistryGetMethod:
+	case ViewRegistryGetMethod, ViewRegistryIsSubscriberMethod:
 		return false
 	default:
 		return false
@@ -104,11 +106,13 @@ func (p *Precompile) HandleMethod(
 	method *abi.Method,
 	args []interface{},
 ) (bz []byte, err error) {
-	switch method.Name {
+	switch method.RawName {
 	case ViewRegistryRegisterMethod:
 		bz, err = p.ViewRegistryRegister(ctx, contract, stateDB, method, args)
 	case ViewRegistryGetMethod:
 		bz, err = p.ViewRegistryGet(ctx, contract, stateDB, method, args)
+	case ViewRegistryIsSubscriberMethod:
+		bz, err = p.ViewRegistryIsSubscriber(ctx, method, args)
 	default:
 		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
 	}
@@ -116,6 +116,7 @@ func initRootCmd(
 		pruning.Cmd(newAppWrapper(), app.DefaultNodeHome),
 		snapshot.Cmd(newAppWrapper()),
 		NewProbeCmd(),
+		NewCheckInvariantsCmd(),
 	)
 
 	// add EVM' flavored TM commands to start server, etc.
@@ -0,0 +1,157 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	"github.com/cosmos/cosmos-sdk/x/crisis"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+
+	"github.com/shinzonetwork/shinzohub/app"
+	sourcehubkeeper "github.com/shinzonetwork/shinzohub/x/sourcehub/keeper"
+	sourcehubtypes "github.com/shinzonetwork/shinzohub/x/sourcehub/types"
+)
+
+const (
+	flagModule = "module"
+
+	entityMappingRoute = "entity-mapping"
+)
+
+// NewCheckInvariantsCmd returns a cobra Command which loads an exported state
+// into an in-memory application and runs the registered crisis invariants on
+// it.
+func NewCheckInvariantsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "check-invariants [exported-genesis-file]",
+		Short: "Run the crisis invariants against a state exported with the export command",
+		Long: "Import the state written by `shinzohubd export` into an in-memory application and run the registered crisis invariants on it, " +
+			"all of them or only those of the modules given with --module. Exits with an error if any invariant is broken.\n\n" +
+			"Importing the state rebuilds the indexes modules derive from their records, so sourcehub entity-mapping is checked on " +
+			"the exported entities before the import: no DID or PID may be registered by more than one address of a role. A state " +
+			"breaking it cannot be imported, and the other invariants are not run.\n\n" +
+			"Example:\n  shinzohubd export > state.json && shinzohubd check-invariants state.json --module sourcehub",
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			modules, err := cmd.Flags().GetStringSlice(flagModule)
+			if err != nil {
+				return err
+			}
+
+			genesis, err := genutiltypes.AppGenesisFromFile(args[0])
+			if err != nil {
+				return fmt.Errorf("read exported state: %w", err)
+			}
+
+			// The invariants are run below, one by one, rather than by the
+			// crisis module while the state is imported.
+			chainApp := app.NewChainApp(
+				log.NewNopLogger(), dbm.NewMemDB(), nil, true,
+				simtestutil.AppOptionsMap{
+					flags.FlagHome:                   tempDir(),
+					crisis.FlagSkipGenesisInvariants: true,
+				},
+				app.ChainID18Decimals,
+				app.EVMAppOptions,
+				baseapp.SetChainID(genesis.ChainID),
+			)
+
+			var checked, broken int
+			if len(modules) == 0 || slices.Contains(modules, sourcehubtypes.ModuleName) {
+				var appState map[string]json.RawMessage
+				if err := json.Unmarshal(genesis.AppState, &appState); err != nil {
+					return fmt.Errorf("read exported state: %w", err)
+				}
+				var gs sourcehubtypes.GenesisState
+				if err := chainApp.AppCodec().UnmarshalJSON(appState[sourcehubtypes.ModuleName], &gs); err != nil {
+					return fmt.Errorf("read exported %s state: %w", sourcehubtypes.ModuleName, err)
+				}
+
+				checked++
+				route := sourcehubtypes.ModuleName + "/" + entityMappingRoute
+				if res, stop := sourcehubkeeper.GenesisEntityMappingInvariant(&gs); stop {
+					cmd.Printf("BROKEN  %s\n%s", route, res)
+					return fmt.Errorf("%s broken, the exported state cannot be imported", route)
+				}
+				cmd.Printf("ok      %s\n", route)
+			}
+
+			req := &abci.RequestInitChain{
+				Time:          genesis.GenesisTime,
+				ChainId:       genesis.ChainID,
+				InitialHeight: genesis.InitialHeight,
+				AppStateBytes: genesis.AppState,
+			}
+			if genesis.Consensus != nil && genesis.Consensus.Params != nil {
+				params := genesis.Consensus.Params.ToProto()
+				req.ConsensusParams = &params
+			}
+			if err := initChain(chainApp, req); err != nil {
+				return fmt.Errorf("import exported state: %w", err)
+			}
+
+			ctx := chainApp.NewContextLegacy(false, cmtproto.Header{
+				ChainID: genesis.ChainID,
+				Height:  genesis.InitialHeight,
+				Time:    genesis.GenesisTime,
+			})
+
+			for _, route := range chainApp.CrisisKeeper.Routes() {
+				if len(modules) > 0 && !slices.Contains(modules, route.ModuleName) {
+					continue
+				}
+				// Checked on the exported entities above.
+				if route.ModuleName == sourcehubtypes.ModuleName && route.Route == entityMappingRoute {
+					continue
+				}
+				checked++
+
+				invCtx, _ := ctx.CacheContext()
+				res, stop := route.Invar(invCtx)
+				if !stop {
+					cmd.Printf("ok      %s\n", route.FullRoute())
+					continue
+				}
+				broken++
+				cmd.Printf("BROKEN  %s\n%s", route.FullRoute(), res)
+			}
+
+			if checked == 0 {
+				return fmt.Errorf("no invariant registered for modules %v", modules)
+			}
+			if broken > 0 {
+				return fmt.Errorf("%d of %d invariants broken", broken, checked)
+			}
+			cmd.Printf("all %d invariants hold\n", checked)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringSlice(flagModule, nil, "Only run the invariants of these modules")
+	return cmd
+}
+
+// initChain imports the state of req into chainApp. Modules panic on a state
+// they cannot import, the panic is returned as an error.
+func initChain(chainApp *app.ChainApp, req *abci.RequestInitChain) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+
+	_, err = chainApp.InitChain(req)
+	return err
+}
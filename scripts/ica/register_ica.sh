@@ -3,6 +3,7 @@ set -euo pipefail
 
 export SHINZOHUB_CONNECTION_ID="connection-0"
 export SOURCEHUB_CONNECTION_ID="connection-0"
+export SOURCEHUB_CHAIN_ID=${SOURCEHUB_CHAIN_ID:-"sourcehub-dev"}
 
 export KEY="acc0"
 export CHAIN_ID=${CHAIN_ID:-"91273002"}
@@ -11,7 +12,7 @@ export HOME_DIR=$(eval echo "${HOME_DIR:-"~/.shinzohub"}")
 export BINARY="./build/shinzohubd"
 export RPC=${RPC:-"26657"}
 
-$BINARY tx sourcehub register-ica $SHINZOHUB_CONNECTION_ID $SOURCEHUB_CONNECTION_ID \
+$BINARY tx sourcehub register-ica $SHINZOHUB_CONNECTION_ID $SOURCEHUB_CONNECTION_ID $SOURCEHUB_CHAIN_ID \
   --from $KEY \
   --keyring-backend $KEYRING \
   --chain-id $CHAIN_ID \
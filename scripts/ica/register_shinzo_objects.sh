@@ -9,7 +9,7 @@ BINARY="./build/shinzohubd"
 RPC="${RPC:-26657}"
 
 # list your resources here (positional args to the command)
-RESOURCES=(block logs event transaction)
+RESOURCES=(block log event transaction)
 
 "$BINARY" tx sourcehub register-objects "${RESOURCES[@]}" \
   --from "$KEY" \
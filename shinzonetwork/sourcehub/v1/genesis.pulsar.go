@@ -13,565 +13,3013 @@ import (
 	sync "sync"
 )
 
-var (
-	md_GenesisState                          protoreflect.MessageDescriptor
-	fd_GenesisState_controller_connection_id protoreflect.FieldDescriptor
-	fd_GenesisState_host_connection_id       protoreflect.FieldDescriptor
-	fd_GenesisState_version                  protoreflect.FieldDescriptor
-	fd_GenesisState_encoding                 protoreflect.FieldDescriptor
-	fd_GenesisState_tx_type                  protoreflect.FieldDescriptor
-	fd_GenesisState_policy_id                protoreflect.FieldDescriptor
-	fd_GenesisState_params                   protoreflect.FieldDescriptor
-)
+var _ protoreflect.List = (*_GenesisState_8_list)(nil)
 
-func init() {
-	file_shinzonetwork_sourcehub_v1_genesis_proto_init()
-	md_GenesisState = File_shinzonetwork_sourcehub_v1_genesis_proto.Messages().ByName("GenesisState")
-	fd_GenesisState_controller_connection_id = md_GenesisState.Fields().ByName("controller_connection_id")
-	fd_GenesisState_host_connection_id = md_GenesisState.Fields().ByName("host_connection_id")
-	fd_GenesisState_version = md_GenesisState.Fields().ByName("version")
-	fd_GenesisState_encoding = md_GenesisState.Fields().ByName("encoding")
-	fd_GenesisState_tx_type = md_GenesisState.Fields().ByName("tx_type")
-	fd_GenesisState_policy_id = md_GenesisState.Fields().ByName("policy_id")
-	fd_GenesisState_params = md_GenesisState.Fields().ByName("params")
+type _GenesisState_8_list struct {
+	list *[]*StreamGrant
 }
 
-var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
+func (x *_GenesisState_8_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
 
-type fastReflection_GenesisState GenesisState
+func (x *_GenesisState_8_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
 
-func (x *GenesisState) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_GenesisState)(x)
+func (x *_GenesisState_8_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*StreamGrant)
+	(*x.list)[i] = concreteValue
 }
 
-func (x *GenesisState) slowProtoReflect() protoreflect.Message {
-	mi := &file_shinzonetwork_sourcehub_v1_genesis_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *_GenesisState_8_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*StreamGrant)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_8_list) AppendMutable() protoreflect.Value {
+	v := new(StreamGrant)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
-	return mi.MessageOf(x)
+	*x.list = (*x.list)[:n]
 }
 
-var _fastReflection_GenesisState_messageType fastReflection_GenesisState_messageType
-var _ protoreflect.MessageType = fastReflection_GenesisState_messageType{}
+func (x *_GenesisState_8_list) NewElement() protoreflect.Value {
+	v := new(StreamGrant)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
 
-type fastReflection_GenesisState_messageType struct{}
+func (x *_GenesisState_8_list) IsValid() bool {
+	return x.list != nil
+}
 
-func (x fastReflection_GenesisState_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_GenesisState)(nil)
+var _ protoreflect.List = (*_GenesisState_9_list)(nil)
+
+type _GenesisState_9_list struct {
+	list *[]*RoleHolder
 }
-func (x fastReflection_GenesisState_messageType) New() protoreflect.Message {
-	return new(fastReflection_GenesisState)
+
+func (x *_GenesisState_9_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
 }
-func (x fastReflection_GenesisState_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_GenesisState
+
+func (x *_GenesisState_9_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-// Descriptor returns message descriptor, which contains only the protobuf
-// type information for the message.
-func (x *fastReflection_GenesisState) Descriptor() protoreflect.MessageDescriptor {
-	return md_GenesisState
+func (x *_GenesisState_9_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RoleHolder)
+	(*x.list)[i] = concreteValue
 }
 
-// Type returns the message type, which encapsulates both Go and protobuf
-// type information. If the Go type information is not needed,
-// it is recommended that the message descriptor be used instead.
-func (x *fastReflection_GenesisState) Type() protoreflect.MessageType {
-	return _fastReflection_GenesisState_messageType
+func (x *_GenesisState_9_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RoleHolder)
+	*x.list = append(*x.list, concreteValue)
 }
 
-// New returns a newly allocated and mutable empty message.
-func (x *fastReflection_GenesisState) New() protoreflect.Message {
-	return new(fastReflection_GenesisState)
+func (x *_GenesisState_9_list) AppendMutable() protoreflect.Value {
+	v := new(RoleHolder)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-// Interface unwraps the message reflection interface and
-// returns the underlying ProtoMessage interface.
-func (x *fastReflection_GenesisState) Interface() protoreflect.ProtoMessage {
-	return (*GenesisState)(x)
+func (x *_GenesisState_9_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
 }
 
-// Range iterates over every populated field in an undefined order,
-// calling f for each field descriptor and value encountered.
-// Range returns immediately if f returns false.
-// While iterating, mutating operations may only be performed
-// on the current field descriptor.
-func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.ControllerConnectionId != "" {
-		value := protoreflect.ValueOfString(x.ControllerConnectionId)
-		if !f(fd_GenesisState_controller_connection_id, value) {
-			return
-		}
+func (x *_GenesisState_9_list) NewElement() protoreflect.Value {
+	v := new(RoleHolder)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_9_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_10_list)(nil)
+
+type _GenesisState_10_list struct {
+	list *[]*Entity
+}
+
+func (x *_GenesisState_10_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
-	if x.HostConnectionId != "" {
-		value := protoreflect.ValueOfString(x.HostConnectionId)
-		if !f(fd_GenesisState_host_connection_id, value) {
-			return
-		}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Entity)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Entity)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_10_list) AppendMutable() protoreflect.Value {
+	v := new(Entity)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
-	if x.Version != "" {
-		value := protoreflect.ValueOfString(x.Version)
-		if !f(fd_GenesisState_version, value) {
-			return
-		}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_10_list) NewElement() protoreflect.Value {
+	v := new(Entity)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_11_list)(nil)
+
+type _GenesisState_11_list struct {
+	list *[]*View
+}
+
+func (x *_GenesisState_11_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
-	if x.Encoding != "" {
-		value := protoreflect.ValueOfString(x.Encoding)
-		if !f(fd_GenesisState_encoding, value) {
-			return
-		}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_11_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_11_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*View)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_11_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*View)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_11_list) AppendMutable() protoreflect.Value {
+	v := new(View)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_11_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
-	if x.TxType != "" {
-		value := protoreflect.ValueOfString(x.TxType)
-		if !f(fd_GenesisState_tx_type, value) {
-			return
-		}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_11_list) NewElement() protoreflect.Value {
+	v := new(View)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_11_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_12_list)(nil)
+
+type _GenesisState_12_list struct {
+	list *[]*IcaPacket
+}
+
+func (x *_GenesisState_12_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
-	if x.PolicyId != "" {
-		value := protoreflect.ValueOfString(x.PolicyId)
-		if !f(fd_GenesisState_policy_id, value) {
-			return
-		}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_12_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_12_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*IcaPacket)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_12_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*IcaPacket)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_12_list) AppendMutable() protoreflect.Value {
+	v := new(IcaPacket)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_12_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
-	if x.Params != nil {
-		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
-		if !f(fd_GenesisState_params, value) {
-			return
-		}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_12_list) NewElement() protoreflect.Value {
+	v := new(IcaPacket)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_12_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_13_list)(nil)
+
+type _GenesisState_13_list struct {
+	list *[]*EntityCommand
+}
+
+func (x *_GenesisState_13_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
+	return len(*x.list)
 }
 
-// Has reports whether a field is populated.
-//
-// Some fields have the property of nullability where it is possible to
-// distinguish between the default value of a field and whether the field
-// was explicitly populated with the default value. Singular message fields,
-// member fields of a oneof, and proto2 scalar fields are nullable. Such
-// fields are populated only if explicitly set.
-//
-// In other cases (aside from the nullable cases above),
-// a proto3 scalar field is populated if it contains a non-zero value, and
-// a repeated field is populated if it is non-empty.
-func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool {
-	switch fd.FullName() {
-	case "shinzonetwork.sourcehub.v1.GenesisState.controller_connection_id":
-		return x.ControllerConnectionId != ""
-	case "shinzonetwork.sourcehub.v1.GenesisState.host_connection_id":
-		return x.HostConnectionId != ""
-	case "shinzonetwork.sourcehub.v1.GenesisState.version":
-		return x.Version != ""
-	case "shinzonetwork.sourcehub.v1.GenesisState.encoding":
-		return x.Encoding != ""
-	case "shinzonetwork.sourcehub.v1.GenesisState.tx_type":
-		return x.TxType != ""
-	case "shinzonetwork.sourcehub.v1.GenesisState.policy_id":
-		return x.PolicyId != ""
-	case "shinzonetwork.sourcehub.v1.GenesisState.params":
-		return x.Params != nil
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: shinzonetwork.sourcehub.v1.GenesisState"))
-		}
-		panic(fmt.Errorf("message shinzonetwork.sourcehub.v1.GenesisState does not contain field %s", fd.FullName()))
+func (x *_GenesisState_13_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_13_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*EntityCommand)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_13_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*EntityCommand)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_13_list) AppendMutable() protoreflect.Value {
+	v := new(EntityCommand)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_13_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
+	*x.list = (*x.list)[:n]
 }
 
-// Clear clears the field such that a subsequent Has call reports false.
-//
-// Clearing an extension field clears both the extension type and value
-// associated with the given field number.
-//
-// Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
-	switch fd.FullName() {
-	case "shinzonetwork.sourcehub.v1.GenesisState.controller_connection_id":
-		x.ControllerConnectionId = ""
-	case "shinzonetwork.sourcehub.v1.GenesisState.host_connection_id":
-		x.HostConnectionId = ""
-	case "shinzonetwork.sourcehub.v1.GenesisState.version":
-		x.Version = ""
-	case "shinzonetwork.sourcehub.v1.GenesisState.encoding":
-		x.Encoding = ""
-	case "shinzonetwork.sourcehub.v1.GenesisState.tx_type":
-		x.TxType = ""
-	case "shinzonetwork.sourcehub.v1.GenesisState.policy_id":
-		x.PolicyId = ""
-	case "shinzonetwork.sourcehub.v1.GenesisState.params":
-		x.Params = nil
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: shinzonetwork.sourcehub.v1.GenesisState"))
-		}
-		panic(fmt.Errorf("message shinzonetwork.sourcehub.v1.GenesisState does not contain field %s", fd.FullName()))
+func (x *_GenesisState_13_list) NewElement() protoreflect.Value {
+	v := new(EntityCommand)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_13_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_15_list)(nil)
+
+type _GenesisState_15_list struct {
+	list *[]*IcaCommand
+}
+
+func (x *_GenesisState_15_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
+	return len(*x.list)
 }
 
-// Get retrieves the value for a field.
-//
-// For unpopulated scalars, it returns the default value, where
-// the default value of a bytes scalar is guaranteed to be a copy.
-// For unpopulated composite types, it returns an empty, read-only view
-// of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
-	switch descriptor.FullName() {
-	case "shinzonetwork.sourcehub.v1.GenesisState.controller_connection_id":
-		value := x.ControllerConnectionId
-		return protoreflect.ValueOfString(value)
-	case "shinzonetwork.sourcehub.v1.GenesisState.host_connection_id":
-		value := x.HostConnectionId
-		return protoreflect.ValueOfString(value)
-	case "shinzonetwork.sourcehub.v1.GenesisState.version":
-		value := x.Version
-		return protoreflect.ValueOfString(value)
-	case "shinzonetwork.sourcehub.v1.GenesisState.encoding":
-		value := x.Encoding
-		return protoreflect.ValueOfString(value)
-	case "shinzonetwork.sourcehub.v1.GenesisState.tx_type":
-		value := x.TxType
-		return protoreflect.ValueOfString(value)
-	case "shinzonetwork.sourcehub.v1.GenesisState.policy_id":
-		value := x.PolicyId
-		return protoreflect.ValueOfString(value)
-	case "shinzonetwork.sourcehub.v1.GenesisState.params":
-		value := x.Params
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	default:
-		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: shinzonetwork.sourcehub.v1.GenesisState"))
-		}
-		panic(fmt.Errorf("message shinzonetwork.sourcehub.v1.GenesisState does not contain field %s", descriptor.FullName()))
+func (x *_GenesisState_15_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_15_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*IcaCommand)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_15_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*IcaCommand)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_15_list) AppendMutable() protoreflect.Value {
+	v := new(IcaCommand)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_15_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
+	*x.list = (*x.list)[:n]
 }
 
-// Set stores the value for a field.
-//
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType.
-// When setting a composite type, it is unspecified whether the stored value
-// aliases the source's memory in any way. If the composite value is an
-// empty, read-only value, then it panics.
-//
-// Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
-	switch fd.FullName() {
-	case "shinzonetwork.sourcehub.v1.GenesisState.controller_connection_id":
-		x.ControllerConnectionId = value.Interface().(string)
-	case "shinzonetwork.sourcehub.v1.GenesisState.host_connection_id":
-		x.HostConnectionId = value.Interface().(string)
-	case "shinzonetwork.sourcehub.v1.GenesisState.version":
-		x.Version = value.Interface().(string)
-	case "shinzonetwork.sourcehub.v1.GenesisState.encoding":
-		x.Encoding = value.Interface().(string)
-	case "shinzonetwork.sourcehub.v1.GenesisState.tx_type":
-		x.TxType = value.Interface().(string)
-	case "shinzonetwork.sourcehub.v1.GenesisState.policy_id":
-		x.PolicyId = value.Interface().(string)
-	case "shinzonetwork.sourcehub.v1.GenesisState.params":
-		x.Params = value.Message().Interface().(*Params)
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: shinzonetwork.sourcehub.v1.GenesisState"))
-		}
-		panic(fmt.Errorf("message shinzonetwork.sourcehub.v1.GenesisState does not contain field %s", fd.FullName()))
+func (x *_GenesisState_15_list) NewElement() protoreflect.Value {
+	v := new(IcaCommand)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_15_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_17_list)(nil)
+
+type _GenesisState_17_list struct {
+	list *[]uint64
+}
+
+func (x *_GenesisState_17_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
+	return len(*x.list)
 }
 
-// Mutable returns a mutable reference to a composite type.
-//
-// If the field is unpopulated, it may allocate a composite value.
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType
-// if not already stored.
-// It panics if the field does not contain a composite type.
-//
-// Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "shinzonetwork.sourcehub.v1.GenesisState.params":
-		if x.Params == nil {
-			x.Params = new(Params)
-		}
-		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
-	case "shinzonetwork.sourcehub.v1.GenesisState.controller_connection_id":
-		panic(fmt.Errorf("field controller_connection_id of message shinzonetwork.sourcehub.v1.GenesisState is not mutable"))
-	case "shinzonetwork.sourcehub.v1.GenesisState.host_connection_id":
-		panic(fmt.Errorf("field host_connection_id of message shinzonetwork.sourcehub.v1.GenesisState is not mutable"))
-	case "shinzonetwork.sourcehub.v1.GenesisState.version":
-		panic(fmt.Errorf("field version of message shinzonetwork.sourcehub.v1.GenesisState is not mutable"))
-	case "shinzonetwork.sourcehub.v1.GenesisState.encoding":
-		panic(fmt.Errorf("field encoding of message shinzonetwork.sourcehub.v1.GenesisState is not mutable"))
-	case "shinzonetwork.sourcehub.v1.GenesisState.tx_type":
-		panic(fmt.Errorf("field tx_type of message shinzonetwork.sourcehub.v1.GenesisState is not mutable"))
-	case "shinzonetwork.sourcehub.v1.GenesisState.policy_id":
-		panic(fmt.Errorf("field policy_id of message shinzonetwork.sourcehub.v1.GenesisState is not mutable"))
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: shinzonetwork.sourcehub.v1.GenesisState"))
-		}
-		panic(fmt.Errorf("message shinzonetwork.sourcehub.v1.GenesisState does not contain field %s", fd.FullName()))
+func (x *_GenesisState_17_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfUint64((*x.list)[i])
+}
+
+func (x *_GenesisState_17_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_17_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_17_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message GenesisState at list field DeadLetters as it is not of Message kind"))
+}
+
+func (x *_GenesisState_17_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_17_list) NewElement() protoreflect.Value {
+	v := uint64(0)
+	return protoreflect.ValueOfUint64(v)
+}
+
+func (x *_GenesisState_17_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_19_list)(nil)
+
+type _GenesisState_19_list struct {
+	list *[]*SourcehubTarget
+}
+
+func (x *_GenesisState_19_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
+	return len(*x.list)
 }
 
-// NewField returns a new value that is assignable to the field
-// for the given descriptor. For scalars, this returns the default value.
-// For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "shinzonetwork.sourcehub.v1.GenesisState.controller_connection_id":
-		return protoreflect.ValueOfString("")
-	case "shinzonetwork.sourcehub.v1.GenesisState.host_connection_id":
-		return protoreflect.ValueOfString("")
-	case "shinzonetwork.sourcehub.v1.GenesisState.version":
-		return protoreflect.ValueOfString("")
-	case "shinzonetwork.sourcehub.v1.GenesisState.encoding":
-		return protoreflect.ValueOfString("")
-	case "shinzonetwork.sourcehub.v1.GenesisState.tx_type":
-		return protoreflect.ValueOfString("")
-	case "shinzonetwork.sourcehub.v1.GenesisState.policy_id":
-		return protoreflect.ValueOfString("")
-	case "shinzonetwork.sourcehub.v1.GenesisState.params":
-		m := new(Params)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: shinzonetwork.sourcehub.v1.GenesisState"))
-		}
-		panic(fmt.Errorf("message shinzonetwork.sourcehub.v1.GenesisState does not contain field %s", fd.FullName()))
+func (x *_GenesisState_19_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_19_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SourcehubTarget)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_19_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SourcehubTarget)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_19_list) AppendMutable() protoreflect.Value {
+	v := new(SourcehubTarget)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_19_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
+	*x.list = (*x.list)[:n]
 }
 
-// WhichOneof reports which field within the oneof is populated,
-// returning nil if none are populated.
-// It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_GenesisState) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
-	switch d.FullName() {
-	default:
-		panic(fmt.Errorf("%s is not a oneof field in shinzonetwork.sourcehub.v1.GenesisState", d.FullName()))
+func (x *_GenesisState_19_list) NewElement() protoreflect.Value {
+	v := new(SourcehubTarget)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_19_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_21_list)(nil)
+
+type _GenesisState_21_list struct {
+	list *[]*Relationship
+}
+
+func (x *_GenesisState_21_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
-	panic("unreachable")
+	return len(*x.list)
 }
 
-// GetUnknown retrieves the entire list of unknown fields.
-// The caller may only mutate the contents of the RawFields
-// if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_GenesisState) GetUnknown() protoreflect.RawFields {
-	return x.unknownFields
+func (x *_GenesisState_21_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-// SetUnknown stores an entire list of unknown fields.
-// The raw fields must be syntactically valid according to the wire format.
-// An implementation may panic if this is not the case.
-// Once stored, the caller must not mutate the content of the RawFields.
-// An empty RawFields may be passed to clear the fields.
-//
-// SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) SetUnknown(fields protoreflect.RawFields) {
-	x.unknownFields = fields
+func (x *_GenesisState_21_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Relationship)
+	(*x.list)[i] = concreteValue
 }
 
-// IsValid reports whether the message is valid.
-//
-// An invalid message is an empty, read-only value.
-//
-// An invalid message often corresponds to a nil pointer of the concrete
-// message type, but the details are implementation dependent.
-// Validity is not part of the protobuf data model, and may not
-// be preserved in marshaling or other operations.
-func (x *fastReflection_GenesisState) IsValid() bool {
-	return x != nil
+func (x *_GenesisState_21_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Relationship)
+	*x.list = append(*x.list, concreteValue)
 }
 
-// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
-// This method may return nil.
-//
-// The returned methods type is identical to
-// "google.golang.org/protobuf/runtime/protoiface".Methods.
-// Consult the protoiface package documentation for details.
-func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
-	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*GenesisState)
-		if x == nil {
-			return protoiface.SizeOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Size:              0,
-			}
-		}
-		options := runtime.SizeInputToOptions(input)
-		_ = options
-		var n int
-		var l int
-		_ = l
-		l = len(x.ControllerConnectionId)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.HostConnectionId)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Version)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Encoding)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.TxType)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.PolicyId)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.Params != nil {
-			l = options.Size(x.Params)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.unknownFields != nil {
-			n += len(x.unknownFields)
-		}
-		return protoiface.SizeOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Size:              n,
-		}
+func (x *_GenesisState_21_list) AppendMutable() protoreflect.Value {
+	v := new(Relationship)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_21_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
+	*x.list = (*x.list)[:n]
+}
 
-	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*GenesisState)
-		if x == nil {
-			return protoiface.MarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Buf:               input.Buf,
-			}, nil
-		}
-		options := runtime.MarshalInputToOptions(input)
-		_ = options
-		size := options.Size(x)
-		dAtA := make([]byte, size)
-		i := len(dAtA)
-		_ = i
-		var l int
-		_ = l
-		if x.unknownFields != nil {
-			i -= len(x.unknownFields)
-			copy(dAtA[i:], x.unknownFields)
-		}
-		if x.Params != nil {
-			encoded, err := options.Marshal(x.Params)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x3a
-		}
-		if len(x.PolicyId) > 0 {
-			i -= len(x.PolicyId)
-			copy(dAtA[i:], x.PolicyId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PolicyId)))
-			i--
-			dAtA[i] = 0x32
-		}
-		if len(x.TxType) > 0 {
-			i -= len(x.TxType)
-			copy(dAtA[i:], x.TxType)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TxType)))
-			i--
-			dAtA[i] = 0x2a
-		}
-		if len(x.Encoding) > 0 {
-			i -= len(x.Encoding)
-			copy(dAtA[i:], x.Encoding)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Encoding)))
-			i--
-			dAtA[i] = 0x22
-		}
-		if len(x.Version) > 0 {
-			i -= len(x.Version)
-			copy(dAtA[i:], x.Version)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Version)))
-			i--
-			dAtA[i] = 0x1a
-		}
-		if len(x.HostConnectionId) > 0 {
-			i -= len(x.HostConnectionId)
-			copy(dAtA[i:], x.HostConnectionId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.HostConnectionId)))
-			i--
-			dAtA[i] = 0x12
-		}
-		if len(x.ControllerConnectionId) > 0 {
-			i -= len(x.ControllerConnectionId)
-			copy(dAtA[i:], x.ControllerConnectionId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ControllerConnectionId)))
-			i--
-			dAtA[i] = 0xa
-		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
-		}
-		return protoiface.MarshalOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Buf:               input.Buf,
-		}, nil
+func (x *_GenesisState_21_list) NewElement() protoreflect.Value {
+	v := new(Relationship)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_21_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_22_list)(nil)
+
+type _GenesisState_22_list struct {
+	list *[]*PolicyVersion
+}
+
+func (x *_GenesisState_22_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
-	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*GenesisState)
-		if x == nil {
-			return protoiface.UnmarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Flags:             input.Flags,
-			}, nil
-		}
-		options := runtime.UnmarshalInputToOptions(input)
-		_ = options
-		dAtA := input.Buf
-		l := len(dAtA)
-		iNdEx := 0
-		for iNdEx < l {
-			preIndex := iNdEx
-			var wire uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+	return len(*x.list)
+}
+
+func (x *_GenesisState_22_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_22_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PolicyVersion)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_22_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PolicyVersion)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_22_list) AppendMutable() protoreflect.Value {
+	v := new(PolicyVersion)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_22_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_22_list) NewElement() protoreflect.Value {
+	v := new(PolicyVersion)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_22_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_23_list)(nil)
+
+type _GenesisState_23_list struct {
+	list *[]*StreamPrice
+}
+
+func (x *_GenesisState_23_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_23_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_23_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*StreamPrice)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_23_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*StreamPrice)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_23_list) AppendMutable() protoreflect.Value {
+	v := new(StreamPrice)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_23_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_23_list) NewElement() protoreflect.Value {
+	v := new(StreamPrice)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_23_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_24_list)(nil)
+
+type _GenesisState_24_list struct {
+	list *[]*StreamPayment
+}
+
+func (x *_GenesisState_24_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_24_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_24_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*StreamPayment)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_24_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*StreamPayment)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_24_list) AppendMutable() protoreflect.Value {
+	v := new(StreamPayment)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_24_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_24_list) NewElement() protoreflect.Value {
+	v := new(StreamPayment)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_24_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_25_list)(nil)
+
+type _GenesisState_25_list struct {
+	list *[]*StreamRevenue
+}
+
+func (x *_GenesisState_25_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_25_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_25_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*StreamRevenue)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_25_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*StreamRevenue)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_25_list) AppendMutable() protoreflect.Value {
+	v := new(StreamRevenue)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_25_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_25_list) NewElement() protoreflect.Value {
+	v := new(StreamRevenue)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_25_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_26_list)(nil)
+
+type _GenesisState_26_list struct {
+	list *[]*PendingReward
+}
+
+func (x *_GenesisState_26_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_26_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_26_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PendingReward)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_26_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PendingReward)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_26_list) AppendMutable() protoreflect.Value {
+	v := new(PendingReward)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_26_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_26_list) NewElement() protoreflect.Value {
+	v := new(PendingReward)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_26_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_27_list)(nil)
+
+type _GenesisState_27_list struct {
+	list *[]*EntityUnbonding
+}
+
+func (x *_GenesisState_27_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_27_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_27_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*EntityUnbonding)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_27_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*EntityUnbonding)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_27_list) AppendMutable() protoreflect.Value {
+	v := new(EntityUnbonding)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_27_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_27_list) NewElement() protoreflect.Value {
+	v := new(EntityUnbonding)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_27_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_28_list)(nil)
+
+type _GenesisState_28_list struct {
+	list *[]*UsedRegistrationMessage
+}
+
+func (x *_GenesisState_28_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_28_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_28_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*UsedRegistrationMessage)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_28_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*UsedRegistrationMessage)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_28_list) AppendMutable() protoreflect.Value {
+	v := new(UsedRegistrationMessage)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_28_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_28_list) NewElement() protoreflect.Value {
+	v := new(UsedRegistrationMessage)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_28_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_GenesisState                            protoreflect.MessageDescriptor
+	fd_GenesisState_controller_connection_id   protoreflect.FieldDescriptor
+	fd_GenesisState_host_connection_id         protoreflect.FieldDescriptor
+	fd_GenesisState_version                    protoreflect.FieldDescriptor
+	fd_GenesisState_encoding                   protoreflect.FieldDescriptor
+	fd_GenesisState_tx_type                    protoreflect.FieldDescriptor
+	fd_GenesisState_policy_id                  protoreflect.FieldDescriptor
+	fd_GenesisState_params                     protoreflect.FieldDescriptor
+	fd_GenesisState_stream_grants              protoreflect.FieldDescriptor
+	fd_GenesisState_role_holders               protoreflect.FieldDescriptor
+	fd_GenesisState_entities                   protoreflect.FieldDescriptor
+	fd_GenesisState_views                      protoreflect.FieldDescriptor
+	fd_GenesisState_ica_packets                protoreflect.FieldDescriptor
+	fd_GenesisState_entity_commands            protoreflect.FieldDescriptor
+	fd_GenesisState_ica_commands               protoreflect.FieldDescriptor
+	fd_GenesisState_next_command_id            protoreflect.FieldDescriptor
+	fd_GenesisState_dead_letters               protoreflect.FieldDescriptor
+	fd_GenesisState_targets                    protoreflect.FieldDescriptor
+	fd_GenesisState_default_target             protoreflect.FieldDescriptor
+	fd_GenesisState_relationships              protoreflect.FieldDescriptor
+	fd_GenesisState_policy_versions            protoreflect.FieldDescriptor
+	fd_GenesisState_stream_prices              protoreflect.FieldDescriptor
+	fd_GenesisState_stream_payments            protoreflect.FieldDescriptor
+	fd_GenesisState_stream_revenues            protoreflect.FieldDescriptor
+	fd_GenesisState_pending_rewards            protoreflect.FieldDescriptor
+	fd_GenesisState_entity_unbondings          protoreflect.FieldDescriptor
+	fd_GenesisState_used_registration_messages protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_shinzonetwork_sourcehub_v1_genesis_proto_init()
+	md_GenesisState = File_shinzonetwork_sourcehub_v1_genesis_proto.Messages().ByName("GenesisState")
+	fd_GenesisState_controller_connection_id = md_GenesisState.Fields().ByName("controller_connection_id")
+	fd_GenesisState_host_connection_id = md_GenesisState.Fields().ByName("host_connection_id")
+	fd_GenesisState_version = md_GenesisState.Fields().ByName("version")
+	fd_GenesisState_encoding = md_GenesisState.Fields().ByName("encoding")
+	fd_GenesisState_tx_type = md_GenesisState.Fields().ByName("tx_type")
+	fd_GenesisState_policy_id = md_GenesisState.Fields().ByName("policy_id")
+	fd_GenesisState_params = md_GenesisState.Fields().ByName("params")
+	fd_GenesisState_stream_grants = md_GenesisState.Fields().ByName("stream_grants")
+	fd_GenesisState_role_holders = md_GenesisState.Fields().ByName("role_holders")
+	fd_GenesisState_entities = md_GenesisState.Fields().ByName("entities")
+	fd_GenesisState_views = md_GenesisState.Fields().ByName("views")
+	fd_GenesisState_ica_packets = md_GenesisState.Fields().ByName("ica_packets")
+	fd_GenesisState_entity_commands = md_GenesisState.Fields().ByName("entity_commands")
+	fd_GenesisState_ica_commands = md_GenesisState.Fields().ByName("ica_commands")
+	fd_GenesisState_next_command_id = md_GenesisState.Fields().ByName("next_command_id")
+	fd_GenesisState_dead_letters = md_GenesisState.Fields().ByName("dead_letters")
+	fd_GenesisState_targets = md_GenesisState.Fields().ByName("targets")
+	fd_GenesisState_default_target = md_GenesisState.Fields().ByName("default_target")
+	fd_GenesisState_relationships = md_GenesisState.Fields().ByName("relationships")
+	fd_GenesisState_policy_versions = md_GenesisState.Fields().ByName("policy_versions")
+	fd_GenesisState_stream_prices = md_GenesisState.Fields().ByName("stream_prices")
+	fd_GenesisState_stream_payments = md_GenesisState.Fields().ByName("stream_payments")
+	fd_GenesisState_stream_revenues = md_GenesisState.Fields().ByName("stream_revenues")
+	fd_GenesisState_pending_rewards = md_GenesisState.Fields().ByName("pending_rewards")
+	fd_GenesisState_entity_unbondings = md_GenesisState.Fields().ByName("entity_unbondings")
+	fd_GenesisState_used_registration_messages = md_GenesisState.Fields().ByName("used_registration_messages")
+}
+
+var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
+
+type fastReflection_GenesisState GenesisState
+
+func (x *GenesisState) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_GenesisState)(x)
+}
+
+func (x *GenesisState) slowProtoReflect() protoreflect.Message {
+	mi := &file_shinzonetwork_sourcehub_v1_genesis_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_GenesisState_messageType fastReflection_GenesisState_messageType
+var _ protoreflect.MessageType = fastReflection_GenesisState_messageType{}
+
+type fastReflection_GenesisState_messageType struct{}
+
+func (x fastReflection_GenesisState_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_GenesisState)(nil)
+}
+func (x fastReflection_GenesisState_messageType) New() protoreflect.Message {
+	return new(fastReflection_GenesisState)
+}
+func (x fastReflection_GenesisState_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_GenesisState
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_GenesisState) Descriptor() protoreflect.MessageDescriptor {
+	return md_GenesisState
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_GenesisState) Type() protoreflect.MessageType {
+	return _fastReflection_GenesisState_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_GenesisState) New() protoreflect.Message {
+	return new(fastReflection_GenesisState)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_GenesisState) Interface() protoreflect.ProtoMessage {
+	return (*GenesisState)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ControllerConnectionId != "" {
+		value := protoreflect.ValueOfString(x.ControllerConnectionId)
+		if !f(fd_GenesisState_controller_connection_id, value) {
+			return
+		}
+	}
+	if x.HostConnectionId != "" {
+		value := protoreflect.ValueOfString(x.HostConnectionId)
+		if !f(fd_GenesisState_host_connection_id, value) {
+			return
+		}
+	}
+	if x.Version != "" {
+		value := protoreflect.ValueOfString(x.Version)
+		if !f(fd_GenesisState_version, value) {
+			return
+		}
+	}
+	if x.Encoding != "" {
+		value := protoreflect.ValueOfString(x.Encoding)
+		if !f(fd_GenesisState_encoding, value) {
+			return
+		}
+	}
+	if x.TxType != "" {
+		value := protoreflect.ValueOfString(x.TxType)
+		if !f(fd_GenesisState_tx_type, value) {
+			return
+		}
+	}
+	if x.PolicyId != "" {
+		value := protoreflect.ValueOfString(x.PolicyId)
+		if !f(fd_GenesisState_policy_id, value) {
+			return
+		}
+	}
+	if x.Params != nil {
+		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+		if !f(fd_GenesisState_params, value) {
+			return
+		}
+	}
+	if len(x.StreamGrants) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_8_list{list: &x.StreamGrants})
+		if !f(fd_GenesisState_stream_grants, value) {
+			return
+		}
+	}
+	if len(x.RoleHolders) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_9_list{list: &x.RoleHolders})
+		if !f(fd_GenesisState_role_holders, value) {
+			return
+		}
+	}
+	if len(x.Entities) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_10_list{list: &x.Entities})
+		if !f(fd_GenesisState_entities, value) {
+			return
+		}
+	}
+	if len(x.Views) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_11_list{list: &x.Views})
+		if !f(fd_GenesisState_views, value) {
+			return
+		}
+	}
+	if len(x.IcaPackets) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_12_list{list: &x.IcaPackets})
+		if !f(fd_GenesisState_ica_packets, value) {
+			return
+		}
+	}
+	if len(x.EntityCommands) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_13_list{list: &x.EntityCommands})
+		if !f(fd_GenesisState_entity_commands, value) {
+			return
+		}
+	}
+	if len(x.IcaCommands) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_15_list{list: &x.IcaCommands})
+		if !f(fd_GenesisState_ica_commands, value) {
+			return
+		}
+	}
+	if x.NextCommandId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.NextCommandId)
+		if !f(fd_GenesisState_next_command_id, value) {
+			return
+		}
+	}
+	if len(x.DeadLetters) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_17_list{list: &x.DeadLetters})
+		if !f(fd_GenesisState_dead_letters, value) {
+			return
+		}
+	}
+	if len(x.Targets) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_19_list{list: &x.Targets})
+		if !f(fd_GenesisState_targets, value) {
+			return
+		}
+	}
+	if x.DefaultTarget != "" {
+		value := protoreflect.ValueOfString(x.DefaultTarget)
+		if !f(fd_GenesisState_default_target, value) {
+			return
+		}
+	}
+	if len(x.Relationships) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_21_list{list: &x.Relationships})
+		if !f(fd_GenesisState_relationships, value) {
+			return
+		}
+	}
+	if len(x.PolicyVersions) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_22_list{list: &x.PolicyVersions})
+		if !f(fd_GenesisState_policy_versions, value) {
+			return
+		}
+	}
+	if len(x.StreamPrices) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_23_list{list: &x.StreamPrices})
+		if !f(fd_GenesisState_stream_prices, value) {
+			return
+		}
+	}
+	if len(x.StreamPayments) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_24_list{list: &x.StreamPayments})
+		if !f(fd_GenesisState_stream_payments, value) {
+			return
+		}
+	}
+	if len(x.StreamRevenues) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_25_list{list: &x.StreamRevenues})
+		if !f(fd_GenesisState_stream_revenues, value) {
+			return
+		}
+	}
+	if len(x.PendingRewards) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_26_list{list: &x.PendingRewards})
+		if !f(fd_GenesisState_pending_rewards, value) {
+			return
+		}
+	}
+	if len(x.EntityUnbondings) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_27_list{list: &x.EntityUnbondings})
+		if !f(fd_GenesisState_entity_unbondings, value) {
+			return
+		}
+	}
+	if len(x.UsedRegistrationMessages) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_28_list{list: &x.UsedRegistrationMessages})
+		if !f(fd_GenesisState_used_registration_messages, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "shinzonetwork.sourcehub.v1.GenesisState.controller_connection_id":
+		return x.ControllerConnectionId != ""
+	case "shinzonetwork.sourcehub.v1.GenesisState.host_connection_id":
+		return x.HostConnectionId != ""
+	case "shinzonetwork.sourcehub.v1.GenesisState.version":
+		return x.Version != ""
+	case "shinzonetwork.sourcehub.v1.GenesisState.encoding":
+		return x.Encoding != ""
+	case "shinzonetwork.sourcehub.v1.GenesisState.tx_type":
+		return x.TxType != ""
+	case "shinzonetwork.sourcehub.v1.GenesisState.policy_id":
+		return x.PolicyId != ""
+	case "shinzonetwork.sourcehub.v1.GenesisState.params":
+		return x.Params != nil
+	case "shinzonetwork.sourcehub.v1.GenesisState.stream_grants":
+		return len(x.StreamGrants) != 0
+	case "shinzonetwork.sourcehub.v1.GenesisState.role_holders":
+		return len(x.RoleHolders) != 0
+	case "shinzonetwork.sourcehub.v1.GenesisState.entities":
+		return len(x.Entities) != 0
+	case "shinzonetwork.sourcehub.v1.GenesisState.views":
+		return len(x.Views) != 0
+	case "shinzonetwork.sourcehub.v1.GenesisState.ica_packets":
+		return len(x.IcaPackets) != 0
+	case "shinzonetwork.sourcehub.v1.GenesisState.entity_commands":
+		return len(x.EntityCommands) != 0
+	case "shinzonetwork.sourcehub.v1.GenesisState.ica_commands":
+		return len(x.IcaCommands) != 0
+	case "shinzonetwork.sourcehub.v1.GenesisState.next_command_id":
+		return x.NextCommandId != uint64(0)
+	case "shinzonetwork.sourcehub.v1.GenesisState.dead_letters":
+		return len(x.DeadLetters) != 0
+	case "shinzonetwork.sourcehub.v1.GenesisState.targets":
+		return len(x.Targets) != 0
+	case "shinzonetwork.sourcehub.v1.GenesisState.default_target":
+		return x.DefaultTarget != ""
+	case "shinzonetwork.sourcehub.v1.GenesisState.relationships":
+		return len(x.Relationships) != 0
+	case "shinzonetwork.sourcehub.v1.GenesisState.policy_versions":
+		return len(x.PolicyVersions) != 0
+	case "shinzonetwork.sourcehub.v1.GenesisState.stream_prices":
+		return len(x.StreamPrices) != 0
+	case "shinzonetwork.sourcehub.v1.GenesisState.stream_payments":
+		return len(x.StreamPayments) != 0
+	case "shinzonetwork.sourcehub.v1.GenesisState.stream_revenues":
+		return len(x.StreamRevenues) != 0
+	case "shinzonetwork.sourcehub.v1.GenesisState.pending_rewards":
+		return len(x.PendingRewards) != 0
+	case "shinzonetwork.sourcehub.v1.GenesisState.entity_unbondings":
+		return len(x.EntityUnbondings) != 0
+	case "shinzonetwork.sourcehub.v1.GenesisState.used_registration_messages":
+		return len(x.UsedRegistrationMessages) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: shinzonetwork.sourcehub.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message shinzonetwork.sourcehub.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "shinzonetwork.sourcehub.v1.GenesisState.controller_connection_id":
+		x.ControllerConnectionId = ""
+	case "shinzonetwork.sourcehub.v1.GenesisState.host_connection_id":
+		x.HostConnectionId = ""
+	case "shinzonetwork.sourcehub.v1.GenesisState.version":
+		x.Version = ""
+	case "shinzonetwork.sourcehub.v1.GenesisState.encoding":
+		x.Encoding = ""
+	case "shinzonetwork.sourcehub.v1.GenesisState.tx_type":
+		x.TxType = ""
+	case "shinzonetwork.sourcehub.v1.GenesisState.policy_id":
+		x.PolicyId = ""
+	case "shinzonetwork.sourcehub.v1.GenesisState.params":
+		x.Params = nil
+	case "shinzonetwork.sourcehub.v1.GenesisState.stream_grants":
+		x.StreamGrants = nil
+	case "shinzonetwork.sourcehub.v1.GenesisState.role_holders":
+		x.RoleHolders = nil
+	case "shinzonetwork.sourcehub.v1.GenesisState.entities":
+		x.Entities = nil
+	case "shinzonetwork.sourcehub.v1.GenesisState.views":
+		x.Views = nil
+	case "shinzonetwork.sourcehub.v1.GenesisState.ica_packets":
+		x.IcaPackets = nil
+	case "shinzonetwork.sourcehub.v1.GenesisState.entity_commands":
+		x.EntityCommands = nil
+	case "shinzonetwork.sourcehub.v1.GenesisState.ica_commands":
+		x.IcaCommands = nil
+	case "shinzonetwork.sourcehub.v1.GenesisState.next_command_id":
+		x.NextCommandId = uint64(0)
+	case "shinzonetwork.sourcehub.v1.GenesisState.dead_letters":
+		x.DeadLetters = nil
+	case "shinzonetwork.sourcehub.v1.GenesisState.targets":
+		x.Targets = nil
+	case "shinzonetwork.sourcehub.v1.GenesisState.default_target":
+		x.DefaultTarget = ""
+	case "shinzonetwork.sourcehub.v1.GenesisState.relationships":
+		x.Relationships = nil
+	case "shinzonetwork.sourcehub.v1.GenesisState.policy_versions":
+		x.PolicyVersions = nil
+	case "shinzonetwork.sourcehub.v1.GenesisState.stream_prices":
+		x.StreamPrices = nil
+	case "shinzonetwork.sourcehub.v1.GenesisState.stream_payments":
+		x.StreamPayments = nil
+	case "shinzonetwork.sourcehub.v1.GenesisState.stream_revenues":
+		x.StreamRevenues = nil
+	case "shinzonetwork.sourcehub.v1.GenesisState.pending_rewards":
+		x.PendingRewards = nil
+	case "shinzonetwork.sourcehub.v1.GenesisState.entity_unbondings":
+		x.EntityUnbondings = nil
+	case "shinzonetwork.sourcehub.v1.GenesisState.used_registration_messages":
+		x.UsedRegistrationMessages = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: shinzonetwork.sourcehub.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message shinzonetwork.sourcehub.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "shinzonetwork.sourcehub.v1.GenesisState.controller_connection_id":
+		value := x.ControllerConnectionId
+		return protoreflect.ValueOfString(value)
+	case "shinzonetwork.sourcehub.v1.GenesisState.host_connection_id":
+		value := x.HostConnectionId
+		return protoreflect.ValueOfString(value)
+	case "shinzonetwork.sourcehub.v1.GenesisState.version":
+		value := x.Version
+		return protoreflect.ValueOfString(value)
+	case "shinzonetwork.sourcehub.v1.GenesisState.encoding":
+		value := x.Encoding
+		return protoreflect.ValueOfString(value)
+	case "shinzonetwork.sourcehub.v1.GenesisState.tx_type":
+		value := x.TxType
+		return protoreflect.ValueOfString(value)
+	case "shinzonetwork.sourcehub.v1.GenesisState.policy_id":
+		value := x.PolicyId
+		return protoreflect.ValueOfString(value)
+	case "shinzonetwork.sourcehub.v1.GenesisState.params":
+		value := x.Params
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "shinzonetwork.sourcehub.v1.GenesisState.stream_grants":
+		if len(x.StreamGrants) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_8_list{})
+		}
+		listValue := &_GenesisState_8_list{list: &x.StreamGrants}
+		return protoreflect.ValueOfList(listValue)
+	case "shinzonetwork.sourcehub.v1.GenesisState.role_holders":
+		if len(x.RoleHolders) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_9_list{})
+		}
+		listValue := &_GenesisState_9_list{list: &x.RoleHolders}
+		return protoreflect.ValueOfList(listValue)
+	case "shinzonetwork.sourcehub.v1.GenesisState.entities":
+		if len(x.Entities) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_10_list{})
+		}
+		listValue := &_GenesisState_10_list{list: &x.Entities}
+		return protoreflect.ValueOfList(listValue)
+	case "shinzonetwork.sourcehub.v1.GenesisState.views":
+		if len(x.Views) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_11_list{})
+		}
+		listValue := &_GenesisState_11_list{list: &x.Views}
+		return protoreflect.ValueOfList(listValue)
+	case "shinzonetwork.sourcehub.v1.GenesisState.ica_packets":
+		if len(x.IcaPackets) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_12_list{})
+		}
+		listValue := &_GenesisState_12_list{list: &x.IcaPackets}
+		return protoreflect.ValueOfList(listValue)
+	case "shinzonetwork.sourcehub.v1.GenesisState.entity_commands":
+		if len(x.EntityCommands) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_13_list{})
+		}
+		listValue := &_GenesisState_13_list{list: &x.EntityCommands}
+		return protoreflect.ValueOfList(listValue)
+	case "shinzonetwork.sourcehub.v1.GenesisState.ica_commands":
+		if len(x.IcaCommands) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_15_list{})
+		}
+		listValue := &_GenesisState_15_list{list: &x.IcaCommands}
+		return protoreflect.ValueOfList(listValue)
+	case "shinzonetwork.sourcehub.v1.GenesisState.next_command_id":
+		value := x.NextCommandId
+		return protoreflect.ValueOfUint64(value)
+	case "shinzonetwork.sourcehub.v1.GenesisState.dead_letters":
+		if len(x.DeadLetters) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_17_list{})
+		}
+		listValue := &_GenesisState_17_list{list: &x.DeadLetters}
+		return protoreflect.ValueOfList(listValue)
+	case "shinzonetwork.sourcehub.v1.GenesisState.targets":
+		if len(x.Targets) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_19_list{})
+		}
+		listValue := &_GenesisState_19_list{list: &x.Targets}
+		return protoreflect.ValueOfList(listValue)
+	case "shinzonetwork.sourcehub.v1.GenesisState.default_target":
+		value := x.DefaultTarget
+		return protoreflect.ValueOfString(value)
+	case "shinzonetwork.sourcehub.v1.GenesisState.relationships":
+		if len(x.Relationships) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_21_list{})
+		}
+		listValue := &_GenesisState_21_list{list: &x.Relationships}
+		return protoreflect.ValueOfList(listValue)
+	case "shinzonetwork.sourcehub.v1.GenesisState.policy_versions":
+		if len(x.PolicyVersions) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_22_list{})
+		}
+		listValue := &_GenesisState_22_list{list: &x.PolicyVersions}
+		return protoreflect.ValueOfList(listValue)
+	case "shinzonetwork.sourcehub.v1.GenesisState.stream_prices":
+		if len(x.StreamPrices) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_23_list{})
+		}
+		listValue := &_GenesisState_23_list{list: &x.StreamPrices}
+		return protoreflect.ValueOfList(listValue)
+	case "shinzonetwork.sourcehub.v1.GenesisState.stream_payments":
+		if len(x.StreamPayments) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_24_list{})
+		}
+		listValue := &_GenesisState_24_list{list: &x.StreamPayments}
+		return protoreflect.ValueOfList(listValue)
+	case "shinzonetwork.sourcehub.v1.GenesisState.stream_revenues":
+		if len(x.StreamRevenues) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_25_list{})
+		}
+		listValue := &_GenesisState_25_list{list: &x.StreamRevenues}
+		return protoreflect.ValueOfList(listValue)
+	case "shinzonetwork.sourcehub.v1.GenesisState.pending_rewards":
+		if len(x.PendingRewards) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_26_list{})
+		}
+		listValue := &_GenesisState_26_list{list: &x.PendingRewards}
+		return protoreflect.ValueOfList(listValue)
+	case "shinzonetwork.sourcehub.v1.GenesisState.entity_unbondings":
+		if len(x.EntityUnbondings) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_27_list{})
+		}
+		listValue := &_GenesisState_27_list{list: &x.EntityUnbondings}
+		return protoreflect.ValueOfList(listValue)
+	case "shinzonetwork.sourcehub.v1.GenesisState.used_registration_messages":
+		if len(x.UsedRegistrationMessages) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_28_list{})
+		}
+		listValue := &_GenesisState_28_list{list: &x.UsedRegistrationMessages}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: shinzonetwork.sourcehub.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message shinzonetwork.sourcehub.v1.GenesisState does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "shinzonetwork.sourcehub.v1.GenesisState.controller_connection_id":
+		x.ControllerConnectionId = value.Interface().(string)
+	case "shinzonetwork.sourcehub.v1.GenesisState.host_connection_id":
+		x.HostConnectionId = value.Interface().(string)
+	case "shinzonetwork.sourcehub.v1.GenesisState.version":
+		x.Version = value.Interface().(string)
+	case "shinzonetwork.sourcehub.v1.GenesisState.encoding":
+		x.Encoding = value.Interface().(string)
+	case "shinzonetwork.sourcehub.v1.GenesisState.tx_type":
+		x.TxType = value.Interface().(string)
+	case "shinzonetwork.sourcehub.v1.GenesisState.policy_id":
+		x.PolicyId = value.Interface().(string)
+	case "shinzonetwork.sourcehub.v1.GenesisState.params":
+		x.Params = value.Message().Interface().(*Params)
+	case "shinzonetwork.sourcehub.v1.GenesisState.stream_grants":
+		lv := value.List()
+		clv := lv.(*_GenesisState_8_list)
+		x.StreamGrants = *clv.list
+	case "shinzonetwork.sourcehub.v1.GenesisState.role_holders":
+		lv := value.List()
+		clv := lv.(*_GenesisState_9_list)
+		x.RoleHolders = *clv.list
+	case "shinzonetwork.sourcehub.v1.GenesisState.entities":
+		lv := value.List()
+		clv := lv.(*_GenesisState_10_list)
+		x.Entities = *clv.list
+	case "shinzonetwork.sourcehub.v1.GenesisState.views":
+		lv := value.List()
+		clv := lv.(*_GenesisState_11_list)
+		x.Views = *clv.list
+	case "shinzonetwork.sourcehub.v1.GenesisState.ica_packets":
+		lv := value.List()
+		clv := lv.(*_GenesisState_12_list)
+		x.IcaPackets = *clv.list
+	case "shinzonetwork.sourcehub.v1.GenesisState.entity_commands":
+		lv := value.List()
+		clv := lv.(*_GenesisState_13_list)
+		x.EntityCommands = *clv.list
+	case "shinzonetwork.sourcehub.v1.GenesisState.ica_commands":
+		lv := value.List()
+		clv := lv.(*_GenesisState_15_list)
+		x.IcaCommands = *clv.list
+	case "shinzonetwork.sourcehub.v1.GenesisState.next_command_id":
+		x.NextCommandId = value.Uint()
+	case "shinzonetwork.sourcehub.v1.GenesisState.dead_letters":
+		lv := value.List()
+		clv := lv.(*_GenesisState_17_list)
+		x.DeadLetters = *clv.list
+	case "shinzonetwork.sourcehub.v1.GenesisState.targets":
+		lv := value.List()
+		clv := lv.(*_GenesisState_19_list)
+		x.Targets = *clv.list
+	case "shinzonetwork.sourcehub.v1.GenesisState.default_target":
+		x.DefaultTarget = value.Interface().(string)
+	case "shinzonetwork.sourcehub.v1.GenesisState.relationships":
+		lv := value.List()
+		clv := lv.(*_GenesisState_21_list)
+		x.Relationships = *clv.list
+	case "shinzonetwork.sourcehub.v1.GenesisState.policy_versions":
+		lv := value.List()
+		clv := lv.(*_GenesisState_22_list)
+		x.PolicyVersions = *clv.list
+	case "shinzonetwork.sourcehub.v1.GenesisState.stream_prices":
+		lv := value.List()
+		clv := lv.(*_GenesisState_23_list)
+		x.StreamPrices = *clv.list
+	case "shinzonetwork.sourcehub.v1.GenesisState.stream_payments":
+		lv := value.List()
+		clv := lv.(*_GenesisState_24_list)
+		x.StreamPayments = *clv.list
+	case "shinzonetwork.sourcehub.v1.GenesisState.stream_revenues":
+		lv := value.List()
+		clv := lv.(*_GenesisState_25_list)
+		x.StreamRevenues = *clv.list
+	case "shinzonetwork.sourcehub.v1.GenesisState.pending_rewards":
+		lv := value.List()
+		clv := lv.(*_GenesisState_26_list)
+		x.PendingRewards = *clv.list
+	case "shinzonetwork.sourcehub.v1.GenesisState.entity_unbondings":
+		lv := value.List()
+		clv := lv.(*_GenesisState_27_list)
+		x.EntityUnbondings = *clv.list
+	case "shinzonetwork.sourcehub.v1.GenesisState.used_registration_messages":
+		lv := value.List()
+		clv := lv.(*_GenesisState_28_list)
+		x.UsedRegistrationMessages = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: shinzonetwork.sourcehub.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message shinzonetwork.sourcehub.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "shinzonetwork.sourcehub.v1.GenesisState.params":
+		if x.Params == nil {
+			x.Params = new(Params)
+		}
+		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+	case "shinzonetwork.sourcehub.v1.GenesisState.stream_grants":
+		if x.StreamGrants == nil {
+			x.StreamGrants = []*StreamGrant{}
+		}
+		value := &_GenesisState_8_list{list: &x.StreamGrants}
+		return protoreflect.ValueOfList(value)
+	case "shinzonetwork.sourcehub.v1.GenesisState.role_holders":
+		if x.RoleHolders == nil {
+			x.RoleHolders = []*RoleHolder{}
+		}
+		value := &_GenesisState_9_list{list: &x.RoleHolders}
+		return protoreflect.ValueOfList(value)
+	case "shinzonetwork.sourcehub.v1.GenesisState.entities":
+		if x.Entities == nil {
+			x.Entities = []*Entity{}
+		}
+		value := &_GenesisState_10_list{list: &x.Entities}
+		return protoreflect.ValueOfList(value)
+	case "shinzonetwork.sourcehub.v1.GenesisState.views":
+		if x.Views == nil {
+			x.Views = []*View{}
+		}
+		value := &_GenesisState_11_list{list: &x.Views}
+		return protoreflect.ValueOfList(value)
+	case "shinzonetwork.sourcehub.v1.GenesisState.ica_packets":
+		if x.IcaPackets == nil {
+			x.IcaPackets = []*IcaPacket{}
+		}
+		value := &_GenesisState_12_list{list: &x.IcaPackets}
+		return protoreflect.ValueOfList(value)
+	case "shinzonetwork.sourcehub.v1.GenesisState.entity_commands":
+		if x.EntityCommands == nil {
+			x.EntityCommands = []*EntityCommand{}
+		}
+		value := &_GenesisState_13_list{list: &x.EntityCommands}
+		return protoreflect.ValueOfList(value)
+	case "shinzonetwork.sourcehub.v1.GenesisState.ica_commands":
+		if x.IcaCommands == nil {
+			x.IcaCommands = []*IcaCommand{}
+		}
+		value := &_GenesisState_15_list{list: &x.IcaCommands}
+		return protoreflect.ValueOfList(value)
+	case "shinzonetwork.sourcehub.v1.GenesisState.dead_letters":
+		if x.DeadLetters == nil {
+			x.DeadLetters = []uint64{}
+		}
+		value := &_GenesisState_17_list{list: &x.DeadLetters}
+		return protoreflect.ValueOfList(value)
+	case "shinzonetwork.sourcehub.v1.GenesisState.targets":
+		if x.Targets == nil {
+			x.Targets = []*SourcehubTarget{}
+		}
+		value := &_GenesisState_19_list{list: &x.Targets}
+		return protoreflect.ValueOfList(value)
+	case "shinzonetwork.sourcehub.v1.GenesisState.relationships":
+		if x.Relationships == nil {
+			x.Relationships = []*Relationship{}
+		}
+		value := &_GenesisState_21_list{list: &x.Relationships}
+		return protoreflect.ValueOfList(value)
+	case "shinzonetwork.sourcehub.v1.GenesisState.policy_versions":
+		if x.PolicyVersions == nil {
+			x.PolicyVersions = []*PolicyVersion{}
+		}
+		value := &_GenesisState_22_list{list: &x.PolicyVersions}
+		return protoreflect.ValueOfList(value)
+	case "shinzonetwork.sourcehub.v1.GenesisState.stream_prices":
+		if x.StreamPrices == nil {
+			x.StreamPrices = []*StreamPrice{}
+		}
+		value := &_GenesisState_23_list{list: &x.StreamPrices}
+		return protoreflect.ValueOfList(value)
+	case "shinzonetwork.sourcehub.v1.GenesisState.stream_payments":
+		if x.StreamPayments == nil {
+			x.StreamPayments = []*StreamPayment{}
+		}
+		value := &_GenesisState_24_list{list: &x.StreamPayments}
+		return protoreflect.ValueOfList(value)
+	case "shinzonetwork.sourcehub.v1.GenesisState.stream_revenues":
+		if x.StreamRevenues == nil {
+			x.StreamRevenues = []*StreamRevenue{}
+		}
+		value := &_GenesisState_25_list{list: &x.StreamRevenues}
+		return protoreflect.ValueOfList(value)
+	case "shinzonetwork.sourcehub.v1.GenesisState.pending_rewards":
+		if x.PendingRewards == nil {
+			x.PendingRewards = []*PendingReward{}
+		}
+		value := &_GenesisState_26_list{list: &x.PendingRewards}
+		return protoreflect.ValueOfList(value)
+	case "shinzonetwork.sourcehub.v1.GenesisState.entity_unbondings":
+		if x.EntityUnbondings == nil {
+			x.EntityUnbondings = []*EntityUnbonding{}
+		}
+		value := &_GenesisState_27_list{list: &x.EntityUnbondings}
+		return protoreflect.ValueOfList(value)
+	case "shinzonetwork.sourcehub.v1.GenesisState.used_registration_messages":
+		if x.UsedRegistrationMessages == nil {
+			x.UsedRegistrationMessages = []*UsedRegistrationMessage{}
+		}
+		value := &_GenesisState_28_list{list: &x.UsedRegistrationMessages}
+		return protoreflect.ValueOfList(value)
+	case "shinzonetwork.sourcehub.v1.GenesisState.controller_connection_id":
+		panic(fmt.Errorf("field controller_connection_id of message shinzonetwork.sourcehub.v1.GenesisState is not mutable"))
+	case "shinzonetwork.sourcehub.v1.GenesisState.host_connection_id":
+		panic(fmt.Errorf("field host_connection_id of message shinzonetwork.sourcehub.v1.GenesisState is not mutable"))
+	case "shinzonetwork.sourcehub.v1.GenesisState.version":
+		panic(fmt.Errorf("field version of message shinzonetwork.sourcehub.v1.GenesisState is not mutable"))
+	case "shinzonetwork.sourcehub.v1.GenesisState.encoding":
+		panic(fmt.Errorf("field encoding of message shinzonetwork.sourcehub.v1.GenesisState is not mutable"))
+	case "shinzonetwork.sourcehub.v1.GenesisState.tx_type":
+		panic(fmt.Errorf("field tx_type of message shinzonetwork.sourcehub.v1.GenesisState is not mutable"))
+	case "shinzonetwork.sourcehub.v1.GenesisState.policy_id":
+		panic(fmt.Errorf("field policy_id of message shinzonetwork.sourcehub.v1.GenesisState is not mutable"))
+	case "shinzonetwork.sourcehub.v1.GenesisState.next_command_id":
+		panic(fmt.Errorf("field next_command_id of message shinzonetwork.sourcehub.v1.GenesisState is not mutable"))
+	case "shinzonetwork.sourcehub.v1.GenesisState.default_target":
+		panic(fmt.Errorf("field default_target of message shinzonetwork.sourcehub.v1.GenesisState is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: shinzonetwork.sourcehub.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message shinzonetwork.sourcehub.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "shinzonetwork.sourcehub.v1.GenesisState.controller_connection_id":
+		return protoreflect.ValueOfString("")
+	case "shinzonetwork.sourcehub.v1.GenesisState.host_connection_id":
+		return protoreflect.ValueOfString("")
+	case "shinzonetwork.sourcehub.v1.GenesisState.version":
+		return protoreflect.ValueOfString("")
+	case "shinzonetwork.sourcehub.v1.GenesisState.encoding":
+		return protoreflect.ValueOfString("")
+	case "shinzonetwork.sourcehub.v1.GenesisState.tx_type":
+		return protoreflect.ValueOfString("")
+	case "shinzonetwork.sourcehub.v1.GenesisState.policy_id":
+		return protoreflect.ValueOfString("")
+	case "shinzonetwork.sourcehub.v1.GenesisState.params":
+		m := new(Params)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "shinzonetwork.sourcehub.v1.GenesisState.stream_grants":
+		list := []*StreamGrant{}
+		return protoreflect.ValueOfList(&_GenesisState_8_list{list: &list})
+	case "shinzonetwork.sourcehub.v1.GenesisState.role_holders":
+		list := []*RoleHolder{}
+		return protoreflect.ValueOfList(&_GenesisState_9_list{list: &list})
+	case "shinzonetwork.sourcehub.v1.GenesisState.entities":
+		list := []*Entity{}
+		return protoreflect.ValueOfList(&_GenesisState_10_list{list: &list})
+	case "shinzonetwork.sourcehub.v1.GenesisState.views":
+		list := []*View{}
+		return protoreflect.ValueOfList(&_GenesisState_11_list{list: &list})
+	case "shinzonetwork.sourcehub.v1.GenesisState.ica_packets":
+		list := []*IcaPacket{}
+		return protoreflect.ValueOfList(&_GenesisState_12_list{list: &list})
+	case "shinzonetwork.sourcehub.v1.GenesisState.entity_commands":
+		list := []*EntityCommand{}
+		return protoreflect.ValueOfList(&_GenesisState_13_list{list: &list})
+	case "shinzonetwork.sourcehub.v1.GenesisState.ica_commands":
+		list := []*IcaCommand{}
+		return protoreflect.ValueOfList(&_GenesisState_15_list{list: &list})
+	case "shinzonetwork.sourcehub.v1.GenesisState.next_command_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "shinzonetwork.sourcehub.v1.GenesisState.dead_letters":
+		list := []uint64{}
+		return protoreflect.ValueOfList(&_GenesisState_17_list{list: &list})
+	case "shinzonetwork.sourcehub.v1.GenesisState.targets":
+		list := []*SourcehubTarget{}
+		return protoreflect.ValueOfList(&_GenesisState_19_list{list: &list})
+	case "shinzonetwork.sourcehub.v1.GenesisState.default_target":
+		return protoreflect.ValueOfString("")
+	case "shinzonetwork.sourcehub.v1.GenesisState.relationships":
+		list := []*Relationship{}
+		return protoreflect.ValueOfList(&_GenesisState_21_list{list: &list})
+	case "shinzonetwork.sourcehub.v1.GenesisState.policy_versions":
+		list := []*PolicyVersion{}
+		return protoreflect.ValueOfList(&_GenesisState_22_list{list: &list})
+	case "shinzonetwork.sourcehub.v1.GenesisState.stream_prices":
+		list := []*StreamPrice{}
+		return protoreflect.ValueOfList(&_GenesisState_23_list{list: &list})
+	case "shinzonetwork.sourcehub.v1.GenesisState.stream_payments":
+		list := []*StreamPayment{}
+		return protoreflect.ValueOfList(&_GenesisState_24_list{list: &list})
+	case "shinzonetwork.sourcehub.v1.GenesisState.stream_revenues":
+		list := []*StreamRevenue{}
+		return protoreflect.ValueOfList(&_GenesisState_25_list{list: &list})
+	case "shinzonetwork.sourcehub.v1.GenesisState.pending_rewards":
+		list := []*PendingReward{}
+		return protoreflect.ValueOfList(&_GenesisState_26_list{list: &list})
+	case "shinzonetwork.sourcehub.v1.GenesisState.entity_unbondings":
+		list := []*EntityUnbonding{}
+		return protoreflect.ValueOfList(&_GenesisState_27_list{list: &list})
+	case "shinzonetwork.sourcehub.v1.GenesisState.used_registration_messages":
+		list := []*UsedRegistrationMessage{}
+		return protoreflect.ValueOfList(&_GenesisState_28_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: shinzonetwork.sourcehub.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message shinzonetwork.sourcehub.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_GenesisState) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in shinzonetwork.sourcehub.v1.GenesisState", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_GenesisState) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_GenesisState) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*GenesisState)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ControllerConnectionId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.HostConnectionId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Version)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Encoding)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.TxType)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.PolicyId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Params != nil {
+			l = options.Size(x.Params)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.StreamGrants) > 0 {
+			for _, e := range x.StreamGrants {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.RoleHolders) > 0 {
+			for _, e := range x.RoleHolders {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Entities) > 0 {
+			for _, e := range x.Entities {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Views) > 0 {
+			for _, e := range x.Views {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.IcaPackets) > 0 {
+			for _, e := range x.IcaPackets {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.EntityCommands) > 0 {
+			for _, e := range x.EntityCommands {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.IcaCommands) > 0 {
+			for _, e := range x.IcaCommands {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.NextCommandId != 0 {
+			n += 2 + runtime.Sov(uint64(x.NextCommandId))
+		}
+		if len(x.DeadLetters) > 0 {
+			l = 0
+			for _, e := range x.DeadLetters {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 2 + runtime.Sov(uint64(l)) + l
+		}
+		if len(x.Targets) > 0 {
+			for _, e := range x.Targets {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.DefaultTarget)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Relationships) > 0 {
+			for _, e := range x.Relationships {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.PolicyVersions) > 0 {
+			for _, e := range x.PolicyVersions {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.StreamPrices) > 0 {
+			for _, e := range x.StreamPrices {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.StreamPayments) > 0 {
+			for _, e := range x.StreamPayments {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.StreamRevenues) > 0 {
+			for _, e := range x.StreamRevenues {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.PendingRewards) > 0 {
+			for _, e := range x.PendingRewards {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.EntityUnbondings) > 0 {
+			for _, e := range x.EntityUnbondings {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.UsedRegistrationMessages) > 0 {
+			for _, e := range x.UsedRegistrationMessages {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*GenesisState)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.UsedRegistrationMessages) > 0 {
+			for iNdEx := len(x.UsedRegistrationMessages) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.UsedRegistrationMessages[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xe2
+			}
+		}
+		if len(x.EntityUnbondings) > 0 {
+			for iNdEx := len(x.EntityUnbondings) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.EntityUnbondings[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xda
+			}
+		}
+		if len(x.PendingRewards) > 0 {
+			for iNdEx := len(x.PendingRewards) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PendingRewards[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xd2
+			}
+		}
+		if len(x.StreamRevenues) > 0 {
+			for iNdEx := len(x.StreamRevenues) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.StreamRevenues[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xca
+			}
+		}
+		if len(x.StreamPayments) > 0 {
+			for iNdEx := len(x.StreamPayments) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.StreamPayments[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xc2
+			}
+		}
+		if len(x.StreamPrices) > 0 {
+			for iNdEx := len(x.StreamPrices) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.StreamPrices[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xba
+			}
+		}
+		if len(x.PolicyVersions) > 0 {
+			for iNdEx := len(x.PolicyVersions) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PolicyVersions[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xb2
+			}
+		}
+		if len(x.Relationships) > 0 {
+			for iNdEx := len(x.Relationships) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Relationships[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xaa
+			}
+		}
+		if len(x.DefaultTarget) > 0 {
+			i -= len(x.DefaultTarget)
+			copy(dAtA[i:], x.DefaultTarget)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DefaultTarget)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+		if len(x.Targets) > 0 {
+			for iNdEx := len(x.Targets) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Targets[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x9a
+			}
+		}
+		if len(x.DeadLetters) > 0 {
+			var pksize2 int
+			for _, num := range x.DeadLetters {
+				pksize2 += runtime.Sov(uint64(num))
+			}
+			i -= pksize2
+			j1 := i
+			for _, num := range x.DeadLetters {
+				for num >= 1<<7 {
+					dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j1++
+				}
+				dAtA[j1] = uint8(num)
+				j1++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+		if x.NextCommandId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NextCommandId))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x80
+		}
+		if len(x.IcaCommands) > 0 {
+			for iNdEx := len(x.IcaCommands) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.IcaCommands[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x7a
+			}
+		}
+		if len(x.EntityCommands) > 0 {
+			for iNdEx := len(x.EntityCommands) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.EntityCommands[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x6a
+			}
+		}
+		if len(x.IcaPackets) > 0 {
+			for iNdEx := len(x.IcaPackets) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.IcaPackets[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x62
+			}
+		}
+		if len(x.Views) > 0 {
+			for iNdEx := len(x.Views) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Views[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x5a
+			}
+		}
+		if len(x.Entities) > 0 {
+			for iNdEx := len(x.Entities) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Entities[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x52
+			}
+		}
+		if len(x.RoleHolders) > 0 {
+			for iNdEx := len(x.RoleHolders) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.RoleHolders[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x4a
+			}
+		}
+		if len(x.StreamGrants) > 0 {
+			for iNdEx := len(x.StreamGrants) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.StreamGrants[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x42
+			}
+		}
+		if x.Params != nil {
+			encoded, err := options.Marshal(x.Params)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.PolicyId) > 0 {
+			i -= len(x.PolicyId)
+			copy(dAtA[i:], x.PolicyId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PolicyId)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.TxType) > 0 {
+			i -= len(x.TxType)
+			copy(dAtA[i:], x.TxType)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TxType)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Encoding) > 0 {
+			i -= len(x.Encoding)
+			copy(dAtA[i:], x.Encoding)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Encoding)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Version) > 0 {
+			i -= len(x.Version)
+			copy(dAtA[i:], x.Version)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Version)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.HostConnectionId) > 0 {
+			i -= len(x.HostConnectionId)
+			copy(dAtA[i:], x.HostConnectionId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.HostConnectionId)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.ControllerConnectionId) > 0 {
+			i -= len(x.ControllerConnectionId)
+			copy(dAtA[i:], x.ControllerConnectionId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ControllerConnectionId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*GenesisState)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ControllerConnectionId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ControllerConnectionId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HostConnectionId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.HostConnectionId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Version = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Encoding", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Encoding = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TxType", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TxType = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PolicyId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PolicyId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Params == nil {
+					x.Params = &Params{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StreamGrants", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.StreamGrants = append(x.StreamGrants, &StreamGrant{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.StreamGrants[len(x.StreamGrants)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RoleHolders", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RoleHolders = append(x.RoleHolders, &RoleHolder{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RoleHolders[len(x.RoleHolders)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Entities", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Entities = append(x.Entities, &Entity{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Entities[len(x.Entities)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Views", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Views = append(x.Views, &View{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Views[len(x.Views)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IcaPackets", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.IcaPackets = append(x.IcaPackets, &IcaPacket{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.IcaPackets[len(x.IcaPackets)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EntityCommands", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EntityCommands = append(x.EntityCommands, &EntityCommand{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.EntityCommands[len(x.EntityCommands)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 15:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IcaCommands", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.IcaCommands = append(x.IcaCommands, &IcaCommand{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.IcaCommands[len(x.IcaCommands)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 16:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextCommandId", wireType)
+				}
+				x.NextCommandId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NextCommandId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 17:
+				if wireType == 0 {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.DeadLetters = append(x.DeadLetters, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					var count int
+					for _, integer := range dAtA[iNdEx:postIndex] {
+						if integer < 128 {
+							count++
+						}
+					}
+					elementCount = count
+					if elementCount != 0 && len(x.DeadLetters) == 0 {
+						x.DeadLetters = make([]uint64, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.DeadLetters = append(x.DeadLetters, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DeadLetters", wireType)
+				}
+			case 19:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Targets", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if iNdEx >= l {
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				x.Targets = append(x.Targets, &SourcehubTarget{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Targets[len(x.Targets)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
+				iNdEx = postIndex
+			case 20:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ControllerConnectionId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DefaultTarget", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -599,13 +3047,13 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ControllerConnectionId = string(dAtA[iNdEx:postIndex])
+				x.DefaultTarget = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 2:
+			case 21:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HostConnectionId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Relationships", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -615,29 +3063,31 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.HostConnectionId = string(dAtA[iNdEx:postIndex])
+				x.Relationships = append(x.Relationships, &Relationship{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Relationships[len(x.Relationships)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
-			case 3:
+			case 22:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PolicyVersions", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -647,29 +3097,31 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Version = string(dAtA[iNdEx:postIndex])
+				x.PolicyVersions = append(x.PolicyVersions, &PolicyVersion{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PolicyVersions[len(x.PolicyVersions)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
-			case 4:
+			case 23:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Encoding", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StreamPrices", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -679,29 +3131,31 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Encoding = string(dAtA[iNdEx:postIndex])
+				x.StreamPrices = append(x.StreamPrices, &StreamPrice{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.StreamPrices[len(x.StreamPrices)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
-			case 5:
+			case 24:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TxType", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StreamPayments", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -711,29 +3165,31 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.TxType = string(dAtA[iNdEx:postIndex])
+				x.StreamPayments = append(x.StreamPayments, &StreamPayment{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.StreamPayments[len(x.StreamPayments)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
-			case 6:
+			case 25:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PolicyId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StreamRevenues", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -743,27 +3199,29 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.PolicyId = string(dAtA[iNdEx:postIndex])
+				x.StreamRevenues = append(x.StreamRevenues, &StreamRevenue{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.StreamRevenues[len(x.StreamRevenues)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
-			case 7:
+			case 26:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PendingRewards", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -790,10 +3248,76 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Params == nil {
-					x.Params = &Params{}
+				x.PendingRewards = append(x.PendingRewards, &PendingReward{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PendingRewards[len(x.PendingRewards)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+				iNdEx = postIndex
+			case 27:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EntityUnbondings", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EntityUnbondings = append(x.EntityUnbondings, &EntityUnbonding{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.EntityUnbondings[len(x.EntityUnbondings)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 28:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UsedRegistrationMessages", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.UsedRegistrationMessages = append(x.UsedRegistrationMessages, &UsedRegistrationMessage{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.UsedRegistrationMessages[len(x.UsedRegistrationMessages)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -864,6 +3388,45 @@ type GenesisState struct {
 	// Policy ID for shinzohub on sourcehub
 	PolicyId string  `protobuf:"bytes,6,opt,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty"`
 	Params   *Params `protobuf:"bytes,7,opt,name=params,proto3" json:"params,omitempty"`
+	// Stream access grants and their expirations
+	StreamGrants []*StreamGrant `protobuf:"bytes,8,rep,name=stream_grants,json=streamGrants,proto3" json:"stream_grants,omitempty"`
+	// Module role assignments
+	RoleHolders []*RoleHolder `protobuf:"bytes,9,rep,name=role_holders,json=roleHolders,proto3" json:"role_holders,omitempty"`
+	// Registered indexers and hosts
+	Entities []*Entity `protobuf:"bytes,10,rep,name=entities,proto3" json:"entities,omitempty"`
+	// Registered views and their creators
+	Views []*View `protobuf:"bytes,11,rep,name=views,proto3" json:"views,omitempty"`
+	// ICA packets sent to SourceHub and their outcomes
+	IcaPackets []*IcaPacket `protobuf:"bytes,12,rep,name=ica_packets,json=icaPackets,proto3" json:"ica_packets,omitempty"`
+	// Unresolved outbox commands carrying an entity group membership
+	EntityCommands []*EntityCommand `protobuf:"bytes,13,rep,name=entity_commands,json=entityCommands,proto3" json:"entity_commands,omitempty"`
+	// Outbox commands, queued or sent
+	IcaCommands []*IcaCommand `protobuf:"bytes,15,rep,name=ica_commands,json=icaCommands,proto3" json:"ica_commands,omitempty"`
+	// Next outbox command ID
+	NextCommandId uint64 `protobuf:"varint,16,opt,name=next_command_id,json=nextCommandId,proto3" json:"next_command_id,omitempty"`
+	// IDs of the commands that exhausted their attempts
+	DeadLetters []uint64 `protobuf:"varint,17,rep,packed,name=dead_letters,json=deadLetters,proto3" json:"dead_letters,omitempty"`
+	// SourceHub chains the module is connected to
+	Targets []*SourcehubTarget `protobuf:"bytes,19,rep,name=targets,proto3" json:"targets,omitempty"`
+	// Chain ID of the target used when none is given
+	DefaultTarget string `protobuf:"bytes,20,opt,name=default_target,json=defaultTarget,proto3" json:"default_target,omitempty"`
+	// Mirror of the ACP relationships sent to SourceHub
+	Relationships []*Relationship `protobuf:"bytes,21,rep,name=relationships,proto3" json:"relationships,omitempty"`
+	// Versions of the shinzohub policy adopted through governance
+	PolicyVersions []*PolicyVersion `protobuf:"bytes,22,rep,name=policy_versions,json=policyVersions,proto3" json:"policy_versions,omitempty"`
+	// Prices of the streams open to MsgBuyStreamAccess
+	StreamPrices []*StreamPrice `protobuf:"bytes,23,rep,name=stream_prices,json=streamPrices,proto3" json:"stream_prices,omitempty"`
+	// Payments held in escrow until SourceHub acknowledges the access they bought
+	StreamPayments []*StreamPayment `protobuf:"bytes,24,rep,name=stream_payments,json=streamPayments,proto3" json:"stream_payments,omitempty"`
+	// Subscription fees collected in the current epoch
+	StreamRevenues []*StreamRevenue `protobuf:"bytes,25,rep,name=stream_revenues,json=streamRevenues,proto3" json:"stream_revenues,omitempty"`
+	// Rewards credited and not withdrawn yet
+	PendingRewards []*PendingReward `protobuf:"bytes,26,rep,name=pending_rewards,json=pendingRewards,proto3" json:"pending_rewards,omitempty"`
+	// Bonds of removed or blocked entities waiting for the end of their
+	// unbonding period
+	EntityUnbondings []*EntityUnbonding `protobuf:"bytes,27,rep,name=entity_unbondings,json=entityUnbondings,proto3" json:"entity_unbondings,omitempty"`
+	// Registration messages already used that have not expired yet
+	UsedRegistrationMessages []*UsedRegistrationMessage `protobuf:"bytes,28,rep,name=used_registration_messages,json=usedRegistrationMessages,proto3" json:"used_registration_messages,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -935,6 +3498,139 @@ func (x *GenesisState) GetParams() *Params {
 	return nil
 }
 
+func (x *GenesisState) GetStreamGrants() []*StreamGrant {
+	if x != nil {
+		return x.StreamGrants
+	}
+	return nil
+}
+
+func (x *GenesisState) GetRoleHolders() []*RoleHolder {
+	if x != nil {
+		return x.RoleHolders
+	}
+	return nil
+}
+
+func (x *GenesisState) GetEntities() []*Entity {
+	if x != nil {
+		return x.Entities
+	}
+	return nil
+}
+
+func (x *GenesisState) GetViews() []*View {
+	if x != nil {
+		return x.Views
+	}
+	return nil
+}
+
+func (x *GenesisState) GetIcaPackets() []*IcaPacket {
+	if x != nil {
+		return x.IcaPackets
+	}
+	return nil
+}
+
+func (x *GenesisState) GetEntityCommands() []*EntityCommand {
+	if x != nil {
+		return x.EntityCommands
+	}
+	return nil
+}
+
+func (x *GenesisState) GetIcaCommands() []*IcaCommand {
+	if x != nil {
+		return x.IcaCommands
+	}
+	return nil
+}
+
+func (x *GenesisState) GetNextCommandId() uint64 {
+	if x != nil {
+		return x.NextCommandId
+	}
+	return 0
+}
+
+func (x *GenesisState) GetDeadLetters() []uint64 {
+	if x != nil {
+		return x.DeadLetters
+	}
+	return nil
+}
+
+func (x *GenesisState) GetTargets() []*SourcehubTarget {
+	if x != nil {
+		return x.Targets
+	}
+	return nil
+}
+
+func (x *GenesisState) GetDefaultTarget() string {
+	if x != nil {
+		return x.DefaultTarget
+	}
+	return ""
+}
+
+func (x *GenesisState) GetRelationships() []*Relationship {
+	if x != nil {
+		return x.Relationships
+	}
+	return nil
+}
+
+func (x *GenesisState) GetPolicyVersions() []*PolicyVersion {
+	if x != nil {
+		return x.PolicyVersions
+	}
+	return nil
+}
+
+func (x *GenesisState) GetStreamPrices() []*StreamPrice {
+	if x != nil {
+		return x.StreamPrices
+	}
+	return nil
+}
+
+func (x *GenesisState) GetStreamPayments() []*StreamPayment {
+	if x != nil {
+		return x.StreamPayments
+	}
+	return nil
+}
+
+func (x *GenesisState) GetStreamRevenues() []*StreamRevenue {
+	if x != nil {
+		return x.StreamRevenues
+	}
+	return nil
+}
+
+func (x *GenesisState) GetPendingRewards() []*PendingReward {
+	if x != nil {
+		return x.PendingRewards
+	}
+	return nil
+}
+
+func (x *GenesisState) GetEntityUnbondings() []*EntityUnbonding {
+	if x != nil {
+		return x.EntityUnbondings
+	}
+	return nil
+}
+
+func (x *GenesisState) GetUsedRegistrationMessages() []*UsedRegistrationMessage {
+	if x != nil {
+		return x.UsedRegistrationMessages
+	}
+	return nil
+}
+
 var File_shinzonetwork_sourcehub_v1_genesis_proto protoreflect.FileDescriptor
 
 var file_shinzonetwork_sourcehub_v1_genesis_proto_rawDesc = []byte{
@@ -945,43 +3641,163 @@ var file_shinzonetwork_sourcehub_v1_genesis_proto_rawDesc = []byte{
 	0x68, 0x75, 0x62, 0x2e, 0x76, 0x31, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x27, 0x73, 0x68,
 	0x69, 0x6e, 0x7a, 0x6f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x68, 0x75, 0x62, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa4, 0x02, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69,
-	0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x38, 0x0a, 0x18, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
-	0x6c, 0x6c, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
-	0x6c, 0x6c, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
-	0x12, 0x2c, 0x0a, 0x12, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x68, 0x6f,
-	0x73, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x18,
-	0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x63, 0x6f,
-	0x64, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x63, 0x6f,
-	0x64, 0x69, 0x6e, 0x67, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x78, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x78, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a,
-	0x09, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x49, 0x64, 0x12, 0x40, 0x0a, 0x06, 0x70, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x68, 0x69,
-	0x6e, 0x7a, 0x6f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x68, 0x75, 0x62, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x04,
-	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x87, 0x02, 0x0a,
-	0x1e, 0x63, 0x6f, 0x6d, 0x2e, 0x73, 0x68, 0x69, 0x6e, 0x7a, 0x6f, 0x6e, 0x65, 0x74, 0x77, 0x6f,
-	0x72, 0x6b, 0x2e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x68, 0x75, 0x62, 0x2e, 0x76, 0x31, 0x42,
-	0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
-	0x4d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x68, 0x69, 0x6e,
-	0x7a, 0x6f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x73, 0x68, 0x69, 0x6e, 0x7a, 0x6f,
-	0x68, 0x75, 0x62, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x68, 0x69, 0x6e, 0x7a, 0x6f, 0x6e, 0x65,
+	0x63, 0x65, 0x68, 0x75, 0x62, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x26, 0x73, 0x68, 0x69, 0x6e, 0x7a, 0x6f, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x68, 0x75, 0x62, 0x2f, 0x76,
+	0x31, 0x2f, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x27, 0x73,
+	0x68, 0x69, 0x6e, 0x7a, 0x6f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x68, 0x75, 0x62, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x27, 0x73, 0x68, 0x69, 0x6e, 0x7a, 0x6f, 0x6e, 0x65,
 	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x68, 0x75, 0x62, 0x2f,
-	0x76, 0x31, 0x3b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x68, 0x75, 0x62, 0x76, 0x31, 0xa2, 0x02,
-	0x03, 0x53, 0x53, 0x58, 0xaa, 0x02, 0x1a, 0x53, 0x68, 0x69, 0x6e, 0x7a, 0x6f, 0x6e, 0x65, 0x74,
-	0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x68, 0x75, 0x62, 0x2e, 0x56,
-	0x31, 0xca, 0x02, 0x1a, 0x53, 0x68, 0x69, 0x6e, 0x7a, 0x6f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
-	0x6b, 0x5c, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x68, 0x75, 0x62, 0x5c, 0x56, 0x31, 0xe2, 0x02,
-	0x26, 0x53, 0x68, 0x69, 0x6e, 0x7a, 0x6f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5c, 0x53,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x68, 0x75, 0x62, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1c, 0x53, 0x68, 0x69, 0x6e, 0x7a, 0x6f,
-	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x3a, 0x3a, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x68,
-	0x75, 0x62, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x76, 0x31, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x28, 0x73, 0x68, 0x69, 0x6e, 0x7a, 0x6f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x68, 0x75, 0x62, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x27, 0x73, 0x68, 0x69, 0x6e, 0x7a,
+	0x6f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x68,
+	0x75, 0x62, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x2d, 0x73, 0x68, 0x69, 0x6e, 0x7a, 0x6f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x2f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x68, 0x75, 0x62, 0x2f, 0x76, 0x31, 0x2f, 0x72,
+	0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x28, 0x73, 0x68, 0x69, 0x6e, 0x7a, 0x6f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x2f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x68, 0x75, 0x62, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65,
+	0x76, 0x65, 0x6e, 0x75, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x25, 0x73, 0x68, 0x69,
+	0x6e, 0x7a, 0x6f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x68, 0x75, 0x62, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x27, 0x73, 0x68, 0x69, 0x6e, 0x7a, 0x6f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x2f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x68, 0x75, 0x62, 0x2f, 0x76, 0x31, 0x2f, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x25, 0x73, 0x68, 0x69,
+	0x6e, 0x7a, 0x6f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x68, 0x75, 0x62, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x69, 0x65, 0x77, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0xfc, 0x0d, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x38, 0x0a, 0x18, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65,
+	0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65,
+	0x72, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x2c, 0x0a,
+	0x12, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x68, 0x6f, 0x73, 0x74, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e,
+	0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e,
+	0x67, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x78, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x74, 0x78, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x49, 0x64, 0x12, 0x40, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x68, 0x69, 0x6e, 0x7a, 0x6f,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x68, 0x75,
+	0x62, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f,
+	0x00, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x52, 0x0a, 0x0d, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x5f, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x27, 0x2e, 0x73, 0x68, 0x69, 0x6e, 0x7a, 0x6f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x2e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x68, 0x75, 0x62, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
+	0x0c, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x4f, 0x0a,
+	0x0c, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x18, 0x09, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73, 0x68, 0x69, 0x6e, 0x7a, 0x6f, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x2e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x68, 0x75, 0x62, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x48, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f,
+	0x00, 0x52, 0x0b, 0x72, 0x6f, 0x6c, 0x65, 0x48, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x12, 0x44,
+	0x0a, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x22, 0x2e, 0x73, 0x68, 0x69, 0x6e, 0x7a, 0x6f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x2e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x68, 0x75, 0x62, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x12, 0x3c, 0x0a, 0x05, 0x76, 0x69, 0x65, 0x77, 0x73, 0x18, 0x0b, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x68, 0x69, 0x6e, 0x7a, 0x6f, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x2e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x68, 0x75, 0x62, 0x2e, 0x76, 0x31,
+	0x2e, 0x56, 0x69, 0x65, 0x77, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05, 0x76, 0x69, 0x65,
+	0x77, 0x73, 0x12, 0x4c, 0x0a, 0x0b, 0x69, 0x63, 0x61, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73, 0x68, 0x69, 0x6e, 0x7a, 0x6f,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x68, 0x75,
+	0x62, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x63, 0x61, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x69, 0x63, 0x61, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73,
+	0x12, 0x58, 0x0a, 0x0f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x61,
+	0x6e, 0x64, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x73, 0x68, 0x69, 0x6e,
+	0x7a, 0x6f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x68, 0x75, 0x62, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0e, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x12, 0x4f, 0x0a, 0x0c, 0x69, 0x63,
+	0x61, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x26, 0x2e, 0x73, 0x68, 0x69, 0x6e, 0x7a, 0x6f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x2e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x68, 0x75, 0x62, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x63,
+	0x61, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0b,
+	0x69, 0x63, 0x61, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e,
+	0x65, 0x78, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x10,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+	0x64, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x65, 0x61, 0x64, 0x5f, 0x6c, 0x65, 0x74, 0x74,
+	0x65, 0x72, 0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0b, 0x64, 0x65, 0x61, 0x64, 0x4c,
+	0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x12, 0x4b, 0x0a, 0x07, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x73, 0x18, 0x13, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x73, 0x68, 0x69, 0x6e, 0x7a, 0x6f,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x68, 0x75,
+	0x62, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x68, 0x75, 0x62, 0x54, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x07, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x54, 0x0a, 0x0d, 0x72, 0x65,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x73, 0x18, 0x15, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x28, 0x2e, 0x73, 0x68, 0x69, 0x6e, 0x7a, 0x6f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x2e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x68, 0x75, 0x62, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x42, 0x04, 0xc8, 0xde, 0x1f,
+	0x00, 0x52, 0x0d, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x73,
+	0x12, 0x58, 0x0a, 0x0f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x16, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x73, 0x68, 0x69, 0x6e,
+	0x7a, 0x6f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x68, 0x75, 0x62, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0e, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x52, 0x0a, 0x0d, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x18, 0x17, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x27, 0x2e, 0x73, 0x68, 0x69, 0x6e, 0x7a, 0x6f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x2e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x68, 0x75, 0x62, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x72, 0x69, 0x63, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
+	0x52, 0x0c, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x12, 0x58,
+	0x0a, 0x0f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x18, 0x18, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x73, 0x68, 0x69, 0x6e, 0x7a, 0x6f,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x68, 0x75,
+	0x62, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x58, 0x0a, 0x0f, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x5f, 0x72, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x73, 0x18, 0x19, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x29, 0x2e, 0x73, 0x68, 0x69, 0x6e, 0x7a, 0x6f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x2e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x68, 0x75, 0x62, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x0e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x76, 0x65, 0x6e, 0x75,
+	0x65, 0x73, 0x12, 0x58, 0x0a, 0x0f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x1a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x73, 0x68,
+	0x69, 0x6e, 0x7a, 0x6f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x68, 0x75, 0x62, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0e, 0x70, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x5e, 0x0a, 0x11,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x73, 0x18, 0x1b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x73, 0x68, 0x69, 0x6e, 0x7a, 0x6f,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x68, 0x75,
+	0x62, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x55, 0x6e, 0x62, 0x6f, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x10, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x55, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x77, 0x0a, 0x1a,
+	0x75, 0x73, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x1c, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x33, 0x2e, 0x73, 0x68, 0x69, 0x6e, 0x7a, 0x6f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x2e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x68, 0x75, 0x62, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73,
+	0x65, 0x64, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x18, 0x75, 0x73, 0x65,
+	0x64, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x4a, 0x04, 0x08, 0x0e, 0x10, 0x0f, 0x4a, 0x04, 0x08, 0x12, 0x10,
+	0x13, 0x42, 0x87, 0x02, 0x0a, 0x1e, 0x63, 0x6f, 0x6d, 0x2e, 0x73, 0x68, 0x69, 0x6e, 0x7a, 0x6f,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x68, 0x75,
+	0x62, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x73, 0x68, 0x69, 0x6e, 0x7a, 0x6f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x73,
+	0x68, 0x69, 0x6e, 0x7a, 0x6f, 0x68, 0x75, 0x62, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x68, 0x69,
+	0x6e, 0x7a, 0x6f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x68, 0x75, 0x62, 0x2f, 0x76, 0x31, 0x3b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x68, 0x75,
+	0x62, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x53, 0x53, 0x58, 0xaa, 0x02, 0x1a, 0x53, 0x68, 0x69, 0x6e,
+	0x7a, 0x6f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x68, 0x75, 0x62, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x1a, 0x53, 0x68, 0x69, 0x6e, 0x7a, 0x6f, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5c, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x68, 0x75, 0x62,
+	0x5c, 0x56, 0x31, 0xe2, 0x02, 0x26, 0x53, 0x68, 0x69, 0x6e, 0x7a, 0x6f, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x5c, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x68, 0x75, 0x62, 0x5c, 0x56, 0x31,
+	0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1c, 0x53,
+	0x68, 0x69, 0x6e, 0x7a, 0x6f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x3a, 0x3a, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x68, 0x75, 0x62, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -998,16 +3814,48 @@ func file_shinzonetwork_sourcehub_v1_genesis_proto_rawDescGZIP() []byte {
 
 var file_shinzonetwork_sourcehub_v1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_shinzonetwork_sourcehub_v1_genesis_proto_goTypes = []interface{}{
-	(*GenesisState)(nil), // 0: shinzonetwork.sourcehub.v1.GenesisState
-	(*Params)(nil),       // 1: shinzonetwork.sourcehub.v1.Params
+	(*GenesisState)(nil),            // 0: shinzonetwork.sourcehub.v1.GenesisState
+	(*Params)(nil),                  // 1: shinzonetwork.sourcehub.v1.Params
+	(*StreamGrant)(nil),             // 2: shinzonetwork.sourcehub.v1.StreamGrant
+	(*RoleHolder)(nil),              // 3: shinzonetwork.sourcehub.v1.RoleHolder
+	(*Entity)(nil),                  // 4: shinzonetwork.sourcehub.v1.Entity
+	(*View)(nil),                    // 5: shinzonetwork.sourcehub.v1.View
+	(*IcaPacket)(nil),               // 6: shinzonetwork.sourcehub.v1.IcaPacket
+	(*EntityCommand)(nil),           // 7: shinzonetwork.sourcehub.v1.EntityCommand
+	(*IcaCommand)(nil),              // 8: shinzonetwork.sourcehub.v1.IcaCommand
+	(*SourcehubTarget)(nil),         // 9: shinzonetwork.sourcehub.v1.SourcehubTarget
+	(*Relationship)(nil),            // 10: shinzonetwork.sourcehub.v1.Relationship
+	(*PolicyVersion)(nil),           // 11: shinzonetwork.sourcehub.v1.PolicyVersion
+	(*StreamPrice)(nil),             // 12: shinzonetwork.sourcehub.v1.StreamPrice
+	(*StreamPayment)(nil),           // 13: shinzonetwork.sourcehub.v1.StreamPayment
+	(*StreamRevenue)(nil),           // 14: shinzonetwork.sourcehub.v1.StreamRevenue
+	(*PendingReward)(nil),           // 15: shinzonetwork.sourcehub.v1.PendingReward
+	(*EntityUnbonding)(nil),         // 16: shinzonetwork.sourcehub.v1.EntityUnbonding
+	(*UsedRegistrationMessage)(nil), // 17: shinzonetwork.sourcehub.v1.UsedRegistrationMessage
 }
 var file_shinzonetwork_sourcehub_v1_genesis_proto_depIdxs = []int32{
-	1, // 0: shinzonetwork.sourcehub.v1.GenesisState.params:type_name -> shinzonetwork.sourcehub.v1.Params
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	1,  // 0: shinzonetwork.sourcehub.v1.GenesisState.params:type_name -> shinzonetwork.sourcehub.v1.Params
+	2,  // 1: shinzonetwork.sourcehub.v1.GenesisState.stream_grants:type_name -> shinzonetwork.sourcehub.v1.StreamGrant
+	3,  // 2: shinzonetwork.sourcehub.v1.GenesisState.role_holders:type_name -> shinzonetwork.sourcehub.v1.RoleHolder
+	4,  // 3: shinzonetwork.sourcehub.v1.GenesisState.entities:type_name -> shinzonetwork.sourcehub.v1.Entity
+	5,  // 4: shinzonetwork.sourcehub.v1.GenesisState.views:type_name -> shinzonetwork.sourcehub.v1.View
+	6,  // 5: shinzonetwork.sourcehub.v1.GenesisState.ica_packets:type_name -> shinzonetwork.sourcehub.v1.IcaPacket
+	7,  // 6: shinzonetwork.sourcehub.v1.GenesisState.entity_commands:type_name -> shinzonetwork.sourcehub.v1.EntityCommand
+	8,  // 7: shinzonetwork.sourcehub.v1.GenesisState.ica_commands:type_name -> shinzonetwork.sourcehub.v1.IcaCommand
+	9,  // 8: shinzonetwork.sourcehub.v1.GenesisState.targets:type_name -> shinzonetwork.sourcehub.v1.SourcehubTarget
+	10, // 9: shinzonetwork.sourcehub.v1.GenesisState.relationships:type_name -> shinzonetwork.sourcehub.v1.Relationship
+	11, // 10: shinzonetwork.sourcehub.v1.GenesisState.policy_versions:type_name -> shinzonetwork.sourcehub.v1.PolicyVersion
+	12, // 11: shinzonetwork.sourcehub.v1.GenesisState.stream_prices:type_name -> shinzonetwork.sourcehub.v1.StreamPrice
+	13, // 12: shinzonetwork.sourcehub.v1.GenesisState.stream_payments:type_name -> shinzonetwork.sourcehub.v1.StreamPayment
+	14, // 13: shinzonetwork.sourcehub.v1.GenesisState.stream_revenues:type_name -> shinzonetwork.sourcehub.v1.StreamRevenue
+	15, // 14: shinzonetwork.sourcehub.v1.GenesisState.pending_rewards:type_name -> shinzonetwork.sourcehub.v1.PendingReward
+	16, // 15: shinzonetwork.sourcehub.v1.GenesisState.entity_unbondings:type_name -> shinzonetwork.sourcehub.v1.EntityUnbonding
+	17, // 16: shinzonetwork.sourcehub.v1.GenesisState.used_registration_messages:type_name -> shinzonetwork.sourcehub.v1.UsedRegistrationMessage
+	17, // [17:17] is the sub-list for method output_type
+	17, // [17:17] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_shinzonetwork_sourcehub_v1_genesis_proto_init() }
@@ -1015,7 +3863,17 @@ func file_shinzonetwork_sourcehub_v1_genesis_proto_init() {
 	if File_shinzonetwork_sourcehub_v1_genesis_proto != nil {
 		return
 	}
+	file_shinzonetwork_sourcehub_v1_entity_proto_init()
+	file_shinzonetwork_sourcehub_v1_grant_proto_init()
+	file_shinzonetwork_sourcehub_v1_packet_proto_init()
 	file_shinzonetwork_sourcehub_v1_params_proto_init()
+	file_shinzonetwork_sourcehub_v1_payment_proto_init()
+	file_shinzonetwork_sourcehub_v1_policy_proto_init()
+	file_shinzonetwork_sourcehub_v1_relationship_proto_init()
+	file_shinzonetwork_sourcehub_v1_revenue_proto_init()
+	file_shinzonetwork_sourcehub_v1_role_proto_init()
+	file_shinzonetwork_sourcehub_v1_target_proto_init()
+	file_shinzonetwork_sourcehub_v1_view_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_shinzonetwork_sourcehub_v1_genesis_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GenesisState); i {
@@ -0,0 +1,2963 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package sourcehubv1
+
+import (
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var _ protoreflect.List = (*_IcaPacket_11_list)(nil)
+
+type _IcaPacket_11_list struct {
+	list *[]uint64
+}
+
+func (x *_IcaPacket_11_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_IcaPacket_11_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfUint64((*x.list)[i])
+}
+
+func (x *_IcaPacket_11_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_IcaPacket_11_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_IcaPacket_11_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message IcaPacket at list field CommandIds as it is not of Message kind"))
+}
+
+func (x *_IcaPacket_11_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_IcaPacket_11_list) NewElement() protoreflect.Value {
+	v := uint64(0)
+	return protoreflect.ValueOfUint64(v)
+}
+
+func (x *_IcaPacket_11_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_IcaPacket                 protoreflect.MessageDescriptor
+	fd_IcaPacket_channel_id      protoreflect.FieldDescriptor
+	fd_IcaPacket_sequence        protoreflect.FieldDescriptor
+	fd_IcaPacket_kind            protoreflect.FieldDescriptor
+	fd_IcaPacket_payload         protoreflect.FieldDescriptor
+	fd_IcaPacket_sender          protoreflect.FieldDescriptor
+	fd_IcaPacket_height          protoreflect.FieldDescriptor
+	fd_IcaPacket_status          protoreflect.FieldDescriptor
+	fd_IcaPacket_result          protoreflect.FieldDescriptor
+	fd_IcaPacket_error           protoreflect.FieldDescriptor
+	fd_IcaPacket_resolved_height protoreflect.FieldDescriptor
+	fd_IcaPacket_command_ids     protoreflect.FieldDescriptor
+	fd_IcaPacket_target          protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_shinzonetwork_sourcehub_v1_packet_proto_init()
+	md_IcaPacket = File_shinzonetwork_sourcehub_v1_packet_proto.Messages().ByName("IcaPacket")
+	fd_IcaPacket_channel_id = md_IcaPacket.Fields().ByName("channel_id")
+	fd_IcaPacket_sequence = md_IcaPacket.Fields().ByName("sequence")
+	fd_IcaPacket_kind = md_IcaPacket.Fields().ByName("kind")
+	fd_IcaPacket_payload = md_IcaPacket.Fields().ByName("payload")
+	fd_IcaPacket_sender = md_IcaPacket.Fields().ByName("sender")
+	fd_IcaPacket_height = md_IcaPacket.Fields().ByName("height")
+	fd_IcaPacket_status = md_IcaPacket.Fields().ByName("status")
+	fd_IcaPacket_result = md_IcaPacket.Fields().ByName("result")
+	fd_IcaPacket_error = md_IcaPacket.Fields().ByName("error")
+	fd_IcaPacket_resolved_height = md_IcaPacket.Fields().ByName("resolved_height")
+	fd_IcaPacket_command_ids = md_IcaPacket.Fields().ByName("command_ids")
+	fd_IcaPacket_target = md_IcaPacket.Fields().ByName("target")
+}
+
+var _ protoreflect.Message = (*fastReflection_IcaPacket)(nil)
+
+type fastReflection_IcaPacket IcaPacket
+
+func (x *IcaPacket) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_IcaPacket)(x)
+}
+
+func (x *IcaPacket) slowProtoReflect() protoreflect.Message {
+	mi := &file_shinzonetwork_sourcehub_v1_packet_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_IcaPacket_messageType fastReflection_IcaPacket_messageType
+var _ protoreflect.MessageType = fastReflection_IcaPacket_messageType{}
+
+type fastReflection_IcaPacket_messageType struct{}
+
+func (x fastReflection_IcaPacket_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_IcaPacket)(nil)
+}
+func (x fastReflection_IcaPacket_messageType) New() protoreflect.Message {
+	return new(fastReflection_IcaPacket)
+}
+func (x fastReflection_IcaPacket_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_IcaPacket
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_IcaPacket) Descriptor() protoreflect.MessageDescriptor {
+	return md_IcaPacket
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_IcaPacket) Type() protoreflect.MessageType {
+	return _fastReflection_IcaPacket_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_IcaPacket) New() protoreflect.Message {
+	return new(fastReflection_IcaPacket)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_IcaPacket) Interface() protoreflect.ProtoMessage {
+	return (*IcaPacket)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_IcaPacket) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ChannelId != "" {
+		value := protoreflect.ValueOfString(x.ChannelId)
+		if !f(fd_IcaPacket_channel_id, value) {
+			return
+		}
+	}
+	if x.Sequence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Sequence)
+		if !f(fd_IcaPacket_sequence, value) {
+			return
+		}
+	}
+	if x.Kind != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Kind))
+		if !f(fd_IcaPacket_kind, value) {
+			return
+		}
+	}
+	if len(x.Payload) != 0 {
+		value := protoreflect.ValueOfBytes(x.Payload)
+		if !f(fd_IcaPacket_payload, value) {
+			return
+		}
+	}
+	if x.Sender != "" {
+		value := protoreflect.ValueOfString(x.Sender)
+		if !f(fd_IcaPacket_sender, value) {
+			return
+		}
+	}
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_IcaPacket_height, value) {
+			return
+		}
+	}
+	if x.Status != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
+		if !f(fd_IcaPacket_status, value) {
+			return
+		}
+	}
+	if len(x.Result) != 0 {
+		value := protoreflect.ValueOfBytes(x.Result)
+		if !f(fd_IcaPacket_result, value) {
+			return
+		}
+	}
+	if x.Error != "" {
+		value := protoreflect.ValueOfString(x.Error)
+		if !f(fd_IcaPacket_error, value) {
+			return
+		}
+	}
+	if x.ResolvedHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ResolvedHeight)
+		if !f(fd_IcaPacket_resolved_height, value) {
+			return
+		}
+	}
+	if len(x.CommandIds) != 0 {
+		value := protoreflect.ValueOfList(&_IcaPacket_11_list{list: &x.CommandIds})
+		if !f(fd_IcaPacket_command_ids, value) {
+			return
+		}
+	}
+	if x.Target != "" {
+		value := protoreflect.ValueOfString(x.Target)
+		if !f(fd_IcaPacket_target, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_IcaPacket) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "shinzonetwork.sourcehub.v1.IcaPacket.channel_id":
+		return x.ChannelId != ""
+	case "shinzonetwork.sourcehub.v1.IcaPacket.sequence":
+		return x.Sequence != uint64(0)
+	case "shinzonetwork.sourcehub.v1.IcaPacket.kind":
+		return x.Kind != 0
+	case "shinzonetwork.sourcehub.v1.IcaPacket.payload":
+		return len(x.Payload) != 0
+	case "shinzonetwork.sourcehub.v1.IcaPacket.sender":
+		return x.Sender != ""
+	case "shinzonetwork.sourcehub.v1.IcaPacket.height":
+		return x.Height != int64(0)
+	case "shinzonetwork.sourcehub.v1.IcaPacket.status":
+		return x.Status != 0
+	case "shinzonetwork.sourcehub.v1.IcaPacket.result":
+		return len(x.Result) != 0
+	case "shinzonetwork.sourcehub.v1.IcaPacket.error":
+		return x.Error != ""
+	case "shinzonetwork.sourcehub.v1.IcaPacket.resolved_height":
+		return x.ResolvedHeight != int64(0)
+	case "shinzonetwork.sourcehub.v1.IcaPacket.command_ids":
+		return len(x.CommandIds) != 0
+	case "shinzonetwork.sourcehub.v1.IcaPacket.target":
+		return x.Target != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: shinzonetwork.sourcehub.v1.IcaPacket"))
+		}
+		panic(fmt.Errorf("message shinzonetwork.sourcehub.v1.IcaPacket does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_IcaPacket) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "shinzonetwork.sourcehub.v1.IcaPacket.channel_id":
+		x.ChannelId = ""
+	case "shinzonetwork.sourcehub.v1.IcaPacket.sequence":
+		x.Sequence = uint64(0)
+	case "shinzonetwork.sourcehub.v1.IcaPacket.kind":
+		x.Kind = 0
+	case "shinzonetwork.sourcehub.v1.IcaPacket.payload":
+		x.Payload = nil
+	case "shinzonetwork.sourcehub.v1.IcaPacket.sender":
+		x.Sender = ""
+	case "shinzonetwork.sourcehub.v1.IcaPacket.height":
+		x.Height = int64(0)
+	case "shinzonetwork.sourcehub.v1.IcaPacket.status":
+		x.Status = 0
+	case "shinzonetwork.sourcehub.v1.IcaPacket.result":
+		x.Result = nil
+	case "shinzonetwork.sourcehub.v1.IcaPacket.error":
+		x.Error = ""
+	case "shinzonetwork.sourcehub.v1.IcaPacket.resolved_height":
+		x.ResolvedHeight = int64(0)
+	case "shinzonetwork.sourcehub.v1.IcaPacket.command_ids":
+		x.CommandIds = nil
+	case "shinzonetwork.sourcehub.v1.IcaPacket.target":
+		x.Target = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: shinzonetwork.sourcehub.v1.IcaPacket"))
+		}
+		panic(fmt.Errorf("message shinzonetwork.sourcehub.v1.IcaPacket does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_IcaPacket) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "shinzonetwork.sourcehub.v1.IcaPacket.channel_id":
+		value := x.ChannelId
+		return protoreflect.ValueOfString(value)
+	case "shinzonetwork.sourcehub.v1.IcaPacket.sequence":
+		value := x.Sequence
+		return protoreflect.ValueOfUint64(value)
+	case "shinzonetwork.sourcehub.v1.IcaPacket.kind":
+		value := x.Kind
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "shinzonetwork.sourcehub.v1.IcaPacket.payload":
+		value := x.Payload
+		return protoreflect.ValueOfBytes(value)
+	case "shinzonetwork.sourcehub.v1.IcaPacket.sender":
+		value := x.Sender
+		return protoreflect.ValueOfString(value)
+	case "shinzonetwork.sourcehub.v1.IcaPacket.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
+	case "shinzonetwork.sourcehub.v1.IcaPacket.status":
+		value := x.Status
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "shinzonetwork.sourcehub.v1.IcaPacket.result":
+		value := x.Result
+		return protoreflect.ValueOfBytes(value)
+	case "shinzonetwork.sourcehub.v1.IcaPacket.error":
+		value := x.Error
+		return protoreflect.ValueOfString(value)
+	case "shinzonetwork.sourcehub.v1.IcaPacket.resolved_height":
+		value := x.ResolvedHeight
+		return protoreflect.ValueOfInt64(value)
+	case "shinzonetwork.sourcehub.v1.IcaPacket.command_ids":
+		if len(x.CommandIds) == 0 {
+			return protoreflect.ValueOfList(&_IcaPacket_11_list{})
+		}
+		listValue := &_IcaPacket_11_list{list: &x.CommandIds}
+		return protoreflect.ValueOfList(listValue)
+	case "shinzonetwork.sourcehub.v1.IcaPacket.target":
+		value := x.Target
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: shinzonetwork.sourcehub.v1.IcaPacket"))
+		}
+		panic(fmt.Errorf("message shinzonetwork.sourcehub.v1.IcaPacket does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_IcaPacket) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "shinzonetwork.sourcehub.v1.IcaPacket.channel_id":
+		x.ChannelId = value.Interface().(string)
+	case "shinzonetwork.sourcehub.v1.IcaPacket.sequence":
+		x.Sequence = value.Uint()
+	case "shinzonetwork.sourcehub.v1.IcaPacket.kind":
+		x.Kind = (PacketKind)(value.Enum())
+	case "shinzonetwork.sourcehub.v1.IcaPacket.payload":
+		x.Payload = value.Bytes()
+	case "shinzonetwork.sourcehub.v1.IcaPacket.sender":
+		x.Sender = value.Interface().(string)
+	case "shinzonetwork.sourcehub.v1.IcaPacket.height":
+		x.Height = value.Int()
+	case "shinzonetwork.sourcehub.v1.IcaPacket.status":
+		x.Status = (PacketStatus)(value.Enum())
+	case "shinzonetwork.sourcehub.v1.IcaPacket.result":
+		x.Result = value.Bytes()
+	case "shinzonetwork.sourcehub.v1.IcaPacket.error":
+		x.Error = value.Interface().(string)
+	case "shinzonetwork.sourcehub.v1.IcaPacket.resolved_height":
+		x.ResolvedHeight = value.Int()
+	case "shinzonetwork.sourcehub.v1.IcaPacket.command_ids":
+		lv := value.List()
+		clv := lv.(*_IcaPacket_11_list)
+		x.CommandIds = *clv.list
+	case "shinzonetwork.sourcehub.v1.IcaPacket.target":
+		x.Target = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: shinzonetwork.sourcehub.v1.IcaPacket"))
+		}
+		panic(fmt.Errorf("message shinzonetwork.sourcehub.v1.IcaPacket does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_IcaPacket) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "shinzonetwork.sourcehub.v1.IcaPacket.command_ids":
+		if x.CommandIds == nil {
+			x.CommandIds = []uint64{}
+		}
+		value := &_IcaPacket_11_list{list: &x.CommandIds}
+		return protoreflect.ValueOfList(value)
+	case "shinzonetwork.sourcehub.v1.IcaPacket.channel_id":
+		panic(fmt.Errorf("field channel_id of message shinzonetwork.sourcehub.v1.IcaPacket is not mutable"))
+	case "shinzonetwork.sourcehub.v1.IcaPacket.sequence":
+		panic(fmt.Errorf("field sequence of message shinzonetwork.sourcehub.v1.IcaPacket is not mutable"))
+	case "shinzonetwork.sourcehub.v1.IcaPacket.kind":
+		panic(fmt.Errorf("field kind of message shinzonetwork.sourcehub.v1.IcaPacket is not mutable"))
+	case "shinzonetwork.sourcehub.v1.IcaPacket.payload":
+		panic(fmt.Errorf("field payload of message shinzonetwork.sourcehub.v1.IcaPacket is not mutable"))
+	case "shinzonetwork.sourcehub.v1.IcaPacket.sender":
+		panic(fmt.Errorf("field sender of message shinzonetwork.sourcehub.v1.IcaPacket is not mutable"))
+	case "shinzonetwork.sourcehub.v1.IcaPacket.height":
+		panic(fmt.Errorf("field height of message shinzonetwork.sourcehub.v1.IcaPacket is not mutable"))
+	case "shinzonetwork.sourcehub.v1.IcaPacket.status":
+		panic(fmt.Errorf("field status of message shinzonetwork.sourcehub.v1.IcaPacket is not mutable"))
+	case "shinzonetwork.sourcehub.v1.IcaPacket.result":
+		panic(fmt.Errorf("field result of message shinzonetwork.sourcehub.v1.IcaPacket is not mutable"))
+	case "shinzonetwork.sourcehub.v1.IcaPacket.error":
+		panic(fmt.Errorf("field error of message shinzonetwork.sourcehub.v1.IcaPacket is not mutable"))
+	case "shinzonetwork.sourcehub.v1.IcaPacket.resolved_height":
+		panic(fmt.Errorf("field resolved_height of message shinzonetwork.sourcehub.v1.IcaPacket is not mutable"))
+	case "shinzonetwork.sourcehub.v1.IcaPacket.target":
+		panic(fmt.Errorf("field target of message shinzonetwork.sourcehub.v1.IcaPacket is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: shinzonetwork.sourcehub.v1.IcaPacket"))
+		}
+		panic(fmt.Errorf("message shinzonetwork.sourcehub.v1.IcaPacket does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_IcaPacket) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "shinzonetwork.sourcehub.v1.IcaPacket.channel_id":
+		return protoreflect.ValueOfString("")
+	case "shinzonetwork.sourcehub.v1.IcaPacket.sequence":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "shinzonetwork.sourcehub.v1.IcaPacket.kind":
+		return protoreflect.ValueOfEnum(0)
+	case "shinzonetwork.sourcehub.v1.IcaPacket.payload":
+		return protoreflect.ValueOfBytes(nil)
+	case "shinzonetwork.sourcehub.v1.IcaPacket.sender":
+		return protoreflect.ValueOfString("")
+	case "shinzonetwork.sourcehub.v1.IcaPacket.height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "shinzonetwork.sourcehub.v1.IcaPacket.status":
+		return protoreflect.ValueOfEnum(0)
+	case "shinzonetwork.sourcehub.v1.IcaPacket.result":
+		return protoreflect.ValueOfBytes(nil)
+	case "shinzonetwork.sourcehub.v1.IcaPacket.error":
+		return protoreflect.ValueOfString("")
+	case "shinzonetwork.sourcehub.v1.IcaPacket.resolved_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "shinzonetwork.sourcehub.v1.IcaPacket.command_ids":
+		list := []uint64{}
+		return protoreflect.ValueOfList(&_IcaPacket_11_list{list: &list})
+	case "shinzonetwork.sourcehub.v1.IcaPacket.target":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: shinzonetwork.sourcehub.v1.IcaPacket"))
+		}
+		panic(fmt.Errorf("message shinzonetwork.sourcehub.v1.IcaPacket does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_IcaPacket) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in shinzonetwork.sourcehub.v1.IcaPacket", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_IcaPacket) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_IcaPacket) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_IcaPacket) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_IcaPacket) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*IcaPacket)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ChannelId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Sequence != 0 {
+			n += 1 + runtime.Sov(uint64(x.Sequence))
+		}
+		if x.Kind != 0 {
+			n += 1 + runtime.Sov(uint64(x.Kind))
+		}
+		l = len(x.Payload)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Sender)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		if x.Status != 0 {
+			n += 1 + runtime.Sov(uint64(x.Status))
+		}
+		l = len(x.Result)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Error)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ResolvedHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.ResolvedHeight))
+		}
+		if len(x.CommandIds) > 0 {
+			l = 0
+			for _, e := range x.CommandIds {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 1 + runtime.Sov(uint64(l)) + l
+		}
+		l = len(x.Target)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*IcaPacket)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Target) > 0 {
+			i -= len(x.Target)
+			copy(dAtA[i:], x.Target)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Target)))
+			i--
+			dAtA[i] = 0x62
+		}
+		if len(x.CommandIds) > 0 {
+			var pksize2 int
+			for _, num := range x.CommandIds {
+				pksize2 += runtime.Sov(uint64(num))
+			}
+			i -= pksize2
+			j1 := i
+			for _, num := range x.CommandIds {
+				for num >= 1<<7 {
+					dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j1++
+				}
+				dAtA[j1] = uint8(num)
+				j1++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if x.ResolvedHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ResolvedHeight))
+			i--
+			dAtA[i] = 0x50
+		}
+		if len(x.Error) > 0 {
+			i -= len(x.Error)
+			copy(dAtA[i:], x.Error)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Error)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if len(x.Result) > 0 {
+			i -= len(x.Result)
+			copy(dAtA[i:], x.Result)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Result)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if x.Status != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.Sender) > 0 {
+			i -= len(x.Sender)
+			copy(dAtA[i:], x.Sender)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sender)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Payload) > 0 {
+			i -= len(x.Payload)
+			copy(dAtA[i:], x.Payload)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Payload)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.Kind != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Kind))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Sequence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Sequence))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.ChannelId) > 0 {
+			i -= len(x.ChannelId)
+			copy(dAtA[i:], x.ChannelId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ChannelId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*IcaPacket)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: IcaPacket: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: IcaPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ChannelId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+				}
+				x.Sequence = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Sequence |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				}
+				x.Kind = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Kind |= PacketKind(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Payload = append(x.Payload[:0], dAtA[iNdEx:postIndex]...)
+				if x.Payload == nil {
+					x.Payload = []byte{}
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Sender = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				x.Status = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Status |= PacketStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Result = append(x.Result[:0], dAtA[iNdEx:postIndex]...)
+				if x.Result == nil {
+					x.Result = []byte{}
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Error = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ResolvedHeight", wireType)
+				}
+				x.ResolvedHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ResolvedHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 11:
+				if wireType == 0 {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.CommandIds = append(x.CommandIds, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					var count int
+					for _, integer := range dAtA[iNdEx:postIndex] {
+						if integer < 128 {
+							count++
+						}
+					}
+					elementCount = count
+					if elementCount != 0 && len(x.CommandIds) == 0 {
+						x.CommandIds = make([]uint64, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.CommandIds = append(x.CommandIds, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CommandIds", wireType)
+				}
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Target = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_IcaCommand                     protoreflect.MessageDescriptor
+	fd_IcaCommand_id                  protoreflect.FieldDescriptor
+	fd_IcaCommand_kind                protoreflect.FieldDescriptor
+	fd_IcaCommand_sender              protoreflect.FieldDescriptor
+	fd_IcaCommand_tx_hash             protoreflect.FieldDescriptor
+	fd_IcaCommand_height              protoreflect.FieldDescriptor
+	fd_IcaCommand_payload             protoreflect.FieldDescriptor
+	fd_IcaCommand_channel_id          protoreflect.FieldDescriptor
+	fd_IcaCommand_sequence            protoreflect.FieldDescriptor
+	fd_IcaCommand_status              protoreflect.FieldDescriptor
+	fd_IcaCommand_result              protoreflect.FieldDescriptor
+	fd_IcaCommand_error               protoreflect.FieldDescriptor
+	fd_IcaCommand_attempts            protoreflect.FieldDescriptor
+	fd_IcaCommand_next_attempt_height protoreflect.FieldDescriptor
+	fd_IcaCommand_target              protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_shinzonetwork_sourcehub_v1_packet_proto_init()
+	md_IcaCommand = File_shinzonetwork_sourcehub_v1_packet_proto.Messages().ByName("IcaCommand")
+	fd_IcaCommand_id = md_IcaCommand.Fields().ByName("id")
+	fd_IcaCommand_kind = md_IcaCommand.Fields().ByName("kind")
+	fd_IcaCommand_sender = md_IcaCommand.Fields().ByName("sender")
+	fd_IcaCommand_tx_hash = md_IcaCommand.Fields().ByName("tx_hash")
+	fd_IcaCommand_height = md_IcaCommand.Fields().ByName("height")
+	fd_IcaCommand_payload = md_IcaCommand.Fields().ByName("payload")
+	fd_IcaCommand_channel_id = md_IcaCommand.Fields().ByName("channel_id")
+	fd_IcaCommand_sequence = md_IcaCommand.Fields().ByName("sequence")
+	fd_IcaCommand_status = md_IcaCommand.Fields().ByName("status")
+	fd_IcaCommand_result = md_IcaCommand.Fields().ByName("result")
+	fd_IcaCommand_error = md_IcaCommand.Fields().ByName("error")
+	fd_IcaCommand_attempts = md_IcaCommand.Fields().ByName("attempts")
+	fd_IcaCommand_next_attempt_height = md_IcaCommand.Fields().ByName("next_attempt_height")
+	fd_IcaCommand_target = md_IcaCommand.Fields().ByName("target")
+}
+
+var _ protoreflect.Message = (*fastReflection_IcaCommand)(nil)
+
+type fastReflection_IcaCommand IcaCommand
+
+func (x *IcaCommand) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_IcaCommand)(x)
+}
+
+func (x *IcaCommand) slowProtoReflect() protoreflect.Message {
+	mi := &file_shinzonetwork_sourcehub_v1_packet_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_IcaCommand_messageType fastReflection_IcaCommand_messageType
+var _ protoreflect.MessageType = fastReflection_IcaCommand_messageType{}
+
+type fastReflection_IcaCommand_messageType struct{}
+
+func (x fastReflection_IcaCommand_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_IcaCommand)(nil)
+}
+func (x fastReflection_IcaCommand_messageType) New() protoreflect.Message {
+	return new(fastReflection_IcaCommand)
+}
+func (x fastReflection_IcaCommand_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_IcaCommand
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_IcaCommand) Descriptor() protoreflect.MessageDescriptor {
+	return md_IcaCommand
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_IcaCommand) Type() protoreflect.MessageType {
+	return _fastReflection_IcaCommand_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_IcaCommand) New() protoreflect.Message {
+	return new(fastReflection_IcaCommand)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_IcaCommand) Interface() protoreflect.ProtoMessage {
+	return (*IcaCommand)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_IcaCommand) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_IcaCommand_id, value) {
+			return
+		}
+	}
+	if x.Kind != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Kind))
+		if !f(fd_IcaCommand_kind, value) {
+			return
+		}
+	}
+	if x.Sender != "" {
+		value := protoreflect.ValueOfString(x.Sender)
+		if !f(fd_IcaCommand_sender, value) {
+			return
+		}
+	}
+	if x.TxHash != "" {
+		value := protoreflect.ValueOfString(x.TxHash)
+		if !f(fd_IcaCommand_tx_hash, value) {
+			return
+		}
+	}
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_IcaCommand_height, value) {
+			return
+		}
+	}
+	if len(x.Payload) != 0 {
+		value := protoreflect.ValueOfBytes(x.Payload)
+		if !f(fd_IcaCommand_payload, value) {
+			return
+		}
+	}
+	if x.ChannelId != "" {
+		value := protoreflect.ValueOfString(x.ChannelId)
+		if !f(fd_IcaCommand_channel_id, value) {
+			return
+		}
+	}
+	if x.Sequence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Sequence)
+		if !f(fd_IcaCommand_sequence, value) {
+			return
+		}
+	}
+	if x.Status != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
+		if !f(fd_IcaCommand_status, value) {
+			return
+		}
+	}
+	if len(x.Result) != 0 {
+		value := protoreflect.ValueOfBytes(x.Result)
+		if !f(fd_IcaCommand_result, value) {
+			return
+		}
+	}
+	if x.Error != "" {
+		value := protoreflect.ValueOfString(x.Error)
+		if !f(fd_IcaCommand_error, value) {
+			return
+		}
+	}
+	if x.Attempts != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Attempts)
+		if !f(fd_IcaCommand_attempts, value) {
+			return
+		}
+	}
+	if x.NextAttemptHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.NextAttemptHeight)
+		if !f(fd_IcaCommand_next_attempt_height, value) {
+			return
+		}
+	}
+	if x.Target != "" {
+		value := protoreflect.ValueOfString(x.Target)
+		if !f(fd_IcaCommand_target, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_IcaCommand) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "shinzonetwork.sourcehub.v1.IcaCommand.id":
+		return x.Id != uint64(0)
+	case "shinzonetwork.sourcehub.v1.IcaCommand.kind":
+		return x.Kind != 0
+	case "shinzonetwork.sourcehub.v1.IcaCommand.sender":
+		return x.Sender != ""
+	case "shinzonetwork.sourcehub.v1.IcaCommand.tx_hash":
+		return x.TxHash != ""
+	case "shinzonetwork.sourcehub.v1.IcaCommand.height":
+		return x.Height != int64(0)
+	case "shinzonetwork.sourcehub.v1.IcaCommand.payload":
+		return len(x.Payload) != 0
+	case "shinzonetwork.sourcehub.v1.IcaCommand.channel_id":
+		return x.ChannelId != ""
+	case "shinzonetwork.sourcehub.v1.IcaCommand.sequence":
+		return x.Sequence != uint64(0)
+	case "shinzonetwork.sourcehub.v1.IcaCommand.status":
+		return x.Status != 0
+	case "shinzonetwork.sourcehub.v1.IcaCommand.result":
+		return len(x.Result) != 0
+	case "shinzonetwork.sourcehub.v1.IcaCommand.error":
+		return x.Error != ""
+	case "shinzonetwork.sourcehub.v1.IcaCommand.attempts":
+		return x.Attempts != uint32(0)
+	case "shinzonetwork.sourcehub.v1.IcaCommand.next_attempt_height":
+		return x.NextAttemptHeight != int64(0)
+	case "shinzonetwork.sourcehub.v1.IcaCommand.target":
+		return x.Target != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: shinzonetwork.sourcehub.v1.IcaCommand"))
+		}
+		panic(fmt.Errorf("message shinzonetwork.sourcehub.v1.IcaCommand does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_IcaCommand) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "shinzonetwork.sourcehub.v1.IcaCommand.id":
+		x.Id = uint64(0)
+	case "shinzonetwork.sourcehub.v1.IcaCommand.kind":
+		x.Kind = 0
+	case "shinzonetwork.sourcehub.v1.IcaCommand.sender":
+		x.Sender = ""
+	case "shinzonetwork.sourcehub.v1.IcaCommand.tx_hash":
+		x.TxHash = ""
+	case "shinzonetwork.sourcehub.v1.IcaCommand.height":
+		x.Height = int64(0)
+	case "shinzonetwork.sourcehub.v1.IcaCommand.payload":
+		x.Payload = nil
+	case "shinzonetwork.sourcehub.v1.IcaCommand.channel_id":
+		x.ChannelId = ""
+	case "shinzonetwork.sourcehub.v1.IcaCommand.sequence":
+		x.Sequence = uint64(0)
+	case "shinzonetwork.sourcehub.v1.IcaCommand.status":
+		x.Status = 0
+	case "shinzonetwork.sourcehub.v1.IcaCommand.result":
+		x.Result = nil
+	case "shinzonetwork.sourcehub.v1.IcaCommand.error":
+		x.Error = ""
+	case "shinzonetwork.sourcehub.v1.IcaCommand.attempts":
+		x.Attempts = uint32(0)
+	case "shinzonetwork.sourcehub.v1.IcaCommand.next_attempt_height":
+		x.NextAttemptHeight = int64(0)
+	case "shinzonetwork.sourcehub.v1.IcaCommand.target":
+		x.Target = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: shinzonetwork.sourcehub.v1.IcaCommand"))
+		}
+		panic(fmt.Errorf("message shinzonetwork.sourcehub.v1.IcaCommand does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_IcaCommand) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "shinzonetwork.sourcehub.v1.IcaCommand.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "shinzonetwork.sourcehub.v1.IcaCommand.kind":
+		value := x.Kind
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "shinzonetwork.sourcehub.v1.IcaCommand.sender":
+		value := x.Sender
+		return protoreflect.ValueOfString(value)
+	case "shinzonetwork.sourcehub.v1.IcaCommand.tx_hash":
+		value := x.TxHash
+		return protoreflect.ValueOfString(value)
+	case "shinzonetwork.sourcehub.v1.IcaCommand.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
+	case "shinzonetwork.sourcehub.v1.IcaCommand.payload":
+		value := x.Payload
+		return protoreflect.ValueOfBytes(value)
+	case "shinzonetwork.sourcehub.v1.IcaCommand.channel_id":
+		value := x.ChannelId
+		return protoreflect.ValueOfString(value)
+	case "shinzonetwork.sourcehub.v1.IcaCommand.sequence":
+		value := x.Sequence
+		return protoreflect.ValueOfUint64(value)
+	case "shinzonetwork.sourcehub.v1.IcaCommand.status":
+		value := x.Status
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "shinzonetwork.sourcehub.v1.IcaCommand.result":
+		value := x.Result
+		return protoreflect.ValueOfBytes(value)
+	case "shinzonetwork.sourcehub.v1.IcaCommand.error":
+		value := x.Error
+		return protoreflect.ValueOfString(value)
+	case "shinzonetwork.sourcehub.v1.IcaCommand.attempts":
+		value := x.Attempts
+		return protoreflect.ValueOfUint32(value)
+	case "shinzonetwork.sourcehub.v1.IcaCommand.next_attempt_height":
+		value := x.NextAttemptHeight
+		return protoreflect.ValueOfInt64(value)
+	case "shinzonetwork.sourcehub.v1.IcaCommand.target":
+		value := x.Target
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: shinzonetwork.sourcehub.v1.IcaCommand"))
+		}
+		panic(fmt.Errorf("message shinzonetwork.sourcehub.v1.IcaCommand does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_IcaCommand) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "shinzonetwork.sourcehub.v1.IcaCommand.id":
+		x.Id = value.Uint()
+	case "shinzonetwork.sourcehub.v1.IcaCommand.kind":
+		x.Kind = (PacketKind)(value.Enum())
+	case "shinzonetwork.sourcehub.v1.IcaCommand.sender":
+		x.Sender = value.Interface().(string)
+	case "shinzonetwork.sourcehub.v1.IcaCommand.tx_hash":
+		x.TxHash = value.Interface().(string)
+	case "shinzonetwork.sourcehub.v1.IcaCommand.height":
+		x.Height = value.Int()
+	case "shinzonetwork.sourcehub.v1.IcaCommand.payload":
+		x.Payload = value.Bytes()
+	case "shinzonetwork.sourcehub.v1.IcaCommand.channel_id":
+		x.ChannelId = value.Interface().(string)
+	case "shinzonetwork.sourcehub.v1.IcaCommand.sequence":
+		x.Sequence = value.Uint()
+	case "shinzonetwork.sourcehub.v1.IcaCommand.status":
+		x.Status = (PacketStatus)(value.Enum())
+	case "shinzonetwork.sourcehub.v1.IcaCommand.result":
+		x.Result = value.Bytes()
+	case "shinzonetwork.sourcehub.v1.IcaCommand.error":
+		x.Error = value.Interface().(string)
+	case "shinzonetwork.sourcehub.v1.IcaCommand.attempts":
+		x.Attempts = uint32(value.Uint())
+	case "shinzonetwork.sourcehub.v1.IcaCommand.next_attempt_height":
+		x.NextAttemptHeight = value.Int()
+	case "shinzonetwork.sourcehub.v1.IcaCommand.target":
+		x.Target = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: shinzonetwork.sourcehub.v1.IcaCommand"))
+		}
+		panic(fmt.Errorf("message shinzonetwork.sourcehub.v1.IcaCommand does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_IcaCommand) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "shinzonetwork.sourcehub.v1.IcaCommand.id":
+		panic(fmt.Errorf("field id of message shinzonetwork.sourcehub.v1.IcaCommand is not mutable"))
+	case "shinzonetwork.sourcehub.v1.IcaCommand.kind":
+		panic(fmt.Errorf("field kind of message shinzonetwork.sourcehub.v1.IcaCommand is not mutable"))
+	case "shinzonetwork.sourcehub.v1.IcaCommand.sender":
+		panic(fmt.Errorf("field sender of message shinzonetwork.sourcehub.v1.IcaCommand is not mutable"))
+	case "shinzonetwork.sourcehub.v1.IcaCommand.tx_hash":
+		panic(fmt.Errorf("field tx_hash of message shinzonetwork.sourcehub.v1.IcaCommand is not mutable"))
+	case "shinzonetwork.sourcehub.v1.IcaCommand.height":
+		panic(fmt.Errorf("field height of message shinzonetwork.sourcehub.v1.IcaCommand is not mutable"))
+	case "shinzonetwork.sourcehub.v1.IcaCommand.payload":
+		panic(fmt.Errorf("field payload of message shinzonetwork.sourcehub.v1.IcaCommand is not mutable"))
+	case "shinzonetwork.sourcehub.v1.IcaCommand.channel_id":
+		panic(fmt.Errorf("field channel_id of message shinzonetwork.sourcehub.v1.IcaCommand is not mutable"))
+	case "shinzonetwork.sourcehub.v1.IcaCommand.sequence":
+		panic(fmt.Errorf("field sequence of message shinzonetwork.sourcehub.v1.IcaCommand is not mutable"))
+	case "shinzonetwork.sourcehub.v1.IcaCommand.status":
+		panic(fmt.Errorf("field status of message shinzonetwork.sourcehub.v1.IcaCommand is not mutable"))
+	case "shinzonetwork.sourcehub.v1.IcaCommand.result":
+		panic(fmt.Errorf("field result of message shinzonetwork.sourcehub.v1.IcaCommand is not mutable"))
+	case "shinzonetwork.sourcehub.v1.IcaCommand.error":
+		panic(fmt.Errorf("field error of message shinzonetwork.sourcehub.v1.IcaCommand is not mutable"))
+	case "shinzonetwork.sourcehub.v1.IcaCommand.attempts":
+		panic(fmt.Errorf("field attempts of message shinzonetwork.sourcehub.v1.IcaCommand is not mutable"))
+	case "shinzonetwork.sourcehub.v1.IcaCommand.next_attempt_height":
+		panic(fmt.Errorf("field next_attempt_height of message shinzonetwork.sourcehub.v1.IcaCommand is not mutable"))
+	case "shinzonetwork.sourcehub.v1.IcaCommand.target":
+		panic(fmt.Errorf("field target of message shinzonetwork.sourcehub.v1.IcaCommand is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: shinzonetwork.sourcehub.v1.IcaCommand"))
+		}
+		panic(fmt.Errorf("message shinzonetwork.sourcehub.v1.IcaCommand does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_IcaCommand) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "shinzonetwork.sourcehub.v1.IcaCommand.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "shinzonetwork.sourcehub.v1.IcaCommand.kind":
+		return protoreflect.ValueOfEnum(0)
+	case "shinzonetwork.sourcehub.v1.IcaCommand.sender":
+		return protoreflect.ValueOfString("")
+	case "shinzonetwork.sourcehub.v1.IcaCommand.tx_hash":
+		return protoreflect.ValueOfString("")
+	case "shinzonetwork.sourcehub.v1.IcaCommand.height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "shinzonetwork.sourcehub.v1.IcaCommand.payload":
+		return protoreflect.ValueOfBytes(nil)
+	case "shinzonetwork.sourcehub.v1.IcaCommand.channel_id":
+		return protoreflect.ValueOfString("")
+	case "shinzonetwork.sourcehub.v1.IcaCommand.sequence":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "shinzonetwork.sourcehub.v1.IcaCommand.status":
+		return protoreflect.ValueOfEnum(0)
+	case "shinzonetwork.sourcehub.v1.IcaCommand.result":
+		return protoreflect.ValueOfBytes(nil)
+	case "shinzonetwork.sourcehub.v1.IcaCommand.error":
+		return protoreflect.ValueOfString("")
+	case "shinzonetwork.sourcehub.v1.IcaCommand.attempts":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "shinzonetwork.sourcehub.v1.IcaCommand.next_attempt_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "shinzonetwork.sourcehub.v1.IcaCommand.target":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: shinzonetwork.sourcehub.v1.IcaCommand"))
+		}
+		panic(fmt.Errorf("message shinzonetwork.sourcehub.v1.IcaCommand does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_IcaCommand) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in shinzonetwork.sourcehub.v1.IcaCommand", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_IcaCommand) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_IcaCommand) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_IcaCommand) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_IcaCommand) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*IcaCommand)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		if x.Kind != 0 {
+			n += 1 + runtime.Sov(uint64(x.Kind))
+		}
+		l = len(x.Sender)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.TxHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		l = len(x.Payload)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ChannelId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Sequence != 0 {
+			n += 1 + runtime.Sov(uint64(x.Sequence))
+		}
+		if x.Status != 0 {
+			n += 1 + runtime.Sov(uint64(x.Status))
+		}
+		l = len(x.Result)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Error)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Attempts != 0 {
+			n += 1 + runtime.Sov(uint64(x.Attempts))
+		}
+		if x.NextAttemptHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.NextAttemptHeight))
+		}
+		l = len(x.Target)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*IcaCommand)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Target) > 0 {
+			i -= len(x.Target)
+			copy(dAtA[i:], x.Target)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Target)))
+			i--
+			dAtA[i] = 0x72
+		}
+		if x.NextAttemptHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NextAttemptHeight))
+			i--
+			dAtA[i] = 0x68
+		}
+		if x.Attempts != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Attempts))
+			i--
+			dAtA[i] = 0x60
+		}
+		if len(x.Error) > 0 {
+			i -= len(x.Error)
+			copy(dAtA[i:], x.Error)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Error)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if len(x.Result) > 0 {
+			i -= len(x.Result)
+			copy(dAtA[i:], x.Result)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Result)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if x.Status != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.Sequence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Sequence))
+			i--
+			dAtA[i] = 0x40
+		}
+		if len(x.ChannelId) > 0 {
+			i -= len(x.ChannelId)
+			copy(dAtA[i:], x.ChannelId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ChannelId)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.Payload) > 0 {
+			i -= len(x.Payload)
+			copy(dAtA[i:], x.Payload)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Payload)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.TxHash) > 0 {
+			i -= len(x.TxHash)
+			copy(dAtA[i:], x.TxHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TxHash)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Sender) > 0 {
+			i -= len(x.Sender)
+			copy(dAtA[i:], x.Sender)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sender)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Kind != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Kind))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*IcaCommand)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: IcaCommand: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: IcaCommand: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				}
+				x.Kind = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Kind |= PacketKind(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Sender = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TxHash = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Payload = append(x.Payload[:0], dAtA[iNdEx:postIndex]...)
+				if x.Payload == nil {
+					x.Payload = []byte{}
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ChannelId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+				}
+				x.Sequence = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Sequence |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				x.Status = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Status |= PacketStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Result = append(x.Result[:0], dAtA[iNdEx:postIndex]...)
+				if x.Result == nil {
+					x.Result = []byte{}
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Error = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
+				}
+				x.Attempts = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Attempts |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 13:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextAttemptHeight", wireType)
+				}
+				x.NextAttemptHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NextAttemptHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Target = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: shinzonetwork/sourcehub/v1/packet.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// PacketKind identifies the command carried by an outgoing ICA packet.
+type PacketKind int32
+
+const (
+	PacketKind_PACKET_KIND_UNSPECIFIED           PacketKind = 0
+	PacketKind_PACKET_KIND_REGISTER_ENTITY       PacketKind = 1
+	PacketKind_PACKET_KIND_REGISTER_OBJECT       PacketKind = 2
+	PacketKind_PACKET_KIND_REGISTER_POLICY       PacketKind = 3
+	PacketKind_PACKET_KIND_REGISTER_OBJECTS      PacketKind = 4
+	PacketKind_PACKET_KIND_REQUEST_STREAM_ACCESS PacketKind = 5
+	PacketKind_PACKET_KIND_REVOKE_STREAM_ACCESS  PacketKind = 6
+	PacketKind_PACKET_KIND_UNREGISTER_ENTITY     PacketKind = 7
+	PacketKind_PACKET_KIND_ROTATE_ENTITY_KEYS    PacketKind = 8
+	// Outbox commands of different kinds sent together
+	PacketKind_PACKET_KIND_BATCH         PacketKind = 9
+	PacketKind_PACKET_KIND_UPDATE_POLICY PacketKind = 10
+	// Removes the group membership of an entity blocked by an admin
+	PacketKind_PACKET_KIND_BLOCK_ENTITY PacketKind = 11
+)
+
+// Enum value maps for PacketKind.
+var (
+	PacketKind_name = map[int32]string{
+		0:  "PACKET_KIND_UNSPECIFIED",
+		1:  "PACKET_KIND_REGISTER_ENTITY",
+		2:  "PACKET_KIND_REGISTER_OBJECT",
+		3:  "PACKET_KIND_REGISTER_POLICY",
+		4:  "PACKET_KIND_REGISTER_OBJECTS",
+		5:  "PACKET_KIND_REQUEST_STREAM_ACCESS",
+		6:  "PACKET_KIND_REVOKE_STREAM_ACCESS",
+		7:  "PACKET_KIND_UNREGISTER_ENTITY",
+		8:  "PACKET_KIND_ROTATE_ENTITY_KEYS",
+		9:  "PACKET_KIND_BATCH",
+		10: "PACKET_KIND_UPDATE_POLICY",
+		11: "PACKET_KIND_BLOCK_ENTITY",
+	}
+	PacketKind_value = map[string]int32{
+		"PACKET_KIND_UNSPECIFIED":           0,
+		"PACKET_KIND_REGISTER_ENTITY":       1,
+		"PACKET_KIND_REGISTER_OBJECT":       2,
+		"PACKET_KIND_REGISTER_POLICY":       3,
+		"PACKET_KIND_REGISTER_OBJECTS":      4,
+		"PACKET_KIND_REQUEST_STREAM_ACCESS": 5,
+		"PACKET_KIND_REVOKE_STREAM_ACCESS":  6,
+		"PACKET_KIND_UNREGISTER_ENTITY":     7,
+		"PACKET_KIND_ROTATE_ENTITY_KEYS":    8,
+		"PACKET_KIND_BATCH":                 9,
+		"PACKET_KIND_UPDATE_POLICY":         10,
+		"PACKET_KIND_BLOCK_ENTITY":          11,
+	}
+)
+
+func (x PacketKind) Enum() *PacketKind {
+	p := new(PacketKind)
+	*p = x
+	return p
+}
+
+func (x PacketKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PacketKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_shinzonetwork_sourcehub_v1_packet_proto_enumTypes[0].Descriptor()
+}
+
+func (PacketKind) Type() protoreflect.EnumType {
+	return &file_shinzonetwork_sourcehub_v1_packet_proto_enumTypes[0]
+}
+
+func (x PacketKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PacketKind.Descriptor instead.
+func (PacketKind) EnumDescriptor() ([]byte, []int) {
+	return file_shinzonetwork_sourcehub_v1_packet_proto_rawDescGZIP(), []int{0}
+}
+
+// PacketStatus is the lifecycle state of an outgoing ICA packet.
+type PacketStatus int32
+
+const (
+	PacketStatus_PACKET_STATUS_UNSPECIFIED  PacketStatus = 0
+	PacketStatus_PACKET_STATUS_PENDING      PacketStatus = 1
+	PacketStatus_PACKET_STATUS_ACKNOWLEDGED PacketStatus = 2
+	PacketStatus_PACKET_STATUS_FAILED       PacketStatus = 3
+	PacketStatus_PACKET_STATUS_TIMED_OUT    PacketStatus = 4
+	// Waiting in the module outbox to be sent
+	PacketStatus_PACKET_STATUS_QUEUED PacketStatus = 5
+)
+
+// Enum value maps for PacketStatus.
+var (
+	PacketStatus_name = map[int32]string{
+		0: "PACKET_STATUS_UNSPECIFIED",
+		1: "PACKET_STATUS_PENDING",
+		2: "PACKET_STATUS_ACKNOWLEDGED",
+		3: "PACKET_STATUS_FAILED",
+		4: "PACKET_STATUS_TIMED_OUT",
+		5: "PACKET_STATUS_QUEUED",
+	}
+	PacketStatus_value = map[string]int32{
+		"PACKET_STATUS_UNSPECIFIED":  0,
+		"PACKET_STATUS_PENDING":      1,
+		"PACKET_STATUS_ACKNOWLEDGED": 2,
+		"PACKET_STATUS_FAILED":       3,
+		"PACKET_STATUS_TIMED_OUT":    4,
+		"PACKET_STATUS_QUEUED":       5,
+	}
+)
+
+func (x PacketStatus) Enum() *PacketStatus {
+	p := new(PacketStatus)
+	*p = x
+	return p
+}
+
+func (x PacketStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PacketStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_shinzonetwork_sourcehub_v1_packet_proto_enumTypes[1].Descriptor()
+}
+
+func (PacketStatus) Type() protoreflect.EnumType {
+	return &file_shinzonetwork_sourcehub_v1_packet_proto_enumTypes[1]
+}
+
+func (x PacketStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PacketStatus.Descriptor instead.
+func (PacketStatus) EnumDescriptor() ([]byte, []int) {
+	return file_shinzonetwork_sourcehub_v1_packet_proto_rawDescGZIP(), []int{1}
+}
+
+// IcaPacket records an ACP command sent to SourceHub and its outcome.
+type IcaPacket struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// IBC channel the packet was sent on
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// IBC packet sequence on the channel
+	Sequence uint64     `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Kind     PacketKind `protobuf:"varint,3,opt,name=kind,proto3,enum=shinzonetwork.sourcehub.v1.PacketKind" json:"kind,omitempty"`
+	// Marshalled ICA CosmosTx carried by the packet
+	Payload []byte `protobuf:"bytes,4,opt,name=payload,proto3" json:"payload,omitempty"`
+	// Account that triggered the command
+	Sender string `protobuf:"bytes,5,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Block height the packet was sent at
+	Height int64        `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+	Status PacketStatus `protobuf:"varint,7,opt,name=status,proto3,enum=shinzonetwork.sourcehub.v1.PacketStatus" json:"status,omitempty"`
+	// Result bytes of a successful acknowledgement
+	Result []byte `protobuf:"bytes,8,opt,name=result,proto3" json:"result,omitempty"`
+	// Error reported by SourceHub for a failed acknowledgement
+	Error string `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+	// Block height the acknowledgement or timeout was processed at
+	ResolvedHeight int64 `protobuf:"varint,10,opt,name=resolved_height,json=resolvedHeight,proto3" json:"resolved_height,omitempty"`
+	// Outbox commands carried by the packet, in message order
+	CommandIds []uint64 `protobuf:"varint,11,rep,packed,name=command_ids,json=commandIds,proto3" json:"command_ids,omitempty"`
+	// Chain ID of the SourceHub target the packet was sent to
+	Target string `protobuf:"bytes,12,opt,name=target,proto3" json:"target,omitempty"`
+}
+
+func (x *IcaPacket) Reset() {
+	*x = IcaPacket{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shinzonetwork_sourcehub_v1_packet_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IcaPacket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IcaPacket) ProtoMessage() {}
+
+// Deprecated: Use IcaPacket.ProtoReflect.Descriptor instead.
+func (*IcaPacket) Descriptor() ([]byte, []int) {
+	return file_shinzonetwork_sourcehub_v1_packet_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *IcaPacket) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *IcaPacket) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *IcaPacket) GetKind() PacketKind {
+	if x != nil {
+		return x.Kind
+	}
+	return PacketKind_PACKET_KIND_UNSPECIFIED
+}
+
+func (x *IcaPacket) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *IcaPacket) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *IcaPacket) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *IcaPacket) GetStatus() PacketStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PacketStatus_PACKET_STATUS_UNSPECIFIED
+}
+
+func (x *IcaPacket) GetResult() []byte {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *IcaPacket) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *IcaPacket) GetResolvedHeight() int64 {
+	if x != nil {
+		return x.ResolvedHeight
+	}
+	return 0
+}
+
+func (x *IcaPacket) GetCommandIds() []uint64 {
+	if x != nil {
+		return x.CommandIds
+	}
+	return nil
+}
+
+func (x *IcaPacket) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+// IcaCommand is a set of ACP messages queued in the module outbox. The
+// commands queued during a block are sent to SourceHub in EndBlocker, batched
+// into as few ICA packets as the max batch size allows.
+type IcaCommand struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   uint64     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind PacketKind `protobuf:"varint,2,opt,name=kind,proto3,enum=shinzonetwork.sourcehub.v1.PacketKind" json:"kind,omitempty"`
+	// Account that triggered the command
+	Sender string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Hash of the ShinzoHub transaction that queued the command
+	TxHash string `protobuf:"bytes,4,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// Block height the command was queued at
+	Height int64 `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	// Marshalled ICA CosmosTx holding the command messages
+	Payload []byte `protobuf:"bytes,6,opt,name=payload,proto3" json:"payload,omitempty"`
+	// IBC channel of the packet that carried the command
+	ChannelId string `protobuf:"bytes,7,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// IBC packet sequence of the packet that carried the command
+	Sequence uint64       `protobuf:"varint,8,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Status   PacketStatus `protobuf:"varint,9,opt,name=status,proto3,enum=shinzonetwork.sourcehub.v1.PacketStatus" json:"status,omitempty"`
+	// Marshalled TxMsgData holding the responses to the command messages
+	Result []byte `protobuf:"bytes,10,opt,name=result,proto3" json:"result,omitempty"`
+	// Error reported by SourceHub for the packet that carried the command
+	Error string `protobuf:"bytes,11,opt,name=error,proto3" json:"error,omitempty"`
+	// Number of times the command was sent to SourceHub
+	Attempts uint32 `protobuf:"varint,12,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// A retried command is not sent before this height
+	NextAttemptHeight int64 `protobuf:"varint,13,opt,name=next_attempt_height,json=nextAttemptHeight,proto3" json:"next_attempt_height,omitempty"`
+	// Chain ID of the SourceHub target the command is sent to
+	Target string `protobuf:"bytes,14,opt,name=target,proto3" json:"target,omitempty"`
+}
+
+func (x *IcaCommand) Reset() {
+	*x = IcaCommand{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shinzonetwork_sourcehub_v1_packet_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IcaCommand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IcaCommand) ProtoMessage() {}
+
+// Deprecated: Use IcaCommand.ProtoReflect.Descriptor instead.
+func (*IcaCommand) Descriptor() ([]byte, []int) {
+	return file_shinzonetwork_sourcehub_v1_packet_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *IcaCommand) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *IcaCommand) GetKind() PacketKind {
+	if x != nil {
+		return x.Kind
+	}
+	return PacketKind_PACKET_KIND_UNSPECIFIED
+}
+
+func (x *IcaCommand) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *IcaCommand) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+func (x *IcaCommand) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *IcaCommand) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *IcaCommand) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *IcaCommand) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *IcaCommand) GetStatus() PacketStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PacketStatus_PACKET_STATUS_UNSPECIFIED
+}
+
+func (x *IcaCommand) GetResult() []byte {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *IcaCommand) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *IcaCommand) GetAttempts() uint32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *IcaCommand) GetNextAttemptHeight() int64 {
+	if x != nil {
+		return x.NextAttemptHeight
+	}
+	return 0
+}
+
+func (x *IcaCommand) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+var File_shinzonetwork_sourcehub_v1_packet_proto protoreflect.FileDescriptor
+
+var file_shinzonetwork_sourcehub_v1_packet_proto_rawDesc = []byte{
+	0x0a, 0x27, 0x73, 0x68, 0x69, 0x6e, 0x7a, 0x6f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x68, 0x75, 0x62, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1a, 0x73, 0x68, 0x69, 0x6e, 0x7a,
+	0x6f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x68,
+	0x75, 0x62, 0x2e, 0x76, 0x31, 0x22, 0x9e, 0x03, 0x0a, 0x09, 0x49, 0x63, 0x61, 0x50, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x3a,
+	0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x73,
+	0x68, 0x69, 0x6e, 0x7a, 0x6f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x68, 0x75, 0x62, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x40, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e, 0x73, 0x68, 0x69, 0x6e, 0x7a, 0x6f, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x68, 0x75, 0x62, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64,
+	0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x72,
+	0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1f, 0x0a,
+	0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x0b, 0x20, 0x03,
+	0x28, 0x04, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x49, 0x64, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x22, 0xca, 0x03, 0x0a, 0x0a, 0x49, 0x63, 0x61, 0x43, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3a, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x73, 0x68, 0x69, 0x6e, 0x7a, 0x6f, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x2e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x68, 0x75, 0x62, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x78, 0x5f,
+	0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61,
+	0x73, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f,
+	0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12,
+	0x40, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x28, 0x2e, 0x73, 0x68, 0x69, 0x6e, 0x7a, 0x6f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x68, 0x75, 0x62, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12,
+	0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x6e,
+	0x65, 0x78, 0x74, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x6e, 0x65, 0x78, 0x74, 0x41, 0x74,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x2a, 0x96, 0x03, 0x0a, 0x0a, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x4b, 0x69,
+	0x6e, 0x64, 0x12, 0x1b, 0x0a, 0x17, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x4b, 0x49, 0x4e,
+	0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x1f, 0x0a, 0x1b, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x52,
+	0x45, 0x47, 0x49, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x10, 0x01,
+	0x12, 0x1f, 0x0a, 0x1b, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f,
+	0x52, 0x45, 0x47, 0x49, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x4f, 0x42, 0x4a, 0x45, 0x43, 0x54, 0x10,
+	0x02, 0x12, 0x1f, 0x0a, 0x1b, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44,
+	0x5f, 0x52, 0x45, 0x47, 0x49, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59,
+	0x10, 0x03, 0x12, 0x20, 0x0a, 0x1c, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x4b, 0x49, 0x4e,
+	0x44, 0x5f, 0x52, 0x45, 0x47, 0x49, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x4f, 0x42, 0x4a, 0x45, 0x43,
+	0x54, 0x53, 0x10, 0x04, 0x12, 0x25, 0x0a, 0x21, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x4b,
+	0x49, 0x4e, 0x44, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x53, 0x54, 0x52, 0x45,
+	0x41, 0x4d, 0x5f, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x05, 0x12, 0x24, 0x0a, 0x20, 0x50,
+	0x41, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x52, 0x45, 0x56, 0x4f, 0x4b,
+	0x45, 0x5f, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x5f, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10,
+	0x06, 0x12, 0x21, 0x0a, 0x1d, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44,
+	0x5f, 0x55, 0x4e, 0x52, 0x45, 0x47, 0x49, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x45, 0x4e, 0x54, 0x49,
+	0x54, 0x59, 0x10, 0x07, 0x12, 0x22, 0x0a, 0x1e, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x4b,
+	0x49, 0x4e, 0x44, 0x5f, 0x52, 0x4f, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x45, 0x4e, 0x54, 0x49, 0x54,
+	0x59, 0x5f, 0x4b, 0x45, 0x59, 0x53, 0x10, 0x08, 0x12, 0x15, 0x0a, 0x11, 0x50, 0x41, 0x43, 0x4b,
+	0x45, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x42, 0x41, 0x54, 0x43, 0x48, 0x10, 0x09, 0x12,
+	0x1d, 0x0a, 0x19, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55,
+	0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x10, 0x0a, 0x12, 0x1c,
+	0x0a, 0x18, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x42, 0x4c,
+	0x4f, 0x43, 0x4b, 0x5f, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x10, 0x0b, 0x2a, 0xb9, 0x01, 0x0a,
+	0x0c, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a,
+	0x19, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15,
+	0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x45,
+	0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x1e, 0x0a, 0x1a, 0x50, 0x41, 0x43, 0x4b, 0x45,
+	0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x43, 0x4b, 0x4e, 0x4f, 0x57, 0x4c,
+	0x45, 0x44, 0x47, 0x45, 0x44, 0x10, 0x02, 0x12, 0x18, 0x0a, 0x14, 0x50, 0x41, 0x43, 0x4b, 0x45,
+	0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10,
+	0x03, 0x12, 0x1b, 0x0a, 0x17, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x44, 0x5f, 0x4f, 0x55, 0x54, 0x10, 0x04, 0x12, 0x18,
+	0x0a, 0x14, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
+	0x51, 0x55, 0x45, 0x55, 0x45, 0x44, 0x10, 0x05, 0x42, 0x86, 0x02, 0x0a, 0x1e, 0x63, 0x6f, 0x6d,
+	0x2e, 0x73, 0x68, 0x69, 0x6e, 0x7a, 0x6f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x68, 0x75, 0x62, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x50, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4d, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x68, 0x69, 0x6e, 0x7a, 0x6f, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x73, 0x68, 0x69, 0x6e, 0x7a, 0x6f, 0x68, 0x75, 0x62, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x73, 0x68, 0x69, 0x6e, 0x7a, 0x6f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x2f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x68, 0x75, 0x62, 0x2f, 0x76, 0x31, 0x3b, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x68, 0x75, 0x62, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x53, 0x53, 0x58, 0xaa,
+	0x02, 0x1a, 0x53, 0x68, 0x69, 0x6e, 0x7a, 0x6f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x68, 0x75, 0x62, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x1a, 0x53,
+	0x68, 0x69, 0x6e, 0x7a, 0x6f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5c, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x68, 0x75, 0x62, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x26, 0x53, 0x68, 0x69, 0x6e,
+	0x7a, 0x6f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5c, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x68, 0x75, 0x62, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0xea, 0x02, 0x1c, 0x53, 0x68, 0x69, 0x6e, 0x7a, 0x6f, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x3a, 0x3a, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x68, 0x75, 0x62, 0x3a, 0x3a, 0x56,
+	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_shinzonetwork_sourcehub_v1_packet_proto_rawDescOnce sync.Once
+	file_shinzonetwork_sourcehub_v1_packet_proto_rawDescData = file_shinzonetwork_sourcehub_v1_packet_proto_rawDesc
+)
+
+func file_shinzonetwork_sourcehub_v1_packet_proto_rawDescGZIP() []byte {
+	file_shinzonetwork_sourcehub_v1_packet_proto_rawDescOnce.Do(func() {
+		file_shinzonetwork_sourcehub_v1_packet_proto_rawDescData = protoimpl.X.CompressGZIP(file_shinzonetwork_sourcehub_v1_packet_proto_rawDescData)
+	})
+	return file_shinzonetwork_sourcehub_v1_packet_proto_rawDescData
+}
+
+var file_shinzonetwork_sourcehub_v1_packet_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_shinzonetwork_sourcehub_v1_packet_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_shinzonetwork_sourcehub_v1_packet_proto_goTypes = []interface{}{
+	(PacketKind)(0),    // 0: shinzonetwork.sourcehub.v1.PacketKind
+	(PacketStatus)(0),  // 1: shinzonetwork.sourcehub.v1.PacketStatus
+	(*IcaPacket)(nil),  // 2: shinzonetwork.sourcehub.v1.IcaPacket
+	(*IcaCommand)(nil), // 3: shinzonetwork.sourcehub.v1.IcaCommand
+}
+var file_shinzonetwork_sourcehub_v1_packet_proto_depIdxs = []int32{
+	0, // 0: shinzonetwork.sourcehub.v1.IcaPacket.kind:type_name -> shinzonetwork.sourcehub.v1.PacketKind
+	1, // 1: shinzonetwork.sourcehub.v1.IcaPacket.status:type_name -> shinzonetwork.sourcehub.v1.PacketStatus
+	0, // 2: shinzonetwork.sourcehub.v1.IcaCommand.kind:type_name -> shinzonetwork.sourcehub.v1.PacketKind
+	1, // 3: shinzonetwork.sourcehub.v1.IcaCommand.status:type_name -> shinzonetwork.sourcehub.v1.PacketStatus
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_shinzonetwork_sourcehub_v1_packet_proto_init() }
+func file_shinzonetwork_sourcehub_v1_packet_proto_init() {
+	if File_shinzonetwork_sourcehub_v1_packet_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_shinzonetwork_sourcehub_v1_packet_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IcaPacket); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shinzonetwork_sourcehub_v1_packet_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IcaCommand); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_shinzonetwork_sourcehub_v1_packet_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_shinzonetwork_sourcehub_v1_packet_proto_goTypes,
+		DependencyIndexes: file_shinzonetwork_sourcehub_v1_packet_proto_depIdxs,
+		EnumInfos:         file_shinzonetwork_sourcehub_v1_packet_proto_enumTypes,
+		MessageInfos:      file_shinzonetwork_sourcehub_v1_packet_proto_msgTypes,
+	}.Build()
+	File_shinzonetwork_sourcehub_v1_packet_proto = out.File
+	file_shinzonetwork_sourcehub_v1_packet_proto_rawDesc = nil
+	file_shinzonetwork_sourcehub_v1_packet_proto_goTypes = nil
+	file_shinzonetwork_sourcehub_v1_packet_proto_depIdxs = nil
+}
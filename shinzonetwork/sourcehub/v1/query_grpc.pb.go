@@ -0,0 +1,1423 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: shinzonetwork/sourcehub/v1/query.proto
+
+package sourcehubv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Query_Params_FullMethodName                = "/shinzonetwork.sourcehub.v1.Query/Params"
+	Query_IcaAddress_FullMethodName            = "/shinzonetwork.sourcehub.v1.Query/IcaAddress"
+	Query_IcaMetadata_FullMethodName           = "/shinzonetwork.sourcehub.v1.Query/IcaMetadata"
+	Query_PolicyId_FullMethodName              = "/shinzonetwork.sourcehub.v1.Query/PolicyId"
+	Query_IcaPacket_FullMethodName             = "/shinzonetwork.sourcehub.v1.Query/IcaPacket"
+	Query_IcaPackets_FullMethodName            = "/shinzonetwork.sourcehub.v1.Query/IcaPackets"
+	Query_IcaCommand_FullMethodName            = "/shinzonetwork.sourcehub.v1.Query/IcaCommand"
+	Query_IcaCommands_FullMethodName           = "/shinzonetwork.sourcehub.v1.Query/IcaCommands"
+	Query_Targets_FullMethodName               = "/shinzonetwork.sourcehub.v1.Query/Targets"
+	Query_Target_FullMethodName                = "/shinzonetwork.sourcehub.v1.Query/Target"
+	Query_IcaChannel_FullMethodName            = "/shinzonetwork.sourcehub.v1.Query/IcaChannel"
+	Query_DeadLetters_FullMethodName           = "/shinzonetwork.sourcehub.v1.Query/DeadLetters"
+	Query_StreamGrants_FullMethodName          = "/shinzonetwork.sourcehub.v1.Query/StreamGrants"
+	Query_RoleHolders_FullMethodName           = "/shinzonetwork.sourcehub.v1.Query/RoleHolders"
+	Query_AccountRoles_FullMethodName          = "/shinzonetwork.sourcehub.v1.Query/AccountRoles"
+	Query_Entities_FullMethodName              = "/shinzonetwork.sourcehub.v1.Query/Entities"
+	Query_EntitiesByAddress_FullMethodName     = "/shinzonetwork.sourcehub.v1.Query/EntitiesByAddress"
+	Query_EntitiesByDid_FullMethodName         = "/shinzonetwork.sourcehub.v1.Query/EntitiesByDid"
+	Query_EntitiesByPid_FullMethodName         = "/shinzonetwork.sourcehub.v1.Query/EntitiesByPid"
+	Query_DidByAddress_FullMethodName          = "/shinzonetwork.sourcehub.v1.Query/DidByAddress"
+	Query_PidByAddress_FullMethodName          = "/shinzonetwork.sourcehub.v1.Query/PidByAddress"
+	Query_Relationship_FullMethodName          = "/shinzonetwork.sourcehub.v1.Query/Relationship"
+	Query_RelationshipsByObject_FullMethodName = "/shinzonetwork.sourcehub.v1.Query/RelationshipsByObject"
+	Query_RelationshipsByActor_FullMethodName  = "/shinzonetwork.sourcehub.v1.Query/RelationshipsByActor"
+	Query_ShinzoPolicy_FullMethodName          = "/shinzonetwork.sourcehub.v1.Query/ShinzoPolicy"
+	Query_ShinzoPolicyVersion_FullMethodName   = "/shinzonetwork.sourcehub.v1.Query/ShinzoPolicyVersion"
+	Query_ShinzoPolicyHistory_FullMethodName   = "/shinzonetwork.sourcehub.v1.Query/ShinzoPolicyHistory"
+	Query_StreamPrice_FullMethodName           = "/shinzonetwork.sourcehub.v1.Query/StreamPrice"
+	Query_StreamPrices_FullMethodName          = "/shinzonetwork.sourcehub.v1.Query/StreamPrices"
+	Query_StreamPayments_FullMethodName        = "/shinzonetwork.sourcehub.v1.Query/StreamPayments"
+	Query_PendingRewards_FullMethodName        = "/shinzonetwork.sourcehub.v1.Query/PendingRewards"
+	Query_StreamRevenues_FullMethodName        = "/shinzonetwork.sourcehub.v1.Query/StreamRevenues"
+	Query_EntityUnbondings_FullMethodName      = "/shinzonetwork.sourcehub.v1.Query/EntityUnbondings"
+)
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Query defines the gRPC querier service.
+type QueryClient interface {
+	// Params returns the module parameters.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// IcaAddress returns the interchain account address owned by the module on SourceHub.
+	IcaAddress(ctx context.Context, in *QueryIcaAddressRequest, opts ...grpc.CallOption) (*QueryIcaAddressResponse, error)
+	// IcaMetadata returns the ICS-27 metadata used to open the SourceHub channel.
+	IcaMetadata(ctx context.Context, in *QueryIcaMetadataRequest, opts ...grpc.CallOption) (*QueryIcaMetadataResponse, error)
+	// PolicyId returns the shinzohub policy ID on SourceHub.
+	PolicyId(ctx context.Context, in *QueryPolicyIdRequest, opts ...grpc.CallOption) (*QueryPolicyIdResponse, error)
+	// IcaPacket returns a tracked ICA packet by channel and sequence.
+	IcaPacket(ctx context.Context, in *QueryIcaPacketRequest, opts ...grpc.CallOption) (*QueryIcaPacketResponse, error)
+	// IcaPackets returns all tracked ICA packets, optionally filtered by status.
+	IcaPackets(ctx context.Context, in *QueryIcaPacketsRequest, opts ...grpc.CallOption) (*QueryIcaPacketsResponse, error)
+	// IcaCommand returns an outbox command by ID.
+	IcaCommand(ctx context.Context, in *QueryIcaCommandRequest, opts ...grpc.CallOption) (*QueryIcaCommandResponse, error)
+	// IcaCommands returns outbox commands, optionally filtered by originating
+	// transaction or status.
+	IcaCommands(ctx context.Context, in *QueryIcaCommandsRequest, opts ...grpc.CallOption) (*QueryIcaCommandsResponse, error)
+	// Targets returns the SourceHub chains the module is connected to.
+	Targets(ctx context.Context, in *QueryTargetsRequest, opts ...grpc.CallOption) (*QueryTargetsResponse, error)
+	// Target returns a SourceHub target by chain ID.
+	Target(ctx context.Context, in *QueryTargetRequest, opts ...grpc.CallOption) (*QueryTargetResponse, error)
+	// IcaChannel returns the state of the ICA channel to SourceHub.
+	IcaChannel(ctx context.Context, in *QueryIcaChannelRequest, opts ...grpc.CallOption) (*QueryIcaChannelResponse, error)
+	// DeadLetters returns the commands that exhausted their attempts.
+	DeadLetters(ctx context.Context, in *QueryDeadLettersRequest, opts ...grpc.CallOption) (*QueryDeadLettersResponse, error)
+	// StreamGrants returns stream access grants, optionally filtered by stream or DID.
+	StreamGrants(ctx context.Context, in *QueryStreamGrantsRequest, opts ...grpc.CallOption) (*QueryStreamGrantsResponse, error)
+	// RoleHolders returns the accounts holding module roles, optionally filtered by role.
+	RoleHolders(ctx context.Context, in *QueryRoleHoldersRequest, opts ...grpc.CallOption) (*QueryRoleHoldersResponse, error)
+	// AccountRoles returns the module roles held by an account.
+	AccountRoles(ctx context.Context, in *QueryAccountRolesRequest, opts ...grpc.CallOption) (*QueryAccountRolesResponse, error)
+	// Entities returns all registered entities, optionally filtered by role.
+	Entities(ctx context.Context, in *QueryEntitiesRequest, opts ...grpc.CallOption) (*QueryEntitiesResponse, error)
+	// EntitiesByAddress returns the entities registered by an account.
+	EntitiesByAddress(ctx context.Context, in *QueryEntitiesByAddressRequest, opts ...grpc.CallOption) (*QueryEntitiesByAddressResponse, error)
+	// EntitiesByDid returns the entities registered with a DID.
+	EntitiesByDid(ctx context.Context, in *QueryEntitiesByDidRequest, opts ...grpc.CallOption) (*QueryEntitiesByDidResponse, error)
+	// EntitiesByPid returns the entities registered with a libp2p peer ID.
+	EntitiesByPid(ctx context.Context, in *QueryEntitiesByPidRequest, opts ...grpc.CallOption) (*QueryEntitiesByPidResponse, error)
+	// DidByAddress returns the DID registered by an account.
+	DidByAddress(ctx context.Context, in *QueryDidByAddressRequest, opts ...grpc.CallOption) (*QueryDidByAddressResponse, error)
+	// PidByAddress returns the libp2p peer ID registered by an account.
+	PidByAddress(ctx context.Context, in *QueryPidByAddressRequest, opts ...grpc.CallOption) (*QueryPidByAddressResponse, error)
+	// Relationship returns a mirrored ACP relationship.
+	Relationship(ctx context.Context, in *QueryRelationshipRequest, opts ...grpc.CallOption) (*QueryRelationshipResponse, error)
+	// RelationshipsByObject returns the mirrored relationships of an object,
+	// optionally only those with a relation.
+	RelationshipsByObject(ctx context.Context, in *QueryRelationshipsByObjectRequest, opts ...grpc.CallOption) (*QueryRelationshipsByObjectResponse, error)
+	// RelationshipsByActor returns the mirrored relationships of an actor,
+	// optionally only those with a relation.
+	RelationshipsByActor(ctx context.Context, in *QueryRelationshipsByActorRequest, opts ...grpc.CallOption) (*QueryRelationshipsByActorResponse, error)
+	// ShinzoPolicy returns the active version of the shinzohub policy.
+	ShinzoPolicy(ctx context.Context, in *QueryShinzoPolicyRequest, opts ...grpc.CallOption) (*QueryShinzoPolicyResponse, error)
+	// ShinzoPolicyVersion returns a version of the shinzohub policy.
+	ShinzoPolicyVersion(ctx context.Context, in *QueryShinzoPolicyVersionRequest, opts ...grpc.CallOption) (*QueryShinzoPolicyVersionResponse, error)
+	// ShinzoPolicyHistory returns the versions of the shinzohub policy adopted
+	// through governance, oldest first.
+	ShinzoPolicyHistory(ctx context.Context, in *QueryShinzoPolicyHistoryRequest, opts ...grpc.CallOption) (*QueryShinzoPolicyHistoryResponse, error)
+	// StreamPrice returns the price of access to a stream.
+	StreamPrice(ctx context.Context, in *QueryStreamPriceRequest, opts ...grpc.CallOption) (*QueryStreamPriceResponse, error)
+	// StreamPrices returns the prices of every priced stream.
+	StreamPrices(ctx context.Context, in *QueryStreamPricesRequest, opts ...grpc.CallOption) (*QueryStreamPricesResponse, error)
+	// StreamPayments returns the payments held in escrow until SourceHub
+	// acknowledges the access they bought, optionally filtered by payer.
+	StreamPayments(ctx context.Context, in *QueryStreamPaymentsRequest, opts ...grpc.CallOption) (*QueryStreamPaymentsResponse, error)
+	// PendingRewards returns the rewards credited to an account and not
+	// withdrawn yet.
+	PendingRewards(ctx context.Context, in *QueryPendingRewardsRequest, opts ...grpc.CallOption) (*QueryPendingRewardsResponse, error)
+	// StreamRevenues returns the subscription fees collected per stream in the
+	// current epoch.
+	StreamRevenues(ctx context.Context, in *QueryStreamRevenuesRequest, opts ...grpc.CallOption) (*QueryStreamRevenuesResponse, error)
+	// EntityUnbondings returns the bonds of removed or blocked entities waiting
+	// for the end of their unbonding period.
+	EntityUnbondings(ctx context.Context, in *QueryEntityUnbondingsRequest, opts ...grpc.CallOption) (*QueryEntityUnbondingsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQueryClient(cc grpc.ClientConnInterface) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, Query_Params_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IcaAddress(ctx context.Context, in *QueryIcaAddressRequest, opts ...grpc.CallOption) (*QueryIcaAddressResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryIcaAddressResponse)
+	err := c.cc.Invoke(ctx, Query_IcaAddress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IcaMetadata(ctx context.Context, in *QueryIcaMetadataRequest, opts ...grpc.CallOption) (*QueryIcaMetadataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryIcaMetadataResponse)
+	err := c.cc.Invoke(ctx, Query_IcaMetadata_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PolicyId(ctx context.Context, in *QueryPolicyIdRequest, opts ...grpc.CallOption) (*QueryPolicyIdResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryPolicyIdResponse)
+	err := c.cc.Invoke(ctx, Query_PolicyId_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IcaPacket(ctx context.Context, in *QueryIcaPacketRequest, opts ...grpc.CallOption) (*QueryIcaPacketResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryIcaPacketResponse)
+	err := c.cc.Invoke(ctx, Query_IcaPacket_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IcaPackets(ctx context.Context, in *QueryIcaPacketsRequest, opts ...grpc.CallOption) (*QueryIcaPacketsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryIcaPacketsResponse)
+	err := c.cc.Invoke(ctx, Query_IcaPackets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IcaCommand(ctx context.Context, in *QueryIcaCommandRequest, opts ...grpc.CallOption) (*QueryIcaCommandResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryIcaCommandResponse)
+	err := c.cc.Invoke(ctx, Query_IcaCommand_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IcaCommands(ctx context.Context, in *QueryIcaCommandsRequest, opts ...grpc.CallOption) (*QueryIcaCommandsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryIcaCommandsResponse)
+	err := c.cc.Invoke(ctx, Query_IcaCommands_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Targets(ctx context.Context, in *QueryTargetsRequest, opts ...grpc.CallOption) (*QueryTargetsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryTargetsResponse)
+	err := c.cc.Invoke(ctx, Query_Targets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Target(ctx context.Context, in *QueryTargetRequest, opts ...grpc.CallOption) (*QueryTargetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryTargetResponse)
+	err := c.cc.Invoke(ctx, Query_Target_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IcaChannel(ctx context.Context, in *QueryIcaChannelRequest, opts ...grpc.CallOption) (*QueryIcaChannelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryIcaChannelResponse)
+	err := c.cc.Invoke(ctx, Query_IcaChannel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DeadLetters(ctx context.Context, in *QueryDeadLettersRequest, opts ...grpc.CallOption) (*QueryDeadLettersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryDeadLettersResponse)
+	err := c.cc.Invoke(ctx, Query_DeadLetters_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StreamGrants(ctx context.Context, in *QueryStreamGrantsRequest, opts ...grpc.CallOption) (*QueryStreamGrantsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryStreamGrantsResponse)
+	err := c.cc.Invoke(ctx, Query_StreamGrants_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RoleHolders(ctx context.Context, in *QueryRoleHoldersRequest, opts ...grpc.CallOption) (*QueryRoleHoldersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryRoleHoldersResponse)
+	err := c.cc.Invoke(ctx, Query_RoleHolders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccountRoles(ctx context.Context, in *QueryAccountRolesRequest, opts ...grpc.CallOption) (*QueryAccountRolesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryAccountRolesResponse)
+	err := c.cc.Invoke(ctx, Query_AccountRoles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Entities(ctx context.Context, in *QueryEntitiesRequest, opts ...grpc.CallOption) (*QueryEntitiesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryEntitiesResponse)
+	err := c.cc.Invoke(ctx, Query_Entities_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EntitiesByAddress(ctx context.Context, in *QueryEntitiesByAddressRequest, opts ...grpc.CallOption) (*QueryEntitiesByAddressResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryEntitiesByAddressResponse)
+	err := c.cc.Invoke(ctx, Query_EntitiesByAddress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EntitiesByDid(ctx context.Context, in *QueryEntitiesByDidRequest, opts ...grpc.CallOption) (*QueryEntitiesByDidResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryEntitiesByDidResponse)
+	err := c.cc.Invoke(ctx, Query_EntitiesByDid_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EntitiesByPid(ctx context.Context, in *QueryEntitiesByPidRequest, opts ...grpc.CallOption) (*QueryEntitiesByPidResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryEntitiesByPidResponse)
+	err := c.cc.Invoke(ctx, Query_EntitiesByPid_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DidByAddress(ctx context.Context, in *QueryDidByAddressRequest, opts ...grpc.CallOption) (*QueryDidByAddressResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryDidByAddressResponse)
+	err := c.cc.Invoke(ctx, Query_DidByAddress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PidByAddress(ctx context.Context, in *QueryPidByAddressRequest, opts ...grpc.CallOption) (*QueryPidByAddressResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryPidByAddressResponse)
+	err := c.cc.Invoke(ctx, Query_PidByAddress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Relationship(ctx context.Context, in *QueryRelationshipRequest, opts ...grpc.CallOption) (*QueryRelationshipResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryRelationshipResponse)
+	err := c.cc.Invoke(ctx, Query_Relationship_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RelationshipsByObject(ctx context.Context, in *QueryRelationshipsByObjectRequest, opts ...grpc.CallOption) (*QueryRelationshipsByObjectResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryRelationshipsByObjectResponse)
+	err := c.cc.Invoke(ctx, Query_RelationshipsByObject_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RelationshipsByActor(ctx context.Context, in *QueryRelationshipsByActorRequest, opts ...grpc.CallOption) (*QueryRelationshipsByActorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryRelationshipsByActorResponse)
+	err := c.cc.Invoke(ctx, Query_RelationshipsByActor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ShinzoPolicy(ctx context.Context, in *QueryShinzoPolicyRequest, opts ...grpc.CallOption) (*QueryShinzoPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryShinzoPolicyResponse)
+	err := c.cc.Invoke(ctx, Query_ShinzoPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ShinzoPolicyVersion(ctx context.Context, in *QueryShinzoPolicyVersionRequest, opts ...grpc.CallOption) (*QueryShinzoPolicyVersionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryShinzoPolicyVersionResponse)
+	err := c.cc.Invoke(ctx, Query_ShinzoPolicyVersion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ShinzoPolicyHistory(ctx context.Context, in *QueryShinzoPolicyHistoryRequest, opts ...grpc.CallOption) (*QueryShinzoPolicyHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryShinzoPolicyHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_ShinzoPolicyHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StreamPrice(ctx context.Context, in *QueryStreamPriceRequest, opts ...grpc.CallOption) (*QueryStreamPriceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryStreamPriceResponse)
+	err := c.cc.Invoke(ctx, Query_StreamPrice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StreamPrices(ctx context.Context, in *QueryStreamPricesRequest, opts ...grpc.CallOption) (*QueryStreamPricesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryStreamPricesResponse)
+	err := c.cc.Invoke(ctx, Query_StreamPrices_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StreamPayments(ctx context.Context, in *QueryStreamPaymentsRequest, opts ...grpc.CallOption) (*QueryStreamPaymentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryStreamPaymentsResponse)
+	err := c.cc.Invoke(ctx, Query_StreamPayments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingRewards(ctx context.Context, in *QueryPendingRewardsRequest, opts ...grpc.CallOption) (*QueryPendingRewardsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryPendingRewardsResponse)
+	err := c.cc.Invoke(ctx, Query_PendingRewards_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StreamRevenues(ctx context.Context, in *QueryStreamRevenuesRequest, opts ...grpc.CallOption) (*QueryStreamRevenuesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryStreamRevenuesResponse)
+	err := c.cc.Invoke(ctx, Query_StreamRevenues_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EntityUnbondings(ctx context.Context, in *QueryEntityUnbondingsRequest, opts ...grpc.CallOption) (*QueryEntityUnbondingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryEntityUnbondingsResponse)
+	err := c.cc.Invoke(ctx, Query_EntityUnbondings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+// All implementations must embed UnimplementedQueryServer
+// for forward compatibility.
+//
+// Query defines the gRPC querier service.
+type QueryServer interface {
+	// Params returns the module parameters.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// IcaAddress returns the interchain account address owned by the module on SourceHub.
+	IcaAddress(context.Context, *QueryIcaAddressRequest) (*QueryIcaAddressResponse, error)
+	// IcaMetadata returns the ICS-27 metadata used to open the SourceHub channel.
+	IcaMetadata(context.Context, *QueryIcaMetadataRequest) (*QueryIcaMetadataResponse, error)
+	// PolicyId returns the shinzohub policy ID on SourceHub.
+	PolicyId(context.Context, *QueryPolicyIdRequest) (*QueryPolicyIdResponse, error)
+	// IcaPacket returns a tracked ICA packet by channel and sequence.
+	IcaPacket(context.Context, *QueryIcaPacketRequest) (*QueryIcaPacketResponse, error)
+	// IcaPackets returns all tracked ICA packets, optionally filtered by status.
+	IcaPackets(context.Context, *QueryIcaPacketsRequest) (*QueryIcaPacketsResponse, error)
+	// IcaCommand returns an outbox command by ID.
+	IcaCommand(context.Context, *QueryIcaCommandRequest) (*QueryIcaCommandResponse, error)
+	// IcaCommands returns outbox commands, optionally filtered by originating
+	// transaction or status.
+	IcaCommands(context.Context, *QueryIcaCommandsRequest) (*QueryIcaCommandsResponse, error)
+	// Targets returns the SourceHub chains the module is connected to.
+	Targets(context.Context, *QueryTargetsRequest) (*QueryTargetsResponse, error)
+	// Target returns a SourceHub target by chain ID.
+	Target(context.Context, *QueryTargetRequest) (*QueryTargetResponse, error)
+	// IcaChannel returns the state of the ICA channel to SourceHub.
+	IcaChannel(context.Context, *QueryIcaChannelRequest) (*QueryIcaChannelResponse, error)
+	// DeadLetters returns the commands that exhausted their attempts.
+	DeadLetters(context.Context, *QueryDeadLettersRequest) (*QueryDeadLettersResponse, error)
+	// StreamGrants returns stream access grants, optionally filtered by stream or DID.
+	StreamGrants(context.Context, *QueryStreamGrantsRequest) (*QueryStreamGrantsResponse, error)
+	// RoleHolders returns the accounts holding module roles, optionally filtered by role.
+	RoleHolders(context.Context, *QueryRoleHoldersRequest) (*QueryRoleHoldersResponse, error)
+	// AccountRoles returns the module roles held by an account.
+	AccountRoles(context.Context, *QueryAccountRolesRequest) (*QueryAccountRolesResponse, error)
+	// Entities returns all registered entities, optionally filtered by role.
+	Entities(context.Context, *QueryEntitiesRequest) (*QueryEntitiesResponse, error)
+	// EntitiesByAddress returns the entities registered by an account.
+	EntitiesByAddress(context.Context, *QueryEntitiesByAddressRequest) (*QueryEntitiesByAddressResponse, error)
+	// EntitiesByDid returns the entities registered with a DID.
+	EntitiesByDid(context.Context, *QueryEntitiesByDidRequest) (*QueryEntitiesByDidResponse, error)
+	// EntitiesByPid returns the entities registered with a libp2p peer ID.
+	EntitiesByPid(context.Context, *QueryEntitiesByPidRequest) (*QueryEntitiesByPidResponse, error)
+	// DidByAddress returns the DID registered by an account.
+	DidByAddress(context.Context, *QueryDidByAddressRequest) (*QueryDidByAddressResponse, error)
+	// PidByAddress returns the libp2p peer ID registered by an account.
+	PidByAddress(context.Context, *QueryPidByAddressRequest) (*QueryPidByAddressResponse, error)
+	// Relationship returns a mirrored ACP relationship.
+	Relationship(context.Context, *QueryRelationshipRequest) (*QueryRelationshipResponse, error)
+	// RelationshipsByObject returns the mirrored relationships of an object,
+	// optionally only those with a relation.
+	RelationshipsByObject(context.Context, *QueryRelationshipsByObjectRequest) (*QueryRelationshipsByObjectResponse, error)
+	// RelationshipsByActor returns the mirrored relationships of an actor,
+	// optionally only those with a relation.
+	RelationshipsByActor(context.Context, *QueryRelationshipsByActorRequest) (*QueryRelationshipsByActorResponse, error)
+	// ShinzoPolicy returns the active version of the shinzohub policy.
+	ShinzoPolicy(context.Context, *QueryShinzoPolicyRequest) (*QueryShinzoPolicyResponse, error)
+	// ShinzoPolicyVersion returns a version of the shinzohub policy.
+	ShinzoPolicyVersion(context.Context, *QueryShinzoPolicyVersionRequest) (*QueryShinzoPolicyVersionResponse, error)
+	// ShinzoPolicyHistory returns the versions of the shinzohub policy adopted
+	// through governance, oldest first.
+	ShinzoPolicyHistory(context.Context, *QueryShinzoPolicyHistoryRequest) (*QueryShinzoPolicyHistoryResponse, error)
+	// StreamPrice returns the price of access to a stream.
+	StreamPrice(context.Context, *QueryStreamPriceRequest) (*QueryStreamPriceResponse, error)
+	// StreamPrices returns the prices of every priced stream.
+	StreamPrices(context.Context, *QueryStreamPricesRequest) (*QueryStreamPricesResponse, error)
+	// StreamPayments returns the payments held in escrow until SourceHub
+	// acknowledges the access they bought, optionally filtered by payer.
+	StreamPayments(context.Context, *QueryStreamPaymentsRequest) (*QueryStreamPaymentsResponse, error)
+	// PendingRewards returns the rewards credited to an account and not
+	// withdrawn yet.
+	PendingRewards(context.Context, *QueryPendingRewardsRequest) (*QueryPendingRewardsResponse, error)
+	// StreamRevenues returns the subscription fees collected per stream in the
+	// current epoch.
+	StreamRevenues(context.Context, *QueryStreamRevenuesRequest) (*QueryStreamRevenuesResponse, error)
+	// EntityUnbondings returns the bonds of removed or blocked entities waiting
+	// for the end of their unbonding period.
+	EntityUnbondings(context.Context, *QueryEntityUnbondingsRequest) (*QueryEntityUnbondingsResponse, error)
+	mustEmbedUnimplementedQueryServer()
+}
+
+// UnimplementedQueryServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedQueryServer struct{}
+
+func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (UnimplementedQueryServer) IcaAddress(context.Context, *QueryIcaAddressRequest) (*QueryIcaAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IcaAddress not implemented")
+}
+func (UnimplementedQueryServer) IcaMetadata(context.Context, *QueryIcaMetadataRequest) (*QueryIcaMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IcaMetadata not implemented")
+}
+func (UnimplementedQueryServer) PolicyId(context.Context, *QueryPolicyIdRequest) (*QueryPolicyIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PolicyId not implemented")
+}
+func (UnimplementedQueryServer) IcaPacket(context.Context, *QueryIcaPacketRequest) (*QueryIcaPacketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IcaPacket not implemented")
+}
+func (UnimplementedQueryServer) IcaPackets(context.Context, *QueryIcaPacketsRequest) (*QueryIcaPacketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IcaPackets not implemented")
+}
+func (UnimplementedQueryServer) IcaCommand(context.Context, *QueryIcaCommandRequest) (*QueryIcaCommandResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IcaCommand not implemented")
+}
+func (UnimplementedQueryServer) IcaCommands(context.Context, *QueryIcaCommandsRequest) (*QueryIcaCommandsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IcaCommands not implemented")
+}
+func (UnimplementedQueryServer) Targets(context.Context, *QueryTargetsRequest) (*QueryTargetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Targets not implemented")
+}
+func (UnimplementedQueryServer) Target(context.Context, *QueryTargetRequest) (*QueryTargetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Target not implemented")
+}
+func (UnimplementedQueryServer) IcaChannel(context.Context, *QueryIcaChannelRequest) (*QueryIcaChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IcaChannel not implemented")
+}
+func (UnimplementedQueryServer) DeadLetters(context.Context, *QueryDeadLettersRequest) (*QueryDeadLettersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeadLetters not implemented")
+}
+func (UnimplementedQueryServer) StreamGrants(context.Context, *QueryStreamGrantsRequest) (*QueryStreamGrantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StreamGrants not implemented")
+}
+func (UnimplementedQueryServer) RoleHolders(context.Context, *QueryRoleHoldersRequest) (*QueryRoleHoldersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RoleHolders not implemented")
+}
+func (UnimplementedQueryServer) AccountRoles(context.Context, *QueryAccountRolesRequest) (*QueryAccountRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountRoles not implemented")
+}
+func (UnimplementedQueryServer) Entities(context.Context, *QueryEntitiesRequest) (*QueryEntitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Entities not implemented")
+}
+func (UnimplementedQueryServer) EntitiesByAddress(context.Context, *QueryEntitiesByAddressRequest) (*QueryEntitiesByAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EntitiesByAddress not implemented")
+}
+func (UnimplementedQueryServer) EntitiesByDid(context.Context, *QueryEntitiesByDidRequest) (*QueryEntitiesByDidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EntitiesByDid not implemented")
+}
+func (UnimplementedQueryServer) EntitiesByPid(context.Context, *QueryEntitiesByPidRequest) (*QueryEntitiesByPidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EntitiesByPid not implemented")
+}
+func (UnimplementedQueryServer) DidByAddress(context.Context, *QueryDidByAddressRequest) (*QueryDidByAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DidByAddress not implemented")
+}
+func (UnimplementedQueryServer) PidByAddress(context.Context, *QueryPidByAddressRequest) (*QueryPidByAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PidByAddress not implemented")
+}
+func (UnimplementedQueryServer) Relationship(context.Context, *QueryRelationshipRequest) (*QueryRelationshipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Relationship not implemented")
+}
+func (UnimplementedQueryServer) RelationshipsByObject(context.Context, *QueryRelationshipsByObjectRequest) (*QueryRelationshipsByObjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RelationshipsByObject not implemented")
+}
+func (UnimplementedQueryServer) RelationshipsByActor(context.Context, *QueryRelationshipsByActorRequest) (*QueryRelationshipsByActorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RelationshipsByActor not implemented")
+}
+func (UnimplementedQueryServer) ShinzoPolicy(context.Context, *QueryShinzoPolicyRequest) (*QueryShinzoPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShinzoPolicy not implemented")
+}
+func (UnimplementedQueryServer) ShinzoPolicyVersion(context.Context, *QueryShinzoPolicyVersionRequest) (*QueryShinzoPolicyVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShinzoPolicyVersion not implemented")
+}
+func (UnimplementedQueryServer) ShinzoPolicyHistory(context.Context, *QueryShinzoPolicyHistoryRequest) (*QueryShinzoPolicyHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShinzoPolicyHistory not implemented")
+}
+func (UnimplementedQueryServer) StreamPrice(context.Context, *QueryStreamPriceRequest) (*QueryStreamPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StreamPrice not implemented")
+}
+func (UnimplementedQueryServer) StreamPrices(context.Context, *QueryStreamPricesRequest) (*QueryStreamPricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StreamPrices not implemented")
+}
+func (UnimplementedQueryServer) StreamPayments(context.Context, *QueryStreamPaymentsRequest) (*QueryStreamPaymentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StreamPayments not implemented")
+}
+func (UnimplementedQueryServer) PendingRewards(context.Context, *QueryPendingRewardsRequest) (*QueryPendingRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingRewards not implemented")
+}
+func (UnimplementedQueryServer) StreamRevenues(context.Context, *QueryStreamRevenuesRequest) (*QueryStreamRevenuesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StreamRevenues not implemented")
+}
+func (UnimplementedQueryServer) EntityUnbondings(context.Context, *QueryEntityUnbondingsRequest) (*QueryEntityUnbondingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EntityUnbondings not implemented")
+}
+func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
+func (UnimplementedQueryServer) testEmbeddedByValue()               {}
+
+// UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QueryServer will
+// result in compilation errors.
+type UnsafeQueryServer interface {
+	mustEmbedUnimplementedQueryServer()
+}
+
+func RegisterQueryServer(s grpc.ServiceRegistrar, srv QueryServer) {
+	// If the following call pancis, it indicates UnimplementedQueryServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Query_ServiceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Params_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IcaAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIcaAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IcaAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_IcaAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IcaAddress(ctx, req.(*QueryIcaAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IcaMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIcaMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IcaMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_IcaMetadata_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IcaMetadata(ctx, req.(*QueryIcaMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PolicyId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPolicyIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PolicyId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PolicyId_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PolicyId(ctx, req.(*QueryPolicyIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IcaPacket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIcaPacketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IcaPacket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_IcaPacket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IcaPacket(ctx, req.(*QueryIcaPacketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IcaPackets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIcaPacketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IcaPackets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_IcaPackets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IcaPackets(ctx, req.(*QueryIcaPacketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IcaCommand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIcaCommandRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IcaCommand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_IcaCommand_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IcaCommand(ctx, req.(*QueryIcaCommandRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IcaCommands_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIcaCommandsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IcaCommands(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_IcaCommands_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IcaCommands(ctx, req.(*QueryIcaCommandsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Targets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTargetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Targets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Targets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Targets(ctx, req.(*QueryTargetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Target_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTargetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Target(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Target_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Target(ctx, req.(*QueryTargetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IcaChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIcaChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IcaChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_IcaChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IcaChannel(ctx, req.(*QueryIcaChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDeadLettersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DeadLetters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DeadLetters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DeadLetters(ctx, req.(*QueryDeadLettersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StreamGrants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStreamGrantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StreamGrants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_StreamGrants_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StreamGrants(ctx, req.(*QueryStreamGrantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RoleHolders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRoleHoldersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RoleHolders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RoleHolders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RoleHolders(ctx, req.(*QueryRoleHoldersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccountRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AccountRoles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountRoles(ctx, req.(*QueryAccountRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Entities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEntitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Entities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Entities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Entities(ctx, req.(*QueryEntitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EntitiesByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEntitiesByAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EntitiesByAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_EntitiesByAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EntitiesByAddress(ctx, req.(*QueryEntitiesByAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EntitiesByDid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEntitiesByDidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EntitiesByDid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_EntitiesByDid_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EntitiesByDid(ctx, req.(*QueryEntitiesByDidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EntitiesByPid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEntitiesByPidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EntitiesByPid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_EntitiesByPid_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EntitiesByPid(ctx, req.(*QueryEntitiesByPidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DidByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDidByAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DidByAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DidByAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DidByAddress(ctx, req.(*QueryDidByAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PidByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPidByAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PidByAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PidByAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PidByAddress(ctx, req.(*QueryPidByAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Relationship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRelationshipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Relationship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Relationship_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Relationship(ctx, req.(*QueryRelationshipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RelationshipsByObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRelationshipsByObjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RelationshipsByObject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RelationshipsByObject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RelationshipsByObject(ctx, req.(*QueryRelationshipsByObjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RelationshipsByActor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRelationshipsByActorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RelationshipsByActor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RelationshipsByActor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RelationshipsByActor(ctx, req.(*QueryRelationshipsByActorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ShinzoPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryShinzoPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ShinzoPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ShinzoPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ShinzoPolicy(ctx, req.(*QueryShinzoPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ShinzoPolicyVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryShinzoPolicyVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ShinzoPolicyVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ShinzoPolicyVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ShinzoPolicyVersion(ctx, req.(*QueryShinzoPolicyVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ShinzoPolicyHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryShinzoPolicyHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ShinzoPolicyHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ShinzoPolicyHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ShinzoPolicyHistory(ctx, req.(*QueryShinzoPolicyHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StreamPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStreamPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StreamPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_StreamPrice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StreamPrice(ctx, req.(*QueryStreamPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StreamPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStreamPricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StreamPrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_StreamPrices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StreamPrices(ctx, req.(*QueryStreamPricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StreamPayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStreamPaymentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StreamPayments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_StreamPayments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StreamPayments(ctx, req.(*QueryStreamPaymentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PendingRewards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingRewards(ctx, req.(*QueryPendingRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StreamRevenues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStreamRevenuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StreamRevenues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_StreamRevenues_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StreamRevenues(ctx, req.(*QueryStreamRevenuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EntityUnbondings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEntityUnbondingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EntityUnbondings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_EntityUnbondings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EntityUnbondings(ctx, req.(*QueryEntityUnbondingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Query_ServiceDesc is the grpc.ServiceDesc for Query service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Query_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "shinzonetwork.sourcehub.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "IcaAddress",
+			Handler:    _Query_IcaAddress_Handler,
+		},
+		{
+			MethodName: "IcaMetadata",
+			Handler:    _Query_IcaMetadata_Handler,
+		},
+		{
+			MethodName: "PolicyId",
+			Handler:    _Query_PolicyId_Handler,
+		},
+		{
+			MethodName: "IcaPacket",
+			Handler:    _Query_IcaPacket_Handler,
+		},
+		{
+			MethodName: "IcaPackets",
+			Handler:    _Query_IcaPackets_Handler,
+		},
+		{
+			MethodName: "IcaCommand",
+			Handler:    _Query_IcaCommand_Handler,
+		},
+		{
+			MethodName: "IcaCommands",
+			Handler:    _Query_IcaCommands_Handler,
+		},
+		{
+			MethodName: "Targets",
+			Handler:    _Query_Targets_Handler,
+		},
+		{
+			MethodName: "Target",
+			Handler:    _Query_Target_Handler,
+		},
+		{
+			MethodName: "IcaChannel",
+			Handler:    _Query_IcaChannel_Handler,
+		},
+		{
+			MethodName: "DeadLetters",
+			Handler:    _Query_DeadLetters_Handler,
+		},
+		{
+			MethodName: "StreamGrants",
+			Handler:    _Query_StreamGrants_Handler,
+		},
+		{
+			MethodName: "RoleHolders",
+			Handler:    _Query_RoleHolders_Handler,
+		},
+		{
+			MethodName: "AccountRoles",
+			Handler:    _Query_AccountRoles_Handler,
+		},
+		{
+			MethodName: "Entities",
+			Handler:    _Query_Entities_Handler,
+		},
+		{
+			MethodName: "EntitiesByAddress",
+			Handler:    _Query_EntitiesByAddress_Handler,
+		},
+		{
+			MethodName: "EntitiesByDid",
+			Handler:    _Query_EntitiesByDid_Handler,
+		},
+		{
+			MethodName: "EntitiesByPid",
+			Handler:    _Query_EntitiesByPid_Handler,
+		},
+		{
+			MethodName: "DidByAddress",
+			Handler:    _Query_DidByAddress_Handler,
+		},
+		{
+			MethodName: "PidByAddress",
+			Handler:    _Query_PidByAddress_Handler,
+		},
+		{
+			MethodName: "Relationship",
+			Handler:    _Query_Relationship_Handler,
+		},
+		{
+			MethodName: "RelationshipsByObject",
+			Handler:    _Query_RelationshipsByObject_Handler,
+		},
+		{
+			MethodName: "RelationshipsByActor",
+			Handler:    _Query_RelationshipsByActor_Handler,
+		},
+		{
+			MethodName: "ShinzoPolicy",
+			Handler:    _Query_ShinzoPolicy_Handler,
+		},
+		{
+			MethodName: "ShinzoPolicyVersion",
+			Handler:    _Query_ShinzoPolicyVersion_Handler,
+		},
+		{
+			MethodName: "ShinzoPolicyHistory",
+			Handler:    _Query_ShinzoPolicyHistory_Handler,
+		},
+		{
+			MethodName: "StreamPrice",
+			Handler:    _Query_StreamPrice_Handler,
+		},
+		{
+			MethodName: "StreamPrices",
+			Handler:    _Query_StreamPrices_Handler,
+		},
+		{
+			MethodName: "StreamPayments",
+			Handler:    _Query_StreamPayments_Handler,
+		},
+		{
+			MethodName: "PendingRewards",
+			Handler:    _Query_PendingRewards_Handler,
+		},
+		{
+			MethodName: "StreamRevenues",
+			Handler:    _Query_StreamRevenues_Handler,
+		},
+		{
+			MethodName: "EntityUnbondings",
+			Handler:    _Query_EntityUnbondings_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "shinzonetwork/sourcehub/v1/query.proto",
+}
@@ -23,6 +23,18 @@ const (
 	Msg_RegisterShinzoPolicy_FullMethodName  = "/shinzonetwork.sourcehub.v1.Msg/RegisterShinzoPolicy"
 	Msg_RegisterShinzoObjects_FullMethodName = "/shinzonetwork.sourcehub.v1.Msg/RegisterShinzoObjects"
 	Msg_RequestStreamAccess_FullMethodName   = "/shinzonetwork.sourcehub.v1.Msg/RequestStreamAccess"
+	Msg_UpdateParams_FullMethodName          = "/shinzonetwork.sourcehub.v1.Msg/UpdateParams"
+	Msg_GrantRole_FullMethodName             = "/shinzonetwork.sourcehub.v1.Msg/GrantRole"
+	Msg_RevokeRole_FullMethodName            = "/shinzonetwork.sourcehub.v1.Msg/RevokeRole"
+	Msg_PurgeDeadLetters_FullMethodName      = "/shinzonetwork.sourcehub.v1.Msg/PurgeDeadLetters"
+	Msg_ReopenICAChannel_FullMethodName      = "/shinzonetwork.sourcehub.v1.Msg/ReopenICAChannel"
+	Msg_SetDefaultTarget_FullMethodName      = "/shinzonetwork.sourcehub.v1.Msg/SetDefaultTarget"
+	Msg_UpdateShinzoPolicy_FullMethodName    = "/shinzonetwork.sourcehub.v1.Msg/UpdateShinzoPolicy"
+	Msg_SetStreamPrice_FullMethodName        = "/shinzonetwork.sourcehub.v1.Msg/SetStreamPrice"
+	Msg_BuyStreamAccess_FullMethodName       = "/shinzonetwork.sourcehub.v1.Msg/BuyStreamAccess"
+	Msg_WithdrawRewards_FullMethodName       = "/shinzonetwork.sourcehub.v1.Msg/WithdrawRewards"
+	Msg_BlockEntity_FullMethodName           = "/shinzonetwork.sourcehub.v1.Msg/BlockEntity"
+	Msg_BackfillObjects_FullMethodName       = "/shinzonetwork.sourcehub.v1.Msg/BackfillObjects"
 )
 
 // MsgClient is the client API for Msg service.
@@ -35,6 +47,41 @@ type MsgClient interface {
 	RegisterShinzoPolicy(ctx context.Context, in *MsgRegisterShinzoPolicy, opts ...grpc.CallOption) (*MsgRegisterShinzoPolicyResponse, error)
 	RegisterShinzoObjects(ctx context.Context, in *MsgRegisterShinzoObjects, opts ...grpc.CallOption) (*MsgRegisterShinzoObjectsResponse, error)
 	RequestStreamAccess(ctx context.Context, in *MsgRequestStreamAccess, opts ...grpc.CallOption) (*MsgRequestStreamAccessResponse, error)
+	// UpdateParams updates the module parameters, it can only be executed
+	// through governance.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// GrantRole assigns a module role to an account, admin only.
+	GrantRole(ctx context.Context, in *MsgGrantRole, opts ...grpc.CallOption) (*MsgGrantRoleResponse, error)
+	// RevokeRole removes a module role from an account, admin only.
+	RevokeRole(ctx context.Context, in *MsgRevokeRole, opts ...grpc.CallOption) (*MsgRevokeRoleResponse, error)
+	// PurgeDeadLetters deletes commands from the dead-letter list, admin only.
+	PurgeDeadLetters(ctx context.Context, in *MsgPurgeDeadLetters, opts ...grpc.CallOption) (*MsgPurgeDeadLettersResponse, error)
+	// ReopenICAChannel opens a new ICA channel to SourceHub once the previous
+	// one was closed, policy admins only.
+	ReopenICAChannel(ctx context.Context, in *MsgReopenICAChannel, opts ...grpc.CallOption) (*MsgReopenICAChannelResponse, error)
+	// SetDefaultTarget selects the SourceHub target used when a message or
+	// precompile call does not name one, policy admins only.
+	SetDefaultTarget(ctx context.Context, in *MsgSetDefaultTarget, opts ...grpc.CallOption) (*MsgSetDefaultTargetResponse, error)
+	// UpdateShinzoPolicy adopts a new version of the shinzohub policy and edits
+	// the policy of every target it is registered on. It can only be executed
+	// through governance.
+	UpdateShinzoPolicy(ctx context.Context, in *MsgUpdateShinzoPolicy, opts ...grpc.CallOption) (*MsgUpdateShinzoPolicyResponse, error)
+	// SetStreamPrice sets or removes the price of access to a stream, access
+	// granters and the creator of a view only.
+	SetStreamPrice(ctx context.Context, in *MsgSetStreamPrice, opts ...grpc.CallOption) (*MsgSetStreamPriceResponse, error)
+	// BuyStreamAccess grants a DID access to a priced stream against payment of
+	// its price, open to any account.
+	BuyStreamAccess(ctx context.Context, in *MsgBuyStreamAccess, opts ...grpc.CallOption) (*MsgBuyStreamAccessResponse, error)
+	// WithdrawRewards sends the rewards credited to the owner at the end of
+	// each epoch to the owner.
+	WithdrawRewards(ctx context.Context, in *MsgWithdrawRewards, opts ...grpc.CallOption) (*MsgWithdrawRewardsResponse, error)
+	// BlockEntity removes the group membership of an entity, slashes its bond
+	// and unbonds the rest, admin only.
+	BlockEntity(ctx context.Context, in *MsgBlockEntity, opts ...grpc.CallOption) (*MsgBlockEntityResponse, error)
+	// BackfillObjects records objects registered on SourceHub before shinzohub
+	// mirrored relationships as owned by the interchain account of a target,
+	// admin only.
+	BackfillObjects(ctx context.Context, in *MsgBackfillObjects, opts ...grpc.CallOption) (*MsgBackfillObjectsResponse, error)
 }
 
 type msgClient struct {
@@ -85,6 +132,126 @@ func (c *msgClient) RequestStreamAccess(ctx context.Context, in *MsgRequestStrea
 	return out, nil
 }
 
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateParams_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) GrantRole(ctx context.Context, in *MsgGrantRole, opts ...grpc.CallOption) (*MsgGrantRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgGrantRoleResponse)
+	err := c.cc.Invoke(ctx, Msg_GrantRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeRole(ctx context.Context, in *MsgRevokeRole, opts ...grpc.CallOption) (*MsgRevokeRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRevokeRoleResponse)
+	err := c.cc.Invoke(ctx, Msg_RevokeRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PurgeDeadLetters(ctx context.Context, in *MsgPurgeDeadLetters, opts ...grpc.CallOption) (*MsgPurgeDeadLettersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgPurgeDeadLettersResponse)
+	err := c.cc.Invoke(ctx, Msg_PurgeDeadLetters_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ReopenICAChannel(ctx context.Context, in *MsgReopenICAChannel, opts ...grpc.CallOption) (*MsgReopenICAChannelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgReopenICAChannelResponse)
+	err := c.cc.Invoke(ctx, Msg_ReopenICAChannel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetDefaultTarget(ctx context.Context, in *MsgSetDefaultTarget, opts ...grpc.CallOption) (*MsgSetDefaultTargetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetDefaultTargetResponse)
+	err := c.cc.Invoke(ctx, Msg_SetDefaultTarget_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateShinzoPolicy(ctx context.Context, in *MsgUpdateShinzoPolicy, opts ...grpc.CallOption) (*MsgUpdateShinzoPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgUpdateShinzoPolicyResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateShinzoPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetStreamPrice(ctx context.Context, in *MsgSetStreamPrice, opts ...grpc.CallOption) (*MsgSetStreamPriceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetStreamPriceResponse)
+	err := c.cc.Invoke(ctx, Msg_SetStreamPrice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BuyStreamAccess(ctx context.Context, in *MsgBuyStreamAccess, opts ...grpc.CallOption) (*MsgBuyStreamAccessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgBuyStreamAccessResponse)
+	err := c.cc.Invoke(ctx, Msg_BuyStreamAccess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WithdrawRewards(ctx context.Context, in *MsgWithdrawRewards, opts ...grpc.CallOption) (*MsgWithdrawRewardsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgWithdrawRewardsResponse)
+	err := c.cc.Invoke(ctx, Msg_WithdrawRewards_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BlockEntity(ctx context.Context, in *MsgBlockEntity, opts ...grpc.CallOption) (*MsgBlockEntityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgBlockEntityResponse)
+	err := c.cc.Invoke(ctx, Msg_BlockEntity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BackfillObjects(ctx context.Context, in *MsgBackfillObjects, opts ...grpc.CallOption) (*MsgBackfillObjectsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgBackfillObjectsResponse)
+	err := c.cc.Invoke(ctx, Msg_BackfillObjects_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility.
@@ -95,6 +262,41 @@ type MsgServer interface {
 	RegisterShinzoPolicy(context.Context, *MsgRegisterShinzoPolicy) (*MsgRegisterShinzoPolicyResponse, error)
 	RegisterShinzoObjects(context.Context, *MsgRegisterShinzoObjects) (*MsgRegisterShinzoObjectsResponse, error)
 	RequestStreamAccess(context.Context, *MsgRequestStreamAccess) (*MsgRequestStreamAccessResponse, error)
+	// UpdateParams updates the module parameters, it can only be executed
+	// through governance.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// GrantRole assigns a module role to an account, admin only.
+	GrantRole(context.Context, *MsgGrantRole) (*MsgGrantRoleResponse, error)
+	// RevokeRole removes a module role from an account, admin only.
+	RevokeRole(context.Context, *MsgRevokeRole) (*MsgRevokeRoleResponse, error)
+	// PurgeDeadLetters deletes commands from the dead-letter list, admin only.
+	PurgeDeadLetters(context.Context, *MsgPurgeDeadLetters) (*MsgPurgeDeadLettersResponse, error)
+	// ReopenICAChannel opens a new ICA channel to SourceHub once the previous
+	// one was closed, policy admins only.
+	ReopenICAChannel(context.Context, *MsgReopenICAChannel) (*MsgReopenICAChannelResponse, error)
+	// SetDefaultTarget selects the SourceHub target used when a message or
+	// precompile call does not name one, policy admins only.
+	SetDefaultTarget(context.Context, *MsgSetDefaultTarget) (*MsgSetDefaultTargetResponse, error)
+	// UpdateShinzoPolicy adopts a new version of the shinzohub policy and edits
+	// the policy of every target it is registered on. It can only be executed
+	// through governance.
+	UpdateShinzoPolicy(context.Context, *MsgUpdateShinzoPolicy) (*MsgUpdateShinzoPolicyResponse, error)
+	// SetStreamPrice sets or removes the price of access to a stream, access
+	// granters and the creator of a view only.
+	SetStreamPrice(context.Context, *MsgSetStreamPrice) (*MsgSetStreamPriceResponse, error)
+	// BuyStreamAccess grants a DID access to a priced stream against payment of
+	// its price, open to any account.
+	BuyStreamAccess(context.Context, *MsgBuyStreamAccess) (*MsgBuyStreamAccessResponse, error)
+	// WithdrawRewards sends the rewards credited to the owner at the end of
+	// each epoch to the owner.
+	WithdrawRewards(context.Context, *MsgWithdrawRewards) (*MsgWithdrawRewardsResponse, error)
+	// BlockEntity removes the group membership of an entity, slashes its bond
+	// and unbonds the rest, admin only.
+	BlockEntity(context.Context, *MsgBlockEntity) (*MsgBlockEntityResponse, error)
+	// BackfillObjects records objects registered on SourceHub before shinzohub
+	// mirrored relationships as owned by the interchain account of a target,
+	// admin only.
+	BackfillObjects(context.Context, *MsgBackfillObjects) (*MsgBackfillObjectsResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -117,6 +319,42 @@ func (UnimplementedMsgServer) RegisterShinzoObjects(context.Context, *MsgRegiste
 func (UnimplementedMsgServer) RequestStreamAccess(context.Context, *MsgRequestStreamAccess) (*MsgRequestStreamAccessResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RequestStreamAccess not implemented")
 }
+func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (UnimplementedMsgServer) GrantRole(context.Context, *MsgGrantRole) (*MsgGrantRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantRole not implemented")
+}
+func (UnimplementedMsgServer) RevokeRole(context.Context, *MsgRevokeRole) (*MsgRevokeRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeRole not implemented")
+}
+func (UnimplementedMsgServer) PurgeDeadLetters(context.Context, *MsgPurgeDeadLetters) (*MsgPurgeDeadLettersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeDeadLetters not implemented")
+}
+func (UnimplementedMsgServer) ReopenICAChannel(context.Context, *MsgReopenICAChannel) (*MsgReopenICAChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReopenICAChannel not implemented")
+}
+func (UnimplementedMsgServer) SetDefaultTarget(context.Context, *MsgSetDefaultTarget) (*MsgSetDefaultTargetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDefaultTarget not implemented")
+}
+func (UnimplementedMsgServer) UpdateShinzoPolicy(context.Context, *MsgUpdateShinzoPolicy) (*MsgUpdateShinzoPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateShinzoPolicy not implemented")
+}
+func (UnimplementedMsgServer) SetStreamPrice(context.Context, *MsgSetStreamPrice) (*MsgSetStreamPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetStreamPrice not implemented")
+}
+func (UnimplementedMsgServer) BuyStreamAccess(context.Context, *MsgBuyStreamAccess) (*MsgBuyStreamAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BuyStreamAccess not implemented")
+}
+func (UnimplementedMsgServer) WithdrawRewards(context.Context, *MsgWithdrawRewards) (*MsgWithdrawRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawRewards not implemented")
+}
+func (UnimplementedMsgServer) BlockEntity(context.Context, *MsgBlockEntity) (*MsgBlockEntityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockEntity not implemented")
+}
+func (UnimplementedMsgServer) BackfillObjects(context.Context, *MsgBackfillObjects) (*MsgBackfillObjectsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BackfillObjects not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 func (UnimplementedMsgServer) testEmbeddedByValue()             {}
 
@@ -210,6 +448,222 @@ func _Msg_RequestStreamAccess_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_GrantRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGrantRole)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GrantRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_GrantRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GrantRole(ctx, req.(*MsgGrantRole))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeRole)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RevokeRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeRole(ctx, req.(*MsgRevokeRole))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PurgeDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPurgeDeadLetters)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PurgeDeadLetters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_PurgeDeadLetters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PurgeDeadLetters(ctx, req.(*MsgPurgeDeadLetters))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReopenICAChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReopenICAChannel)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReopenICAChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ReopenICAChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReopenICAChannel(ctx, req.(*MsgReopenICAChannel))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetDefaultTarget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetDefaultTarget)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetDefaultTarget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetDefaultTarget_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetDefaultTarget(ctx, req.(*MsgSetDefaultTarget))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateShinzoPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateShinzoPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateShinzoPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateShinzoPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateShinzoPolicy(ctx, req.(*MsgUpdateShinzoPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetStreamPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetStreamPrice)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetStreamPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetStreamPrice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetStreamPrice(ctx, req.(*MsgSetStreamPrice))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BuyStreamAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBuyStreamAccess)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BuyStreamAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_BuyStreamAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BuyStreamAccess(ctx, req.(*MsgBuyStreamAccess))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawRewards)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_WithdrawRewards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawRewards(ctx, req.(*MsgWithdrawRewards))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BlockEntity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBlockEntity)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BlockEntity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_BlockEntity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BlockEntity(ctx, req.(*MsgBlockEntity))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BackfillObjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBackfillObjects)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BackfillObjects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_BackfillObjects_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BackfillObjects(ctx, req.(*MsgBackfillObjects))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -233,6 +687,54 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RequestStreamAccess",
 			Handler:    _Msg_RequestStreamAccess_Handler,
 		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "GrantRole",
+			Handler:    _Msg_GrantRole_Handler,
+		},
+		{
+			MethodName: "RevokeRole",
+			Handler:    _Msg_RevokeRole_Handler,
+		},
+		{
+			MethodName: "PurgeDeadLetters",
+			Handler:    _Msg_PurgeDeadLetters_Handler,
+		},
+		{
+			MethodName: "ReopenICAChannel",
+			Handler:    _Msg_ReopenICAChannel_Handler,
+		},
+		{
+			MethodName: "SetDefaultTarget",
+			Handler:    _Msg_SetDefaultTarget_Handler,
+		},
+		{
+			MethodName: "UpdateShinzoPolicy",
+			Handler:    _Msg_UpdateShinzoPolicy_Handler,
+		},
+		{
+			MethodName: "SetStreamPrice",
+			Handler:    _Msg_SetStreamPrice_Handler,
+		},
+		{
+			MethodName: "BuyStreamAccess",
+			Handler:    _Msg_BuyStreamAccess_Handler,
+		},
+		{
+			MethodName: "WithdrawRewards",
+			Handler:    _Msg_WithdrawRewards_Handler,
+		},
+		{
+			MethodName: "BlockEntity",
+			Handler:    _Msg_BlockEntity_Handler,
+		},
+		{
+			MethodName: "BackfillObjects",
+			Handler:    _Msg_BackfillObjects_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "shinzonetwork/sourcehub/v1/tx.proto",
@@ -0,0 +1,35 @@
+package cli
+
+// targetFlagUsage describes the --target flag of commands acting on a
+// SourceHub target.
+const targetFlagUsage = "Chain ID of the SourceHub target, the default target if empty"
+
+const (
+	FlagStatus      = "status"
+	FlagExpiration  = "expiration"
+	FlagStreamId    = "stream-id"
+	FlagDid         = "did"
+	FlagRole        = "role"
+	FlagTxHash      = "tx-hash"
+	FlagBatchSize   = "max-batch-size"
+	FlagMaxAttempts = "max-attempts"
+	FlagAutoReopen  = "auto-reopen-channel"
+	FlagTarget      = "target"
+	FlagRelation    = "relation"
+	FlagDuration    = "duration"
+	FlagPayer       = "payer"
+	FlagOwner       = "owner"
+
+	FlagOutpostConnections = "outpost-connections"
+	FlagCreatorShare       = "creator-share"
+	FlagHostsShare         = "hosts-share"
+	FlagIndexersShare      = "indexers-share"
+	FlagCommunityPoolShare = "community-pool-share"
+	FlagEpochLength        = "epoch-length"
+	FlagIndexerBond        = "indexer-bond"
+	FlagIndexerSlash       = "indexer-slash-fraction"
+	FlagHostBond           = "host-bond"
+	FlagHostSlash          = "host-slash-fraction"
+	FlagUnbondingPeriod    = "unbonding-period"
+	FlagRegistrationWindow = "max-registration-window"
+)
@@ -0,0 +1,1257 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/spf13/cobra"
+
+	"github.com/shinzonetwork/shinzohub/x/sourcehub/types"
+)
+
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("Querying commands for the %s module", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(CmdQueryParams())
+	cmd.AddCommand(CmdQueryIcaAddress())
+	cmd.AddCommand(CmdQueryIcaMetadata())
+	cmd.AddCommand(CmdQueryPolicyId())
+	cmd.AddCommand(CmdQueryShinzoPolicy())
+	cmd.AddCommand(CmdQueryShinzoPolicyHistory())
+	cmd.AddCommand(CmdQueryIcaPacket())
+	cmd.AddCommand(CmdQueryIcaPackets())
+	cmd.AddCommand(CmdQueryIcaCommand())
+	cmd.AddCommand(CmdQueryIcaCommands())
+	cmd.AddCommand(CmdQueryDeadLetters())
+	cmd.AddCommand(CmdQueryIcaChannel())
+	cmd.AddCommand(CmdQueryTargets())
+	cmd.AddCommand(CmdQueryTarget())
+	cmd.AddCommand(CmdQueryStreamGrants())
+	cmd.AddCommand(CmdQueryStreamPrice())
+	cmd.AddCommand(CmdQueryStreamPrices())
+	cmd.AddCommand(CmdQueryStreamPayments())
+	cmd.AddCommand(CmdQueryStreamRevenues())
+	cmd.AddCommand(CmdQueryPendingRewards())
+	cmd.AddCommand(CmdQueryRoleHolders())
+	cmd.AddCommand(CmdQueryAccountRoles())
+	cmd.AddCommand(CmdQueryEntities())
+	cmd.AddCommand(CmdQueryEntityUnbondings())
+	cmd.AddCommand(CmdQueryEntitiesByAddress())
+	cmd.AddCommand(CmdQueryEntitiesByDid())
+	cmd.AddCommand(CmdQueryEntitiesByPid())
+	cmd.AddCommand(CmdQueryDidByAddress())
+	cmd.AddCommand(CmdQueryPidByAddress())
+	cmd.AddCommand(CmdQueryRelationship())
+	cmd.AddCommand(CmdQueryRelationshipsByObject())
+	cmd.AddCommand(CmdQueryRelationshipsByActor())
+	cmd.AddCommand(CmdRegistrationMessage())
+
+	return cmd
+}
+
+func CmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Show the sourcehub module parameters",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdQueryIcaAddress() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ica-address",
+		Short: "Show the interchain account address of the module on SourceHub",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			target, err := cmd.Flags().GetString(FlagTarget)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.IcaAddress(cmd.Context(), &types.QueryIcaAddressRequest{Target: target})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagTarget, "", targetFlagUsage)
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdQueryIcaMetadata() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ica-metadata",
+		Short: "Show the controller/host connection IDs and ICS-27 metadata",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			target, err := cmd.Flags().GetString(FlagTarget)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.IcaMetadata(cmd.Context(), &types.QueryIcaMetadataRequest{Target: target})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagTarget, "", targetFlagUsage)
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdQueryPolicyId() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "policy-id",
+		Short: "Show the shinzohub policy ID on SourceHub",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			target, err := cmd.Flags().GetString(FlagTarget)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PolicyId(cmd.Context(), &types.QueryPolicyIdRequest{Target: target})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagTarget, "", targetFlagUsage)
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdQueryIcaPacket() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ica-packet [channel-id] [sequence]",
+		Short: "Show a tracked ICA packet and its acknowledgement result",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			sequence, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid sequence: %w", err)
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.IcaPacket(cmd.Context(), &types.QueryIcaPacketRequest{
+				ChannelId: args[0],
+				Sequence:  sequence,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdQueryIcaPackets() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ica-packets",
+		Short: "List tracked ICA packets",
+		Long:  "Examples:\n  shinzohubd q sourcehub ica-packets --status failed",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			statusFlag, err := cmd.Flags().GetString(FlagStatus)
+			if err != nil {
+				return err
+			}
+
+			status := types.PacketStatus_PACKET_STATUS_UNSPECIFIED
+			if statusFlag != "" {
+				v, ok := types.PacketStatus_value["PACKET_STATUS_"+strings.ToUpper(statusFlag)]
+				if !ok {
+					return fmt.Errorf("invalid status %q, expected pending, acknowledged, failed or timed_out", statusFlag)
+				}
+				status = types.PacketStatus(v)
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.IcaPackets(cmd.Context(), &types.QueryIcaPacketsRequest{
+				Status:     status,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagStatus, "", "Only list packets in this status (pending, acknowledged, failed, timed_out)")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "ica-packets")
+	return cmd
+}
+
+func CmdQueryIcaCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ica-command [id]",
+		Short: "Show an outbox command and its result on SourceHub",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid id: %w", err)
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.IcaCommand(cmd.Context(), &types.QueryIcaCommandRequest{Id: id})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdQueryIcaCommands() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ica-commands",
+		Short: "List outbox commands",
+		Long: "Examples:\n  shinzohubd q sourcehub ica-commands --status queued\n" +
+			"  shinzohubd q sourcehub ica-commands --tx-hash 5F3A...",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			statusFlag, err := cmd.Flags().GetString(FlagStatus)
+			if err != nil {
+				return err
+			}
+
+			status := types.PacketStatus_PACKET_STATUS_UNSPECIFIED
+			if statusFlag != "" {
+				v, ok := types.PacketStatus_value["PACKET_STATUS_"+strings.ToUpper(statusFlag)]
+				if !ok {
+					return fmt.Errorf("invalid status %q, expected queued, pending, acknowledged, failed or timed_out", statusFlag)
+				}
+				status = types.PacketStatus(v)
+			}
+
+			txHash, err := cmd.Flags().GetString(FlagTxHash)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.IcaCommands(cmd.Context(), &types.QueryIcaCommandsRequest{
+				TxHash:     txHash,
+				Status:     status,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagStatus, "", "Only list commands in this status (queued, pending, acknowledged, failed, timed_out)")
+	cmd.Flags().String(FlagTxHash, "", "Only list commands queued by this transaction")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "ica-commands")
+	return cmd
+}
+
+func CmdQueryIcaChannel() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ica-channel",
+		Short: "Show the state of the ICA channel to SourceHub",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			target, err := cmd.Flags().GetString(FlagTarget)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.IcaChannel(cmd.Context(), &types.QueryIcaChannelRequest{Target: target})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagTarget, "", targetFlagUsage)
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdQueryTargets() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "targets",
+		Short: "List the SourceHub chains the module is connected to and the default target",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Targets(cmd.Context(), &types.QueryTargetsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "targets")
+	return cmd
+}
+
+func CmdQueryTarget() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "target [chain-id]",
+		Short: "Show a SourceHub target by chain ID",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Target(cmd.Context(), &types.QueryTargetRequest{ChainId: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdQueryDeadLetters() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dead-letters",
+		Short: "List the outbox commands that exhausted their attempts",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DeadLetters(cmd.Context(), &types.QueryDeadLettersRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "dead-letters")
+	return cmd
+}
+
+func CmdQueryStreamGrants() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stream-grants",
+		Short: "List stream access grants and their expirations",
+		Long:  "Examples:\n  shinzohubd q sourcehub stream-grants --did did:key:alice",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			streamID, err := cmd.Flags().GetString(FlagStreamId)
+			if err != nil {
+				return err
+			}
+
+			did, err := cmd.Flags().GetString(FlagDid)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.StreamGrants(cmd.Context(), &types.QueryStreamGrantsRequest{
+				StreamId:   streamID,
+				Did:        did,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagStreamId, "", "Only list grants on this stream")
+	cmd.Flags().String(FlagDid, "", "Only list grants held by this DID")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "stream-grants")
+	return cmd
+}
+
+func CmdQueryStreamPrice() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stream-price [resource] [stream-id]",
+		Short: "Show the price of access to a stream",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			resourceInt, err := strconv.Atoi(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid resource: %w", err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.StreamPrice(cmd.Context(), &types.QueryStreamPriceRequest{
+				Resource: types.Resource(resourceInt),
+				StreamId: args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdQueryStreamPrices() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stream-prices",
+		Short: "List the priced streams",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.StreamPrices(cmd.Context(), &types.QueryStreamPricesRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "stream-prices")
+	return cmd
+}
+
+func CmdQueryStreamPayments() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stream-payments",
+		Short: "List the stream access payments held until SourceHub acknowledges them",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			payer, err := cmd.Flags().GetString(FlagPayer)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.StreamPayments(cmd.Context(), &types.QueryStreamPaymentsRequest{
+				Payer:      payer,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagPayer, "", "Only list payments made by this account")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "stream-payments")
+	return cmd
+}
+
+func CmdQueryStreamRevenues() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stream-revenues",
+		Short: "List the subscription fees collected per stream in the current epoch",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.StreamRevenues(cmd.Context(), &types.QueryStreamRevenuesRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "stream-revenues")
+	return cmd
+}
+
+func CmdQueryPendingRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-rewards [address]",
+		Short: "Show the rewards credited to an account and not withdrawn yet",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PendingRewards(cmd.Context(), &types.QueryPendingRewardsRequest{Address: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdQueryRoleHolders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "role-holders",
+		Short: "List accounts holding module roles",
+		Long:  "Examples:\n  shinzohubd q sourcehub role-holders --role access-granter",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			roleFlag, err := cmd.Flags().GetString(FlagRole)
+			if err != nil {
+				return err
+			}
+
+			role := types.ModuleRole_MODULE_ROLE_UNSPECIFIED
+			if roleFlag != "" {
+				role, err = types.ParseModuleRole(roleFlag)
+				if err != nil {
+					return err
+				}
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.RoleHolders(cmd.Context(), &types.QueryRoleHoldersRequest{
+				Role:       role,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagRole, "", "Only list holders of this role (policy-admin, object-registrar, access-granter)")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "role-holders")
+	return cmd
+}
+
+func CmdQueryAccountRoles() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "account-roles [address]",
+		Short: "Show the module roles held by an account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AccountRoles(cmd.Context(), &types.QueryAccountRolesRequest{Address: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdQueryEntities() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "entities",
+		Short: "List registered indexers and hosts",
+		Long:  "Examples:\n  shinzohubd q sourcehub entities --role host",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			roleFlag, err := cmd.Flags().GetString(FlagRole)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryEntitiesRequest{}
+			if roleFlag != "" {
+				v, ok := types.EntityRole_value["ENTITY_ROLE_"+strings.ToUpper(roleFlag)]
+				if !ok {
+					return fmt.Errorf("invalid role %q, expected indexer or host", roleFlag)
+				}
+				req.FilterRole = true
+				req.Role = types.EntityRole(v)
+			}
+
+			req.Pagination, err = client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Entities(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagRole, "", "Only list entities with this role (indexer, host)")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "entities")
+	return cmd
+}
+
+func CmdQueryEntityUnbondings() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "entity-unbondings",
+		Short: "List the entity bonds waiting for the end of their unbonding period",
+		Long:  "Examples:\n  shinzohubd q sourcehub entity-unbondings --owner shinzo1...",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			owner, err := cmd.Flags().GetString(FlagOwner)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.EntityUnbondings(cmd.Context(), &types.QueryEntityUnbondingsRequest{
+				Owner:      owner,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagOwner, "", "Only list the bonds of this address")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "entity-unbondings")
+	return cmd
+}
+
+func CmdQueryEntitiesByAddress() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "entity [address]",
+		Short: "Show the entities registered by an account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.EntitiesByAddress(cmd.Context(), &types.QueryEntitiesByAddressRequest{Address: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdQueryEntitiesByDid() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "entity-by-did [did]",
+		Short: "Show the entities registered with a DID",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.EntitiesByDid(cmd.Context(), &types.QueryEntitiesByDidRequest{Did: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdQueryEntitiesByPid() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "entity-by-pid [pid]",
+		Short: "Show the entities registered with a libp2p peer ID",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.EntitiesByPid(cmd.Context(), &types.QueryEntitiesByPidRequest{Pid: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdQueryDidByAddress() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "did [address]",
+		Short: "Show the DID registered by an account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DidByAddress(cmd.Context(), &types.QueryDidByAddressRequest{Address: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdQueryPidByAddress() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pid [address]",
+		Short: "Show the libp2p peer ID registered by an account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PidByAddress(cmd.Context(), &types.QueryPidByAddressRequest{Address: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdRegistrationMessage() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "registration-message [address] [role] [nonce] [expiry-height]",
+		Short: "Print the hex encoded message both entity keys sign to register or rotate keys",
+		Long: "The address is the EVM (0x...) or bech32 address that calls the EntityRegistry precompile.\n" +
+			"The message is only accepted once, up to and including the expiry height, which must not be more than\n" +
+			"params.max_registration_window blocks (14400 by default) past the height it is used at.\n\n" +
+			"Examples:\n  shinzohubd q sourcehub registration-message 0xabd3... indexer 1 5000 --chain-id shinzo_9000-1",
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			if clientCtx.ChainID == "" {
+				return fmt.Errorf("--%s is required", flags.FlagChainID)
+			}
+
+			var address sdk.AccAddress
+			if common.IsHexAddress(args[0]) {
+				address = common.HexToAddress(args[0]).Bytes()
+			} else if address, err = sdk.AccAddressFromBech32(args[0]); err != nil {
+				return err
+			}
+
+			v, ok := types.EntityRole_value["ENTITY_ROLE_"+strings.ToUpper(args[1])]
+			if !ok {
+				return fmt.Errorf("invalid role %q, expected indexer or host", args[1])
+			}
+
+			nonce, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid nonce: %w", err)
+			}
+
+			expiryHeight, err := strconv.ParseInt(args[3], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid expiry height: %w", err)
+			}
+
+			m := types.NewRegistrationMessage(clientCtx.ChainID, address, types.EntityRole(v), nonce, expiryHeight)
+			if m.Target, err = cmd.Flags().GetString(FlagTarget); err != nil {
+				return err
+			}
+			return clientCtx.PrintString(hexutil.Encode(m.GetSignBytes()) + "\n")
+		},
+	}
+
+	cmd.Flags().String(FlagTarget, "", "Chain ID of the SourceHub target to register on, the default target if empty")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdQueryRelationship() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "relationship [resource] [object-id] [relation] [actor]",
+		Short: "Show a relationship mirrored from SourceHub and its status",
+		Long:  "Examples:\n  shinzohubd q sourcehub relationship view Logs_0x01 subscriber did:key:alice",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			target, err := cmd.Flags().GetString(FlagTarget)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Relationship(cmd.Context(), &types.QueryRelationshipRequest{
+				Resource: args[0],
+				ObjectId: args[1],
+				Relation: args[2],
+				Actor:    args[3],
+				Target:   target,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagTarget, "", targetFlagUsage)
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdQueryRelationshipsByObject() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "relationships-by-object [resource] [object-id]",
+		Short: "List the mirrored relationships of an object",
+		Long:  "Examples:\n  shinzohubd q sourcehub relationships-by-object view Logs_0x01 --relation subscriber",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			relation, err := cmd.Flags().GetString(FlagRelation)
+			if err != nil {
+				return err
+			}
+
+			target, err := cmd.Flags().GetString(FlagTarget)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.RelationshipsByObject(cmd.Context(), &types.QueryRelationshipsByObjectRequest{
+				Resource:   args[0],
+				ObjectId:   args[1],
+				Relation:   relation,
+				Pagination: pageReq,
+				Target:     target,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagRelation, "", "Only list relationships with this relation")
+	cmd.Flags().String(FlagTarget, "", targetFlagUsage)
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "relationships-by-object")
+	return cmd
+}
+
+func CmdQueryRelationshipsByActor() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "relationships-by-actor [actor]",
+		Short: "List the mirrored relationships held by an actor",
+		Long:  "Examples:\n  shinzohubd q sourcehub relationships-by-actor did:key:alice --relation subscriber",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			relation, err := cmd.Flags().GetString(FlagRelation)
+			if err != nil {
+				return err
+			}
+
+			target, err := cmd.Flags().GetString(FlagTarget)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.RelationshipsByActor(cmd.Context(), &types.QueryRelationshipsByActorRequest{
+				Actor:      args[0],
+				Relation:   relation,
+				Pagination: pageReq,
+				Target:     target,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagRelation, "", "Only list relationships with this relation")
+	cmd.Flags().String(FlagTarget, "", targetFlagUsage)
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "relationships-by-actor")
+	return cmd
+}
+
+func CmdQueryShinzoPolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "shinzo-policy [version]",
+		Short: "Show the active shinzohub policy, or one of its versions",
+		Long:  "Version 0 is the policy embedded in the binary.\n\nExamples:\n  shinzohubd q sourcehub shinzo-policy\n  shinzohubd q sourcehub shinzo-policy 2",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			if len(args) == 0 {
+				res, err := queryClient.ShinzoPolicy(cmd.Context(), &types.QueryShinzoPolicyRequest{})
+				if err != nil {
+					return err
+				}
+				return clientCtx.PrintProto(res)
+			}
+
+			version, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid version: %w", err)
+			}
+
+			res, err := queryClient.ShinzoPolicyVersion(cmd.Context(), &types.QueryShinzoPolicyVersionRequest{Version: version})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdQueryShinzoPolicyHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "shinzo-policy-history",
+		Short: "List the versions of the shinzohub policy adopted through governance",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ShinzoPolicyHistory(cmd.Context(), &types.QueryShinzoPolicyHistoryRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "shinzo-policy-history")
+	return cmd
+}
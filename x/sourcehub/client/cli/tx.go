@@ -2,12 +2,18 @@ package cli
 
 import (
 	"fmt"
+	"os"
 	"strconv"
 	"strings"
 
+	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/spf13/cobra"
 
 	"github.com/shinzonetwork/shinzohub/x/sourcehub/types"
@@ -24,17 +30,31 @@ func GetTxCmd() *cobra.Command {
 
 	cmd.AddCommand(CmdRegisterSourcehubICA())
 	cmd.AddCommand(CmdRequestStreamAccess())
+	cmd.AddCommand(CmdSetStreamPrice())
+	cmd.AddCommand(CmdBuyStreamAccess())
+	cmd.AddCommand(CmdWithdrawRewards())
 	cmd.AddCommand(CmdRegisterShinzoPolicy())
 	cmd.AddCommand(CmdRegisterObjects())
+	cmd.AddCommand(CmdUpdateParams())
+	cmd.AddCommand(CmdUpdateShinzoPolicy())
+	cmd.AddCommand(CmdGrantRole())
+	cmd.AddCommand(CmdRevokeRole())
+	cmd.AddCommand(CmdBlockEntity())
+	cmd.AddCommand(CmdBackfillObjects())
+	cmd.AddCommand(CmdPurgeDeadLetters())
+	cmd.AddCommand(CmdReopenICAChannel())
+	cmd.AddCommand(CmdSetDefaultTarget())
 
 	return cmd
 }
 
 func CmdRegisterSourcehubICA() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "register-ica [controller-connection-id] [host-connection-id]",
-		Short: "Register an interchain account for the sourcehub module",
-		Args:  cobra.ExactArgs(2),
+		Use:   "register-ica [controller-connection-id] [host-connection-id] [sourcehub-chain-id]",
+		Short: "Register an interchain account for the sourcehub module on a SourceHub chain",
+		Long: "The SourceHub chain becomes a target named by its chain ID. The first registered target is the default.\n\n" +
+			"Example:\n  shinzohubd tx sourcehub register-ica connection-0 connection-0 sourcehub-dev --from acc0",
+		Args: cobra.ExactArgs(3),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			controllerConnectionID := args[0]
 			hostConnectionID := args[1]
@@ -48,6 +68,7 @@ func CmdRegisterSourcehubICA() *cobra.Command {
 				Signer:                 clientCtx.GetFromAddress().String(),
 				ControllerConnectionId: controllerConnectionID,
 				HostConnectionId:       hostConnectionID,
+				ChainId:                args[2],
 			}
 
 			if err := msg.ValidateBasic(); err != nil {
@@ -66,6 +87,7 @@ func CmdRequestStreamAccess() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "request-stream [resource] [stream-id] [did]",
 		Short: "Request access to a stream by providing a stream ID and a DID",
+		Long:  "Requesting access again for the same stream and DID renews the grant with the new expiration.",
 		Args:  cobra.ExactArgs(3),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			streamID := args[1]
@@ -76,16 +98,151 @@ func CmdRequestStreamAccess() *cobra.Command {
 				return fmt.Errorf("invalid resource: %w", err)
 			}
 
+			expiration, err := cmd.Flags().GetUint64(FlagExpiration)
+			if err != nil {
+				return err
+			}
+
+			target, err := cmd.Flags().GetString(FlagTarget)
+			if err != nil {
+				return err
+			}
+
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
 			msg := &types.MsgRequestStreamAccess{
+				Signer:     clientCtx.GetFromAddress().String(),
+				Resource:   types.Resource(resourceInt),
+				StreamId:   streamID,
+				Did:        did,
+				Expiration: expiration,
+				Target:     target,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagExpiration, 0, "Unix time in seconds at which access is revoked, 0 never expires")
+	cmd.Flags().String(FlagTarget, "", targetFlagUsage)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdSetStreamPrice() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-stream-price [resource] [stream-id] [amount]",
+		Short: "Set the price of access to a stream",
+		Long: "Any account can then buy access to the stream with buy-stream. An empty amount takes the stream off sale. " +
+			"Access granters can price any stream, the creator of a view can price that view.\n\n" +
+			"Example:\n  shinzohubd tx sourcehub set-stream-price 1 Logs_0x01 1000ushinzo --duration 2592000 --from acc0",
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			resourceInt, err := strconv.Atoi(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid resource: %w", err)
+			}
+
+			amount, err := sdk.ParseCoinsNormalized(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid amount: %w", err)
+			}
+
+			duration, err := cmd.Flags().GetUint64(FlagDuration)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSetStreamPrice{
 				Signer:   clientCtx.GetFromAddress().String(),
 				Resource: types.Resource(resourceInt),
-				StreamId: streamID,
-				Did:      did,
+				StreamId: args[1],
+				Amount:   amount,
+				Duration: duration,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagDuration, 0, "Length in seconds of the access bought with one payment, 0 never expires")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdBuyStreamAccess() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "buy-stream [resource] [stream-id] [did]",
+		Short: "Pay the price of a stream to give a DID access to it",
+		Long: "The payment is held by the module until SourceHub sets the subscriber relationship and refunded if it cannot be set. " +
+			"Buying access for a DID that already has it extends its expiration, and fails while SourceHub has not confirmed that access yet.",
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			resourceInt, err := strconv.Atoi(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid resource: %w", err)
+			}
+
+			target, err := cmd.Flags().GetString(FlagTarget)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgBuyStreamAccess{
+				Buyer:    clientCtx.GetFromAddress().String(),
+				Resource: types.Resource(resourceInt),
+				StreamId: args[1],
+				Did:      args[2],
+				Target:   target,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagTarget, "", targetFlagUsage)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdWithdrawRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-rewards",
+		Short: "Withdraw the share of subscription fees credited to the sender",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgWithdrawRewards{
+				Owner: clientCtx.GetFromAddress().String(),
 			}
 
 			if err := msg.ValidateBasic(); err != nil {
@@ -111,8 +268,14 @@ func CmdRegisterShinzoPolicy() *cobra.Command {
 				return err
 			}
 
+			target, err := cmd.Flags().GetString(FlagTarget)
+			if err != nil {
+				return err
+			}
+
 			msg := &types.MsgRegisterShinzoPolicy{
 				Signer: clientCtx.GetFromAddress().String(),
+				Target: target,
 			}
 
 			if err := msg.ValidateBasic(); err != nil {
@@ -123,6 +286,7 @@ func CmdRegisterShinzoPolicy() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagTarget, "", targetFlagUsage)
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -131,7 +295,7 @@ func CmdRegisterObjects() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "register-objects [resources...]",
 		Short: "Register one or more shinzo objects by resource name(s)",
-		Long:  "Examples:\n  shinzohubd tx sourcehub register-objects block logs event --from acc0 --yes",
+		Long:  "Examples:\n  shinzohubd tx sourcehub register-objects block log event --from acc0 --yes",
 		Args:  cobra.MinimumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -158,9 +322,15 @@ func CmdRegisterObjects() *cobra.Command {
 				return fmt.Errorf("at least one resource is required")
 			}
 
+			target, err := cmd.Flags().GetString(FlagTarget)
+			if err != nil {
+				return err
+			}
+
 			msg := &types.MsgRegisterShinzoObjects{
 				Signer:    clientCtx.GetFromAddress().String(),
 				Resources: resources,
+				Target:    target,
 			}
 
 			if err := msg.ValidateBasic(); err != nil {
@@ -171,7 +341,453 @@ func CmdRegisterObjects() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagTarget, "", targetFlagUsage)
 	// add standard tx flags: --from, --fees, --gas, --gas-adjustment, --yes, etc.
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+func CmdUpdateParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-params [admin]",
+		Short: "Submit a governance proposal to update the sourcehub module parameters",
+		Long: "Submit a governance proposal that replaces the module parameters. Pass an empty admin (\"\") to leave administration to governance only.\n\n" +
+			"Example:\n  shinzohubd tx sourcehub update-params shinzo1... --title \"Rotate admin\" --summary \"...\" --deposit 10000000ushinzo --from acc0",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal, err := govcli.ReadGovPropFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			batchSize, err := cmd.Flags().GetUint32(FlagBatchSize)
+			if err != nil {
+				return err
+			}
+
+			maxAttempts, err := cmd.Flags().GetUint32(FlagMaxAttempts)
+			if err != nil {
+				return err
+			}
+
+			autoReopen, err := cmd.Flags().GetBool(FlagAutoReopen)
+			if err != nil {
+				return err
+			}
+
+			outpostConnections, err := cmd.Flags().GetStringSlice(FlagOutpostConnections)
+			if err != nil {
+				return err
+			}
+
+			var shares types.RevenueShares
+			for flag, share := range map[string]*uint32{
+				FlagCreatorShare:       &shares.Creator,
+				FlagHostsShare:         &shares.Hosts,
+				FlagIndexersShare:      &shares.Indexers,
+				FlagCommunityPoolShare: &shares.CommunityPool,
+			} {
+				if *share, err = cmd.Flags().GetUint32(flag); err != nil {
+					return err
+				}
+			}
+
+			epochLength, err := cmd.Flags().GetUint64(FlagEpochLength)
+			if err != nil {
+				return err
+			}
+
+			indexerBond, err := readEntityBond(cmd, FlagIndexerBond, FlagIndexerSlash)
+			if err != nil {
+				return err
+			}
+
+			hostBond, err := readEntityBond(cmd, FlagHostBond, FlagHostSlash)
+			if err != nil {
+				return err
+			}
+
+			unbondingPeriod, err := cmd.Flags().GetUint64(FlagUnbondingPeriod)
+			if err != nil {
+				return err
+			}
+
+			registrationWindow, err := cmd.Flags().GetUint64(FlagRegistrationWindow)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgUpdateParams{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Params: types.Params{
+					Admin:                 args[0],
+					MaxBatchSize:          batchSize,
+					MaxAttempts:           maxAttempts,
+					AutoReopenChannel:     autoReopen,
+					OutpostConnections:    outpostConnections,
+					RevenueShares:         shares,
+					EpochLength:           epochLength,
+					IndexerBond:           indexerBond,
+					HostBond:              hostBond,
+					UnbondingPeriod:       unbondingPeriod,
+					MaxRegistrationWindow: registrationWindow,
+				},
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			if err := proposal.SetMsgs([]sdk.Msg{msg}); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposal)
+		},
+	}
+
+	cmd.Flags().Uint32(FlagBatchSize, types.DefaultMaxBatchSize, "Maximum number of ACP commands sent to SourceHub in one ICA packet")
+	cmd.Flags().Uint32(FlagMaxAttempts, types.DefaultMaxAttempts, "Number of times an ACP command is sent to SourceHub before it is dead-lettered")
+	cmd.Flags().Bool(FlagAutoReopen, false, "Reopen the ICA channel automatically once it is closed")
+	cmd.Flags().StringSlice(FlagOutpostConnections, nil, "IBC connections whose outpost channels are trusted to report stream access payments")
+	cmd.Flags().Uint32(FlagCreatorShare, 0, "Share of subscription fees going to the creator of a view, in basis points")
+	cmd.Flags().Uint32(FlagHostsShare, 0, "Share of subscription fees going to the hosts syncing a view, in basis points")
+	cmd.Flags().Uint32(FlagIndexersShare, 0, "Share of subscription fees going to the indexers writing the primitives of a stream, in basis points")
+	cmd.Flags().Uint32(FlagCommunityPoolShare, 0, "Share of subscription fees going to the community pool, in basis points")
+	cmd.Flags().Uint64(FlagEpochLength, types.DefaultEpochLength, "Number of blocks after which the collected subscription fees are distributed")
+	cmd.Flags().String(FlagIndexerBond, "", "Bond locked when registering an indexer, e.g. 1000000ushinzo")
+	cmd.Flags().String(FlagIndexerSlash, "0", "Fraction of an indexer bond slashed when the indexer is blocked")
+	cmd.Flags().String(FlagHostBond, "", "Bond locked when registering a host, e.g. 1000000ushinzo")
+	cmd.Flags().String(FlagHostSlash, "0", "Fraction of a host bond slashed when the host is blocked")
+	cmd.Flags().Uint64(FlagUnbondingPeriod, types.DefaultUnbondingPeriod, "Number of seconds an entity bond stays locked after the entity is unregistered or blocked")
+	cmd.Flags().Uint64(FlagRegistrationWindow, types.DefaultMaxRegistrationWindow, "Number of blocks past the current height a registration message may expire at")
+	flags.AddTxFlagsToCmd(cmd)
+	govcli.AddGovPropFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdUpdateShinzoPolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-shinzo-policy [policy-file]",
+		Short: "Submit a governance proposal to adopt a new version of the shinzohub policy",
+		Long: "Submit a governance proposal that adopts the YAML policy in policy-file and edits the policy of every SourceHub target it is registered on.\n\n" +
+			"Example:\n  shinzohubd tx sourcehub update-shinzo-policy policy.yaml --title \"Add reader relation\" --summary \"...\" --deposit 10000000ushinzo --from acc0",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal, err := govcli.ReadGovPropFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			policy, err := os.ReadFile(args[0])
+			if err != nil {
+				return fmt.Errorf("read policy: %w", err)
+			}
+
+			msg := &types.MsgUpdateShinzoPolicy{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Policy:    string(policy),
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			if err := proposal.SetMsgs([]sdk.Msg{msg}); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposal)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	govcli.AddGovPropFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGrantRole() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-role [role] [address]",
+		Short: "Assign a module role (policy-admin, object-registrar, access-granter) to an account",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			role, err := types.ParseModuleRole(args[0])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgGrantRole{
+				Signer:  clientCtx.GetFromAddress().String(),
+				Role:    role,
+				Address: args[1],
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdRevokeRole() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-role [role] [address]",
+		Short: "Remove a module role from an account",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			role, err := types.ParseModuleRole(args[0])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgRevokeRole{
+				Signer:  clientCtx.GetFromAddress().String(),
+				Role:    role,
+				Address: args[1],
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// readEntityBond reads the bond amount and slash fraction of an entity role
+// from the amountFlag and fractionFlag flags.
+func readEntityBond(cmd *cobra.Command, amountFlag, fractionFlag string) (types.EntityBond, error) {
+	amount, err := cmd.Flags().GetString(amountFlag)
+	if err != nil {
+		return types.EntityBond{}, err
+	}
+	coins, err := sdk.ParseCoinsNormalized(amount)
+	if err != nil {
+		return types.EntityBond{}, fmt.Errorf("invalid --%s %q: %w", amountFlag, amount, err)
+	}
+
+	fraction, err := cmd.Flags().GetString(fractionFlag)
+	if err != nil {
+		return types.EntityBond{}, err
+	}
+	dec, err := math.LegacyNewDecFromStr(fraction)
+	if err != nil {
+		return types.EntityBond{}, fmt.Errorf("invalid --%s %q: %w", fractionFlag, fraction, err)
+	}
+
+	return types.EntityBond{Amount: coins, SlashFraction: dec}, nil
+}
+
+func CmdBlockEntity() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "block-entity [owner] [role]",
+		Short: "Block a registered indexer or host and slash its bond",
+		Long: "Block the entity registered by owner for role (indexer, host). Its group membership is removed on SourceHub, " +
+			"the slash fraction of its bond goes to the community pool and the rest is unbonded.\n\n" +
+			"Example:\n  shinzohubd tx sourcehub block-entity shinzo1... host --from admin",
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			role, ok := types.EntityRole_value["ENTITY_ROLE_"+strings.ToUpper(args[1])]
+			if !ok {
+				return fmt.Errorf("invalid role %q, expected indexer or host", args[1])
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgBlockEntity{
+				Signer: clientCtx.GetFromAddress().String(),
+				Owner:  args[0],
+				Role:   types.EntityRole(role),
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdBackfillObjects() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "backfill-objects [resource] [id]...",
+		Short: "Record objects registered on SourceHub before relationships were mirrored",
+		Long: "Record the objects of resource (primitive, view) with the given IDs as owned by the interchain account of the target, " +
+			"as if SourceHub had acknowledged their registration. Nothing is sent to SourceHub. Use it for objects registered " +
+			"before shinzohub mirrored relationships, so that views can derive from them.\n\n" +
+			"Example:\n  shinzohubd tx sourcehub backfill-objects primitive block log --from admin",
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			resource, ok := types.Resource_value["RESOURCE_"+strings.ToUpper(args[0])]
+			if !ok {
+				return fmt.Errorf("invalid resource %q, expected primitive or view", args[0])
+			}
+
+			target, err := cmd.Flags().GetString(FlagTarget)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgBackfillObjects{
+				Signer:   clientCtx.GetFromAddress().String(),
+				Target:   target,
+				Resource: types.Resource(resource),
+				Ids:      args[1:],
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagTarget, "", targetFlagUsage)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdPurgeDeadLetters() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "purge-dead-letters [id]...",
+		Short: "Delete commands from the dead-letter list, all of them if no ID is given",
+		Args:  cobra.ArbitraryArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ids := make([]uint64, 0, len(args))
+			for _, arg := range args {
+				id, err := strconv.ParseUint(arg, 10, 64)
+				if err != nil {
+					return fmt.Errorf("invalid id %q: %w", arg, err)
+				}
+				ids = append(ids, id)
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgPurgeDeadLetters{
+				Signer: clientCtx.GetFromAddress().String(),
+				Ids:    ids,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdReopenICAChannel() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reopen-ica-channel",
+		Short: "Open a new ICA channel to SourceHub after the previous one was closed",
+		Long: "Buffered ACP commands are sent on the new channel once its handshake completes.\n\n" +
+			"Example:\n  shinzohubd tx sourcehub reopen-ica-channel --from acc0",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			target, err := cmd.Flags().GetString(FlagTarget)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgReopenICAChannel{
+				Signer: clientCtx.GetFromAddress().String(),
+				Target: target,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagTarget, "", targetFlagUsage)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdSetDefaultTarget() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-default-target [chain-id]",
+		Short: "Select the SourceHub target used when a message or precompile call names none",
+		Long:  "Example:\n  shinzohubd tx sourcehub set-default-target sourcehub-dev --from acc0",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSetDefaultTarget{
+				Signer:  clientCtx.GetFromAddress().String(),
+				ChainId: args[0],
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
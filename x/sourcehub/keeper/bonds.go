@@ -0,0 +1,182 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	coretypes "github.com/sourcenetwork/acp_core/pkg/types"
+	acptypes "github.com/sourcenetwork/sourcehub/x/acp/types"
+
+	"github.com/shinzonetwork/shinzohub/x/sourcehub/types"
+)
+
+// maxUnbondingsPerBlock bounds the number of entity bonds released in a
+// single EndBlocker, the rest are picked up in the following blocks.
+const maxUnbondingsPerBlock = 100
+
+func unbondingKey(u types.EntityUnbonding) (collections.Triple[uint64, sdk.AccAddress, int32], error) {
+	owner, err := sdk.AccAddressFromBech32(u.Owner)
+	if err != nil {
+		return collections.Triple[uint64, sdk.AccAddress, int32]{}, err
+	}
+	return collections.Join3(u.CompletionTime, owner, int32(u.Role)), nil
+}
+
+// lockEntityBond moves the bond required to register an entity of role from
+// owner to the module account and returns it.
+func (k Keeper) lockEntityBond(ctx sdk.Context, owner sdk.AccAddress, role types.EntityRole) (sdk.Coins, error) {
+	p, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	bond := p.EntityBond(role).Amount
+	if bond.IsZero() {
+		return nil, nil
+	}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, owner, types.ModuleName, bond); err != nil {
+		return nil, fmt.Errorf("lock %s bond of %s: %w", RoleToString(uint8(role)), bond, err)
+	}
+	return bond, nil
+}
+
+// startEntityUnbonding keeps amount, the bond of e, in the module account
+// until the unbonding period ends.
+func (k Keeper) startEntityUnbonding(ctx sdk.Context, e types.Entity, amount sdk.Coins) error {
+	if amount.IsZero() {
+		return nil
+	}
+	p, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	u := types.EntityUnbonding{
+		Owner:          e.Owner,
+		Role:           e.Role,
+		Did:            e.Did,
+		Amount:         amount,
+		CompletionTime: uint64(ctx.BlockTime().Unix()) + p.UnbondingTime(),
+	}
+	key, err := unbondingKey(u)
+	if err != nil {
+		return err
+	}
+	// The same entity may be registered and removed again before the
+	// previous bond completes unbonding.
+	if existing, err := k.EntityUnbondings.Get(ctx, key); err == nil {
+		u.Amount = u.Amount.Add(existing.Amount...)
+	} else if !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	if err := k.EntityUnbondings.Set(ctx, key, u); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventEntityUnbondingStarted{
+		Owner:          e.Owner,
+		Role:           e.Role,
+		Did:            e.Did,
+		Amount:         amount,
+		CompletionTime: u.CompletionTime,
+	})
+}
+
+// ReleaseEntityUnbondings returns the bonds whose unbonding period ended at
+// or before the block time to their owners. A bond that cannot be sent is
+// logged and retried in the next block.
+func (k Keeper) ReleaseEntityUnbondings(ctx sdk.Context) error {
+	now := uint64(ctx.BlockTime().Unix())
+
+	var matured []types.EntityUnbonding
+	rng := collections.NewPrefixUntilTripleRange[uint64, sdk.AccAddress, int32](now)
+	err := k.EntityUnbondings.Walk(ctx, rng, func(_ collections.Triple[uint64, sdk.AccAddress, int32], u types.EntityUnbonding) (bool, error) {
+		if len(matured) >= maxUnbondingsPerBlock {
+			return true, nil
+		}
+		matured = append(matured, u)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, u := range matured {
+		key, err := unbondingKey(u)
+		if err != nil {
+			return err
+		}
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, key.K2(), u.Amount); err != nil {
+			k.Logger(ctx).Error("failed to release entity bond", "owner", u.Owner, "amount", u.Amount.String(), "error", err)
+			continue
+		}
+		if err := k.EntityUnbondings.Remove(ctx, key); err != nil {
+			return err
+		}
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventEntityUnbonded{
+			Owner:  u.Owner,
+			Role:   u.Role,
+			Did:    u.Did,
+			Amount: u.Amount,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// BlockEntity removes the group membership of the entity registered by owner
+// for role, sends the slashed part of its bond to the community pool and
+// unbonds the rest. The blocked entity keeps its DID and PID, so neither can
+// register again, and is reported to the AfterEntityRemoved hooks.
+func (k Keeper) BlockEntity(ctx sdk.Context, owner sdk.AccAddress, role types.EntityRole, signer string) (sdk.Coins, error) {
+	e, found := k.GetEntity(ctx, owner, role)
+	if !found {
+		return nil, fmt.Errorf("no %s registered for address %s", RoleToString(uint8(role)), owner)
+	}
+	if e.Status == types.EntityStatus_ENTITY_STATUS_BLOCKED {
+		return nil, fmt.Errorf("%s %s is already blocked", RoleToString(uint8(role)), owner)
+	}
+
+	t, addr, policyId, err := k.targetAccount(ctx, e.Target)
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := acptypes.NewMsgDirectPolicyCmd(
+		addr,
+		policyId,
+		acptypes.NewDeleteRelationshipCmd(coretypes.NewActorRelationship("group", RoleToString(uint8(role)), "guest", e.Did)),
+	)
+	if _, err := k.queueCommand(ctx, t.ChainId, types.PacketKind_PACKET_KIND_BLOCK_ENTITY, signer, cmd); err != nil {
+		return nil, err
+	}
+
+	p, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	slashed := p.EntityBond(role).Slash(e.Bond)
+	if !slashed.IsZero() {
+		if err := k.distrKeeper.FundCommunityPool(ctx, slashed, types.ModuleAddress); err != nil {
+			return nil, err
+		}
+	}
+	if err := k.startEntityUnbonding(ctx, e, e.Bond.Sub(slashed...)); err != nil {
+		return nil, err
+	}
+
+	e.Status = types.EntityStatus_ENTITY_STATUS_BLOCKED
+	e.Bond = nil
+	if err := k.SetEntity(ctx, e); err != nil {
+		return nil, err
+	}
+
+	if err := k.Hooks().AfterEntityRemoved(ctx, e); err != nil {
+		return nil, err
+	}
+
+	return slashed, nil
+}
@@ -0,0 +1,166 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/shinzonetwork/shinzohub/x/sourcehub/types"
+)
+
+// setEntityBonds requires a bond of amount ushinzo for both roles, slashed at
+// fraction when an entity is blocked, and unbonded over period seconds.
+func setEntityBonds(t *testing.T, k Keeper, ctx sdk.Context, amount int64, fraction string, period uint64) {
+	t.Helper()
+
+	p, err := k.GetParams(ctx)
+	require.NoError(t, err)
+	bond := types.EntityBond{Amount: ushinzo(amount), SlashFraction: math.LegacyMustNewDecFromStr(fraction)}
+	p.IndexerBond = bond
+	p.HostBond = bond
+	p.UnbondingPeriod = period
+	k.SetParams(ctx, p)
+}
+
+func TestRegisterEntityLocksBond(t *testing.T) {
+	k, ctx, _ := setupKeeperWithICA(t)
+	bank := testBank(k)
+	setEntityBonds(t, k, ctx, 100, "0", 60)
+
+	owner := sdk.AccAddress("owner_______________")
+	keys := newTestEntityKeys(t)
+	_, _, err := keys.register(ctx, k, owner, types.RoleIndexer)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+	_, found := k.GetEntity(ctx, owner, types.EntityRole_ENTITY_ROLE_INDEXER)
+	require.False(t, found)
+
+	bank.balances[owner.String()] = ushinzo(150)
+	_, _, err = keys.register(ctx, k, owner, types.RoleIndexer)
+	require.NoError(t, err)
+	require.Equal(t, ushinzo(50), bank.balances[owner.String()])
+	require.Equal(t, ushinzo(100), bank.balances[types.ModuleAddress.String()])
+
+	e, found := k.GetEntity(ctx, owner, types.EntityRole_ENTITY_ROLE_INDEXER)
+	require.True(t, found)
+	require.Equal(t, ushinzo(100), e.Bond)
+
+	// Registering again keeps the locked bond.
+	_, _, err = keys.register(ctx, k, owner, types.RoleIndexer)
+	require.NoError(t, err)
+	require.Equal(t, ushinzo(50), bank.balances[owner.String()])
+}
+
+func TestUnregisterEntityUnbondsBond(t *testing.T) {
+	k, ctx, _ := setupKeeperWithICA(t)
+	bank := testBank(k)
+	setEntityBonds(t, k, ctx, 100, "0", 60)
+	ctx = ctx.WithBlockTime(time.Unix(1000, 0))
+
+	owner := sdk.AccAddress("owner_______________")
+	bank.balances[owner.String()] = ushinzo(100)
+	did, _, err := newTestEntityKeys(t).register(ctx, k, owner, types.RoleHost)
+	require.NoError(t, err)
+
+	_, err = k.UnregisterEntity(ctx, types.RoleHost, owner)
+	require.NoError(t, err)
+
+	var started types.EventEntityUnbondingStarted
+	require.True(t, lastTypedEvent(t, ctx, &started))
+	require.Equal(t, uint64(1060), started.CompletionTime)
+	require.Equal(t, ushinzo(100), started.Amount)
+
+	res, err := NewQueryServerImpl(k).EntityUnbondings(ctx, &types.QueryEntityUnbondingsRequest{Owner: owner.String()})
+	require.NoError(t, err)
+	require.Equal(t, []types.EntityUnbonding{{
+		Owner:          owner.String(),
+		Role:           types.EntityRole_ENTITY_ROLE_HOST,
+		Did:            string(did),
+		Amount:         ushinzo(100),
+		CompletionTime: 1060,
+	}}, res.Unbondings)
+
+	// The bond stays locked until the unbonding period ends.
+	require.NoError(t, k.ReleaseEntityUnbondings(ctx.WithBlockTime(time.Unix(1059, 0))))
+	require.True(t, bank.balances[owner.String()].IsZero())
+
+	ctx = ctx.WithBlockTime(time.Unix(1060, 0))
+	require.NoError(t, k.ReleaseEntityUnbondings(ctx))
+	require.Equal(t, ushinzo(100), bank.balances[owner.String()])
+	require.True(t, bank.balances[types.ModuleAddress.String()].IsZero())
+
+	var unbonded types.EventEntityUnbonded
+	require.True(t, lastTypedEvent(t, ctx, &unbonded))
+	require.Equal(t, owner.String(), unbonded.Owner)
+
+	res, err = NewQueryServerImpl(k).EntityUnbondings(ctx, &types.QueryEntityUnbondingsRequest{})
+	require.NoError(t, err)
+	require.Empty(t, res.Unbondings)
+}
+
+func TestBlockEntity(t *testing.T) {
+	k, ctx, ica := setupKeeperWithICA(t)
+	ms := NewMsgServerImpl(k)
+	bank := testBank(k)
+	setEntityBonds(t, k, ctx, 100, "0.25", 60)
+	ctx = ctx.WithBlockTime(time.Unix(1000, 0))
+
+	admin := sdk.AccAddress("admin_______________").String()
+	p, err := k.GetParams(ctx)
+	require.NoError(t, err)
+	p.Admin = admin
+	k.SetParams(ctx, p)
+
+	owner := sdk.AccAddress("owner_______________")
+	bank.balances[owner.String()] = ushinzo(100)
+	keys := newTestEntityKeys(t)
+	did, _, err := keys.register(ctx, k, owner, types.RoleIndexer)
+	require.NoError(t, err)
+	require.NoError(t, k.FlushOutbox(ctx))
+
+	msg := &types.MsgBlockEntity{Signer: owner.String(), Owner: owner.String(), Role: types.EntityRole_ENTITY_ROLE_INDEXER}
+	_, err = ms.BlockEntity(ctx, msg)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	msg.Signer = admin
+	res, err := ms.BlockEntity(ctx, msg)
+	require.NoError(t, err)
+	require.Equal(t, ushinzo(25), res.Slashed)
+	require.Equal(t, ushinzo(25), bank.balances[communityPoolAddress.String()])
+	require.Equal(t, ushinzo(75), bank.balances[types.ModuleAddress.String()])
+
+	var blocked types.EventEntityBlocked
+	require.True(t, lastTypedEvent(t, ctx, &blocked))
+	require.Equal(t, string(did), blocked.Did)
+	require.Equal(t, admin, blocked.Signer)
+
+	e, found := k.GetEntity(ctx, owner, types.EntityRole_ENTITY_ROLE_INDEXER)
+	require.True(t, found)
+	require.Equal(t, types.EntityStatus_ENTITY_STATUS_BLOCKED, e.Status)
+	require.True(t, e.Bond.IsZero())
+
+	require.NoError(t, k.FlushOutbox(ctx))
+	require.Len(t, ica.sent, 2)
+	rel := decodePolicyCmd(t, ica.sent[1].Data).Cmd.GetDeleteRelationshipCmd().Relationship
+	require.Equal(t, "indexer", rel.Object.Id)
+	require.Equal(t, string(did), rel.Subject.GetActor().Id)
+	packet, found := k.GetIcaPacket(ctx, testChannelID, ica.sequence)
+	require.True(t, found)
+	require.Equal(t, types.PacketKind_PACKET_KIND_BLOCK_ENTITY, packet.Kind)
+
+	// A blocked entity can neither come back nor leave with its bond.
+	_, _, err = keys.register(ctx, k, owner, types.RoleIndexer)
+	require.ErrorContains(t, err, "blocked")
+	_, err = k.UnregisterEntity(ctx, types.RoleIndexer, owner)
+	require.ErrorContains(t, err, "blocked")
+	_, err = ms.BlockEntity(ctx, msg)
+	require.ErrorContains(t, err, "already blocked")
+
+	// The part of the bond that was not slashed is returned after the
+	// unbonding period.
+	require.NoError(t, k.ReleaseEntityUnbondings(ctx.WithBlockTime(time.Unix(1060, 0))))
+	require.Equal(t, ushinzo(75), bank.balances[owner.String()])
+}
@@ -0,0 +1,215 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+
+	"github.com/shinzonetwork/shinzohub/x/sourcehub/types"
+)
+
+// reopenHandshakeTimeout is the number of blocks a channel handshake started
+// by BeginBlocker may take before a new one is started.
+const reopenHandshakeTimeout int64 = 100
+
+func (k Keeper) setIcaChannel(ctx sdk.Context, t types.SourcehubTarget, channelID string, state types.IcaChannelState) error {
+	t.Channel = types.IcaChannel{
+		ChannelId:     channelID,
+		State:         state,
+		UpdatedHeight: ctx.BlockHeight(),
+	}
+	return k.SetTarget(ctx, t)
+}
+
+// openICAChannel starts the handshake of an ORDERED ICA channel on the
+// controller connection of the target. Registering again once the channel is
+// closed opens a new channel for the same interchain account.
+func (k Keeper) openICAChannel(ctx sdk.Context, t types.SourcehubTarget) error {
+	if t.ControllerConnectionId == "" {
+		return fmt.Errorf("no connection ID set for target %s", t.ChainId)
+	}
+
+	if err := k.IcaCtrlKeeper.RegisterInterchainAccount(
+		ctx,
+		t.ControllerConnectionId,
+		types.ModuleAddress.String(),
+		t.IcaMetadata(),
+		channeltypes.ORDERED,
+	); err != nil {
+		return err
+	}
+
+	return k.setIcaChannel(ctx, t, t.Channel.ChannelId, types.IcaChannelState_ICA_CHANNEL_STATE_OPENING)
+}
+
+// ReopenICAChannel starts the handshake of a new ICA channel to the target,
+// the default target if chainId is empty, once the previous one is no longer
+// open.
+func (k Keeper) ReopenICAChannel(ctx sdk.Context, chainId string) error {
+	t, err := k.resolveTarget(ctx, chainId)
+	if err != nil {
+		return err
+	}
+
+	ch := t.Channel
+	if ch.State == types.IcaChannelState_ICA_CHANNEL_STATE_OPEN {
+		return fmt.Errorf("ICA channel %s is open", ch.ChannelId)
+	}
+
+	if err := k.openICAChannel(ctx, t); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventIcaChannelReopening{
+		ChannelId: ch.ChannelId,
+		Target:    t.ChainId,
+	})
+}
+
+// AutoReopenICAChannel reopens the closed ICA channels of every target if the
+// auto_reopen_channel param is set. A handshake that does not complete within
+// reopenHandshakeTimeout blocks is started again.
+func (k Keeper) AutoReopenICAChannel(ctx sdk.Context) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	if !params.AutoReopenChannel {
+		return nil
+	}
+
+	var reopen []types.SourcehubTarget
+	err = k.Targets.Walk(ctx, nil, func(_ string, t types.SourcehubTarget) (bool, error) {
+		ch := t.Channel
+		switch {
+		case ch.State == types.IcaChannelState_ICA_CHANNEL_STATE_CLOSED:
+		case ch.State == types.IcaChannelState_ICA_CHANNEL_STATE_OPENING && ctx.BlockHeight()-ch.UpdatedHeight >= reopenHandshakeTimeout:
+		default:
+			return false, nil
+		}
+		reopen = append(reopen, t)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, t := range reopen {
+		// Reopen in a cached context so a failure leaves the channel state in place.
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.ReopenICAChannel(cacheCtx, t.ChainId); err != nil {
+			k.Logger(ctx).Error("failed to reopen ICA channel", "target", t.ChainId, "channel", t.Channel.ChannelId, "error", err)
+			continue
+		}
+		write()
+	}
+
+	return nil
+}
+
+// OnIcaChannelOpened records that the handshake of an ICA channel of the
+// module completed. Commands buffered in the outbox for its target are sent
+// on it in EndBlocker.
+func (k Keeper) OnIcaChannelOpened(ctx sdk.Context, portID, channelID string) error {
+	if portID != k.GetICAPortID() {
+		return nil
+	}
+
+	t, found := k.targetByChannel(ctx, channelID)
+	if !found {
+		k.Logger(ctx).Info("ICA channel opened for unknown target", "channel", channelID)
+		return nil
+	}
+
+	if err := k.setIcaChannel(ctx, t, channelID, types.IcaChannelState_ICA_CHANNEL_STATE_OPEN); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventIcaChannelOpened{
+		ChannelId: channelID,
+		Target:    t.ChainId,
+	})
+}
+
+// OnIcaChannelClosed records that an ICA channel of the module was closed.
+// The packets still in flight on it will never be delivered, so they are
+// marked as timed out and their commands are put back in the outbox to be
+// replayed once a new channel to the target is open.
+func (k Keeper) OnIcaChannelClosed(ctx sdk.Context, portID, channelID string) error {
+	if portID != k.GetICAPortID() {
+		return nil
+	}
+
+	t, found := k.targetByChannel(ctx, channelID)
+	if !found {
+		// A channel replaced by a newer one.
+		return nil
+	}
+
+	ch := t.Channel
+	if ch.ChannelId != "" && ch.ChannelId != channelID {
+		return nil
+	}
+	if ch.State == types.IcaChannelState_ICA_CHANNEL_STATE_CLOSED {
+		return nil
+	}
+
+	if err := k.setIcaChannel(ctx, t, channelID, types.IcaChannelState_ICA_CHANNEL_STATE_CLOSED); err != nil {
+		return err
+	}
+
+	requeued, err := k.requeueInFlight(ctx, channelID)
+	if err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventIcaChannelClosed{
+		ChannelId: channelID,
+		Target:    t.ChainId,
+		Requeued:  uint64(requeued),
+	})
+}
+
+// requeueInFlight resolves the pending packets on channelID as timed out and
+// queues their commands again. The lost send does not count as an attempt.
+func (k Keeper) requeueInFlight(ctx sdk.Context, channelID string) (int, error) {
+	var pending []types.IcaPacket
+	err := k.IcaPackets.Walk(ctx, collections.NewPrefixedPairRange[string, uint64](channelID), func(_ collections.Pair[string, uint64], p types.IcaPacket) (bool, error) {
+		if p.Status == types.PacketStatus_PACKET_STATUS_PENDING {
+			pending = append(pending, p)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	requeued := 0
+	for _, p := range pending {
+		p.Status = types.PacketStatus_PACKET_STATUS_TIMED_OUT
+		p.Error = "channel closed"
+		p.ResolvedHeight = ctx.BlockHeight()
+		if err := k.IcaPackets.Set(ctx, collections.Join(p.ChannelId, p.Sequence), p); err != nil {
+			return 0, err
+		}
+
+		for _, id := range p.CommandIds {
+			c, found := k.GetIcaCommand(ctx, id)
+			if !found || c.Status != types.PacketStatus_PACKET_STATUS_PENDING || c.Sequence != p.Sequence {
+				continue
+			}
+
+			c.Status = types.PacketStatus_PACKET_STATUS_QUEUED
+			c.Attempts--
+			c.NextAttemptHeight = 0
+			if err := k.SetIcaCommand(ctx, c); err != nil {
+				return 0, err
+			}
+			requeued++
+		}
+	}
+
+	return requeued, nil
+}
@@ -0,0 +1,132 @@
+package keeper
+
+import (
+	"errors"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/shinzonetwork/shinzohub/x/sourcehub/types"
+)
+
+// defaultChannel returns the ICA channel of the default target.
+func defaultChannel(t *testing.T, k Keeper, ctx sdk.Context) types.IcaChannel {
+	t.Helper()
+
+	target, err := k.resolveTarget(ctx, "")
+	require.NoError(t, err)
+	return target.Channel
+}
+
+func TestRegisterSourcehubICATracksChannel(t *testing.T) {
+	k, ctx, _ := setupKeeper(t)
+	k.InitGenesis(ctx, *types.DefaultGenesis())
+
+	_, err := NewMsgServerImpl(k).RegisterSourcehubICA(ctx, &types.MsgRegisterSourcehubICA{
+		Signer:                 k.GetAuthority(),
+		ControllerConnectionId: "connection-0",
+		HostConnectionId:       "connection-0",
+		ChainId:                testTarget,
+	})
+	require.NoError(t, err)
+	require.Equal(t, types.IcaChannelState_ICA_CHANNEL_STATE_OPENING, defaultChannel(t, k, ctx).State)
+
+	// Channels on other ports are ignored.
+	require.NoError(t, k.OnIcaChannelOpened(ctx, "transfer", "channel-7"))
+	require.Equal(t, types.IcaChannelState_ICA_CHANNEL_STATE_OPENING, defaultChannel(t, k, ctx).State)
+
+	require.NoError(t, k.OnIcaChannelOpened(ctx, k.GetICAPortID(), testChannelID))
+	ch := defaultChannel(t, k, ctx)
+	require.Equal(t, types.IcaChannelState_ICA_CHANNEL_STATE_OPEN, ch.State)
+	require.Equal(t, testChannelID, ch.ChannelId)
+}
+
+func TestTimeoutClosesChannelAndReplaysCommands(t *testing.T) {
+	k, ctx, ica := setupKeeperWithICA(t)
+	ms := NewMsgServerImpl(k)
+	require.NoError(t, k.OnIcaChannelOpened(ctx, k.GetICAPortID(), testChannelID))
+
+	queueStreamAccess(t, k, ctx, "did:key:alice")
+	require.NoError(t, k.FlushOutbox(ctx))
+	queueStreamAccess(t, k, ctx, "did:key:bob")
+	require.NoError(t, k.FlushOutbox(ctx))
+	require.Len(t, ica.sent, 2)
+
+	_, err := ms.ReopenICAChannel(ctx, &types.MsgReopenICAChannel{Signer: k.GetAuthority()})
+	require.ErrorContains(t, err, "is open")
+
+	timeout := channeltypes.Packet{SourcePort: k.GetICAPortID(), SourceChannel: testChannelID, Sequence: 1}
+	require.NoError(t, k.OnTimeoutPacket(ctx, timeout))
+	require.Equal(t, types.IcaChannelState_ICA_CHANNEL_STATE_CLOSED, defaultChannel(t, k, ctx).State)
+
+	// The packet behind the timed out one is lost with the channel.
+	p, _ := k.GetIcaPacket(ctx, testChannelID, 2)
+	require.Equal(t, types.PacketStatus_PACKET_STATUS_TIMED_OUT, p.Status)
+	c, _ := k.GetIcaCommand(ctx, 1)
+	require.Equal(t, types.PacketStatus_PACKET_STATUS_QUEUED, c.Status)
+	require.Zero(t, c.Attempts)
+
+	// Its timeout on close is ignored.
+	require.NoError(t, k.OnTimeoutPacket(ctx, channeltypes.Packet{SourcePort: k.GetICAPortID(), SourceChannel: testChannelID, Sequence: 2}))
+	c, _ = k.GetIcaCommand(ctx, 1)
+	require.Equal(t, types.PacketStatus_PACKET_STATUS_QUEUED, c.Status)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + retryBaseDelay)
+	require.NoError(t, k.FlushOutbox(ctx))
+	require.Len(t, ica.sent, 2, "nothing is sent while the channel is closed")
+
+	_, err = ms.ReopenICAChannel(ctx, &types.MsgReopenICAChannel{Signer: k.GetAuthority()})
+	require.NoError(t, err)
+	require.Equal(t, types.IcaChannelState_ICA_CHANNEL_STATE_OPENING, defaultChannel(t, k, ctx).State)
+	require.NoError(t, k.FlushOutbox(ctx))
+	require.Len(t, ica.sent, 2, "nothing is sent during the handshake")
+
+	require.NoError(t, k.OnIcaChannelOpened(ctx, k.GetICAPortID(), testChannelID))
+	require.NoError(t, k.FlushOutbox(ctx))
+	require.Len(t, ica.sent, 4)
+
+	for _, id := range []uint64{0, 1} {
+		c, _ := k.GetIcaCommand(ctx, id)
+		require.Equal(t, types.PacketStatus_PACKET_STATUS_PENDING, c.Status)
+	}
+}
+
+func TestReopenICAChannelRequiresPolicyAdmin(t *testing.T) {
+	k, ctx, _ := setupKeeperWithICA(t)
+
+	_, err := NewMsgServerImpl(k).ReopenICAChannel(ctx, &types.MsgReopenICAChannel{Signer: testOutsider})
+	require.ErrorContains(t, err, "policy-admin role required")
+}
+
+func TestAutoReopenICAChannel(t *testing.T) {
+	k, ctx, ica := setupKeeperWithICA(t)
+	ctx = ctx.WithBlockHeight(10)
+	require.NoError(t, k.OnIcaChannelClosed(ctx, k.GetICAPortID(), testChannelID))
+
+	require.NoError(t, k.AutoReopenICAChannel(ctx))
+	require.Equal(t, types.IcaChannelState_ICA_CHANNEL_STATE_CLOSED, defaultChannel(t, k, ctx).State, "disabled by default")
+
+	params, err := k.GetParams(ctx)
+	require.NoError(t, err)
+	params.AutoReopenChannel = true
+	k.SetParams(ctx, params)
+
+	require.NoError(t, k.AutoReopenICAChannel(ctx))
+	ch := defaultChannel(t, k, ctx)
+	require.Equal(t, types.IcaChannelState_ICA_CHANNEL_STATE_OPENING, ch.State)
+	require.Equal(t, int64(10), ch.UpdatedHeight)
+
+	require.NoError(t, k.AutoReopenICAChannel(ctx.WithBlockHeight(10+reopenHandshakeTimeout-1)))
+	require.Equal(t, int64(10), defaultChannel(t, k, ctx).UpdatedHeight)
+
+	// A stalled handshake is started again, a failure keeps the state.
+	ica.registerErr = errors.New("connection closed")
+	require.NoError(t, k.AutoReopenICAChannel(ctx.WithBlockHeight(10+reopenHandshakeTimeout)))
+	require.Equal(t, int64(10), defaultChannel(t, k, ctx).UpdatedHeight)
+
+	ica.registerErr = nil
+	require.NoError(t, k.AutoReopenICAChannel(ctx.WithBlockHeight(10+reopenHandshakeTimeout)))
+	require.Equal(t, 10+reopenHandshakeTimeout, defaultChannel(t, k, ctx).UpdatedHeight)
+}
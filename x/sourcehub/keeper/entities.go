@@ -0,0 +1,404 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	coretypes "github.com/sourcenetwork/acp_core/pkg/types"
+	acptypes "github.com/sourcenetwork/sourcehub/x/acp/types"
+
+	"github.com/shinzonetwork/shinzohub/x/sourcehub/types"
+)
+
+// maxPrunedRegistrationsPerBlock bounds the number of expired registration
+// messages forgotten in a single block.
+const maxPrunedRegistrationsPerBlock = 100
+
+var entityKeyCodec = collections.PairKeyCodec(sdk.AccAddressKey, collections.Int32Key)
+
+func entityKey(owner sdk.AccAddress, role types.EntityRole) collections.Pair[sdk.AccAddress, int32] {
+	return collections.Join(owner, int32(role))
+}
+
+// GetEntity returns the entity registered by owner for role.
+func (k Keeper) GetEntity(ctx sdk.Context, owner sdk.AccAddress, role types.EntityRole) (types.Entity, bool) {
+	e, err := k.Entities.Get(ctx, entityKey(owner, role))
+	if err != nil {
+		return types.Entity{}, false
+	}
+	return e, true
+}
+
+// SetEntity stores an entity and keeps the DID and PID indexes in sync with it.
+func (k Keeper) SetEntity(ctx sdk.Context, e types.Entity) error {
+	owner, err := sdk.AccAddressFromBech32(e.Owner)
+	if err != nil {
+		return fmt.Errorf("invalid entity owner: %w", err)
+	}
+
+	if previous, found := k.GetEntity(ctx, owner, e.Role); found {
+		if err := k.removeEntityIndexes(ctx, previous); err != nil {
+			return err
+		}
+	}
+
+	if err := k.Entities.Set(ctx, entityKey(owner, e.Role), e); err != nil {
+		return err
+	}
+
+	if err := k.EntitiesByDid.Set(ctx, collections.Join(e.Did, int32(e.Role)), owner); err != nil {
+		return err
+	}
+	if e.Pid != "" {
+		return k.EntitiesByPid.Set(ctx, collections.Join(e.Pid, int32(e.Role)), owner)
+	}
+	return nil
+}
+
+// RemoveEntity deletes an entity and its index entries.
+func (k Keeper) RemoveEntity(ctx sdk.Context, e types.Entity) error {
+	owner, err := sdk.AccAddressFromBech32(e.Owner)
+	if err != nil {
+		return fmt.Errorf("invalid entity owner: %w", err)
+	}
+
+	if err := k.removeEntityIndexes(ctx, e); err != nil {
+		return err
+	}
+	return k.Entities.Remove(ctx, entityKey(owner, e.Role))
+}
+
+func (k Keeper) removeEntityIndexes(ctx sdk.Context, e types.Entity) error {
+	if err := k.EntitiesByDid.Remove(ctx, collections.Join(e.Did, int32(e.Role))); err != nil {
+		return err
+	}
+	if e.Pid != "" {
+		return k.EntitiesByPid.Remove(ctx, collections.Join(e.Pid, int32(e.Role)))
+	}
+	return nil
+}
+
+// GetEntitiesByAddress returns the entities registered by owner, one per role.
+func (k Keeper) GetEntitiesByAddress(ctx sdk.Context, owner sdk.AccAddress) ([]types.Entity, error) {
+	var entities []types.Entity
+	err := k.Entities.Walk(ctx, collections.NewPrefixedPairRange[sdk.AccAddress, int32](owner), func(_ collections.Pair[sdk.AccAddress, int32], e types.Entity) (bool, error) {
+		entities = append(entities, e)
+		return false, nil
+	})
+	return entities, err
+}
+
+// GetEntitiesByDid returns the entities registered with did, one per role.
+func (k Keeper) GetEntitiesByDid(ctx sdk.Context, did string) ([]types.Entity, error) {
+	return k.entitiesByIndex(ctx, k.EntitiesByDid, did)
+}
+
+// GetEntitiesByPid returns the entities registered with pid, one per role.
+func (k Keeper) GetEntitiesByPid(ctx sdk.Context, pid string) ([]types.Entity, error) {
+	return k.entitiesByIndex(ctx, k.EntitiesByPid, pid)
+}
+
+func (k Keeper) entitiesByIndex(ctx sdk.Context, index collections.Map[collections.Pair[string, int32], sdk.AccAddress], id string) ([]types.Entity, error) {
+	var entities []types.Entity
+	err := index.Walk(ctx, collections.NewPrefixedPairRange[string, int32](id), func(key collections.Pair[string, int32], owner sdk.AccAddress) (bool, error) {
+		e, err := k.Entities.Get(ctx, collections.Join(owner, key.K2()))
+		if err != nil {
+			return true, err
+		}
+		entities = append(entities, e)
+		return false, nil
+	})
+	return entities, err
+}
+
+// GetDidByAddress returns the DID registered by address, preferring the
+// indexer registration when the address registered for both roles.
+func (k Keeper) GetDidByAddress(ctx sdk.Context, address []byte) ([]byte, error) {
+	entities, err := k.GetEntitiesByAddress(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+	if len(entities) == 0 {
+		return nil, fmt.Errorf("no entity registered for address %s", sdk.AccAddress(address))
+	}
+	return []byte(entities[0].Did), nil
+}
+
+// GetPidByAddress returns the libp2p peer ID registered by address, preferring
+// the indexer registration when the address registered for both roles.
+func (k Keeper) GetPidByAddress(ctx sdk.Context, address []byte) ([]byte, error) {
+	entities, err := k.GetEntitiesByAddress(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range entities {
+		if e.Pid != "" {
+			return []byte(e.Pid), nil
+		}
+	}
+	return nil, fmt.Errorf("no peer ID registered for address %s", sdk.AccAddress(address))
+}
+
+// useRegistrationMessage checks that message is a registration message for
+// owner and role on this chain, within the registration window, that was not
+// used before, records it as used and returns the decoded message.
+func (k Keeper) useRegistrationMessage(ctx sdk.Context, message []byte, owner sdk.AccAddress, role types.EntityRole) (types.RegistrationMessage, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return types.RegistrationMessage{}, err
+	}
+
+	m, err := types.ParseRegistrationMessage(message, ctx.ChainID(), owner, role, ctx.BlockHeight(), params.RegistrationWindow())
+	if err != nil {
+		return m, err
+	}
+
+	key := collections.Join(m.ExpiryHeight, types.RegistrationMessageHash(message))
+	used, err := k.UsedRegistrations.Has(ctx, key)
+	if err != nil {
+		return m, err
+	}
+	if used {
+		return m, fmt.Errorf("registration message already used")
+	}
+	return m, k.UsedRegistrations.Set(ctx, key)
+}
+
+// PruneUsedRegistrations forgets up to maxPrunedRegistrationsPerBlock used
+// registration messages that expired before the current height. An expired
+// message is rejected anyway, so it no longer needs replay protection.
+func (k Keeper) PruneUsedRegistrations(ctx sdk.Context) error {
+	var expired []collections.Pair[int64, []byte]
+	rng := new(collections.Range[collections.Pair[int64, []byte]]).EndExclusive(collections.PairPrefix[int64, []byte](ctx.BlockHeight()))
+	err := k.UsedRegistrations.Walk(ctx, rng, func(key collections.Pair[int64, []byte]) (bool, error) {
+		if len(expired) >= maxPrunedRegistrationsPerBlock {
+			return true, nil
+		}
+		expired = append(expired, key)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, key := range expired {
+		if err := k.UsedRegistrations.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setEntityCommand records command id as the one whose outcome sets the
+// status of the entity with the given key. The earlier commands of the entity
+// are forgotten, so that their late outcome cannot override it.
+func (k Keeper) setEntityCommand(ctx sdk.Context, id uint64, key collections.Pair[sdk.AccAddress, int32]) error {
+	var stale []uint64
+	err := k.EntityCommands.Walk(ctx, nil, func(id uint64, e collections.Pair[sdk.AccAddress, int32]) (bool, error) {
+		if e.K1().Equals(key.K1()) && e.K2() == key.K2() {
+			stale = append(stale, id)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, id := range stale {
+		if err := k.EntityCommands.Remove(ctx, id); err != nil {
+			return err
+		}
+	}
+
+	return k.EntityCommands.Set(ctx, id, key)
+}
+
+// resolveEntityCommand updates the status of the entity whose group
+// membership was carried by command c.
+func (k Keeper) resolveEntityCommand(ctx sdk.Context, c types.IcaCommand) error {
+	key, err := k.EntityCommands.Get(ctx, c.Id)
+	if err != nil {
+		return nil
+	}
+	if err := k.EntityCommands.Remove(ctx, c.Id); err != nil {
+		return err
+	}
+
+	e, err := k.Entities.Get(ctx, key)
+	if err != nil || e.Status == types.EntityStatus_ENTITY_STATUS_BLOCKED {
+		// The entity was removed or blocked while the command was in flight.
+		return nil
+	}
+
+	if c.Status == types.PacketStatus_PACKET_STATUS_ACKNOWLEDGED {
+		e.Status = types.EntityStatus_ENTITY_STATUS_ACTIVE
+	} else {
+		e.Status = types.EntityStatus_ENTITY_STATUS_FAILED
+	}
+	if err := k.Entities.Set(ctx, key, e); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventEntityStatusChanged{
+		Owner:  e.Owner,
+		Role:   e.Role,
+		Did:    e.Did,
+		Status: e.Status,
+	})
+}
+
+// UnregisterEntity removes the entity registered by address for role,
+// deletes its group guest relationship on SourceHub and starts unbonding its
+// bond.
+func (k Keeper) UnregisterEntity(ctx sdk.Context, entity uint8, address []byte) ([]byte, error) {
+	owner := sdk.AccAddress(address)
+
+	e, found := k.GetEntity(ctx, owner, types.EntityRole(entity))
+	if !found {
+		return nil, fmt.Errorf("no %s registered for address %s", RoleToString(entity), owner)
+	}
+	if e.Status == types.EntityStatus_ENTITY_STATUS_BLOCKED {
+		return nil, fmt.Errorf("blocked %s cannot be unregistered", RoleToString(entity))
+	}
+
+	t, addr, policyId, err := k.targetAccount(ctx, e.Target)
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := acptypes.NewMsgDirectPolicyCmd(
+		addr,
+		policyId,
+		acptypes.NewDeleteRelationshipCmd(coretypes.NewActorRelationship("group", RoleToString(entity), "guest", e.Did)),
+	)
+
+	if _, err := k.queueCommand(ctx, t.ChainId, types.PacketKind_PACKET_KIND_UNREGISTER_ENTITY, owner.String(), cmd); err != nil {
+		return nil, err
+	}
+
+	if err := k.RemoveEntity(ctx, e); err != nil {
+		return nil, err
+	}
+
+	if err := k.startEntityUnbonding(ctx, e, e.Bond); err != nil {
+		return nil, err
+	}
+
+	if err := k.Hooks().AfterEntityRemoved(ctx, e); err != nil {
+		return nil, err
+	}
+
+	return []byte(e.Did), nil
+}
+
+// RotateEntityKeys replaces the peer and node identity keys of the entity
+// registered by address for role. The old keys prove the rotation by signing
+// message, a fresh registration message, and so do the new keys. When the DID changes, the group guest
+// relationship of the old DID is replaced by one for the new DID in a single
+// outbox command.
+func (k Keeper) RotateEntityKeys(
+	ctx sdk.Context,
+	oldPeerKeySignature []byte,
+	oldNodeIdentityKeySignature []byte,
+	newPeerKeyPubkey []byte,
+	newPeerKeySignature []byte,
+	newNodeIdentityKeyPubkey []byte,
+	newNodeIdentityKeySignature []byte,
+	message []byte,
+	entity uint8,
+	address []byte,
+) ([]byte, []byte, error) {
+	owner := sdk.AccAddress(address)
+	role := types.EntityRole(entity)
+
+	e, found := k.GetEntity(ctx, owner, role)
+	if !found {
+		return nil, nil, fmt.Errorf("no %s registered for address %s", RoleToString(entity), owner)
+	}
+
+	if e.Status == types.EntityStatus_ENTITY_STATUS_BLOCKED {
+		return nil, nil, fmt.Errorf("blocked %s cannot rotate its keys", RoleToString(entity))
+	}
+
+	if len(e.PeerKeyPubkey) == 0 || len(e.NodeIdentityKeyPubkey) == 0 {
+		return nil, nil, fmt.Errorf("entity has no recorded keys, unregister and register again")
+	}
+
+	if _, err := k.useRegistrationMessage(ctx, message, owner, role); err != nil {
+		return nil, nil, err
+	}
+
+	if err := verifyPeerKeySignature(e.PeerKeyPubkey, message, oldPeerKeySignature); err != nil {
+		return nil, nil, fmt.Errorf("old peer key: %w", err)
+	}
+
+	if err := verifynodeIdentityKeySignature(e.NodeIdentityKeyPubkey, message, oldNodeIdentityKeySignature); err != nil {
+		return nil, nil, fmt.Errorf("old node identity key: %w", err)
+	}
+
+	if err := verifyPeerKeySignature(newPeerKeyPubkey, message, newPeerKeySignature); err != nil {
+		return nil, nil, fmt.Errorf("new peer key: %w", err)
+	}
+
+	if err := verifynodeIdentityKeySignature(newNodeIdentityKeyPubkey, message, newNodeIdentityKeySignature); err != nil {
+		return nil, nil, fmt.Errorf("new node identity key: %w", err)
+	}
+
+	pid, err := derivePIDFromPeerKeyPublicKey(newPeerKeyPubkey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	did, err := deriveDIDFromNodeIdentityPublicKey(newNodeIdentityKeyPubkey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if existingOwner, err := k.EntitiesByDid.Get(ctx, collections.Join(did, int32(role))); err == nil && !existingOwner.Equals(owner) {
+		return nil, nil, fmt.Errorf("DID already registered for this role with a different address")
+	}
+
+	if existingOwner, err := k.EntitiesByPid.Get(ctx, collections.Join(pid, int32(role))); err == nil && !existingOwner.Equals(owner) {
+		return nil, nil, fmt.Errorf("PID already registered for this role with a different address")
+	}
+
+	oldDid := e.Did
+
+	e.Did = did
+	e.Pid = pid
+	e.PeerKeyPubkey = newPeerKeyPubkey
+	e.NodeIdentityKeyPubkey = newNodeIdentityKeyPubkey
+	e.Message = message
+	e.Height = ctx.BlockHeight()
+
+	if did != oldDid {
+		t, addr, policyId, err := k.targetAccount(ctx, e.Target)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		group := RoleToString(entity)
+		command, err := k.queueCommand(
+			ctx,
+			t.ChainId,
+			types.PacketKind_PACKET_KIND_ROTATE_ENTITY_KEYS,
+			owner.String(),
+			acptypes.NewMsgDirectPolicyCmd(addr, policyId, acptypes.NewDeleteRelationshipCmd(coretypes.NewActorRelationship("group", group, "guest", oldDid))),
+			acptypes.NewMsgDirectPolicyCmd(addr, policyId, acptypes.NewSetRelationshipCmd(coretypes.NewActorRelationship("group", group, "guest", did))),
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		e.Status = types.EntityStatus_ENTITY_STATUS_PENDING
+		if err := k.setEntityCommand(ctx, command.Id, entityKey(owner, role)); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if err := k.SetEntity(ctx, e); err != nil {
+		return nil, nil, err
+	}
+
+	return []byte(did), []byte(pid), nil
+}
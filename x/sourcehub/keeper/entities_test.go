@@ -0,0 +1,490 @@
+package keeper
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"sync/atomic"
+	"testing"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+	acptypes "github.com/sourcenetwork/sourcehub/x/acp/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/shinzonetwork/shinzohub/x/sourcehub/types"
+)
+
+// testEntityKeys holds the peer and node identity keys of a test entity.
+type testEntityKeys struct {
+	peerPub  ed25519.PublicKey
+	peerPriv ed25519.PrivateKey
+	nodePriv *secp256k1.PrivateKey
+}
+
+func newTestEntityKeys(t *testing.T) testEntityKeys {
+	t.Helper()
+
+	peerPub, peerPriv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	nodePriv, err := secp256k1.GeneratePrivateKey()
+	require.NoError(t, err)
+
+	return testEntityKeys{peerPub: peerPub, peerPriv: peerPriv, nodePriv: nodePriv}
+}
+
+// testNonce makes every registration message signed in a test distinct.
+var testNonce atomic.Uint64
+
+// registrationMessage returns a fresh registration message for owner and role.
+func registrationMessage(ctx sdk.Context, owner sdk.AccAddress, role uint8) []byte {
+	m := types.NewRegistrationMessage(ctx.ChainID(), owner, types.EntityRole(role), testNonce.Add(1), ctx.BlockHeight()+100)
+	return m.GetSignBytes()
+}
+
+func (keys testEntityKeys) register(ctx sdk.Context, k Keeper, owner sdk.AccAddress, role uint8) ([]byte, []byte, error) {
+	return keys.registerWithMessage(ctx, k, registrationMessage(ctx, owner, role), owner, role)
+}
+
+func (keys testEntityKeys) registerWithMessage(ctx sdk.Context, k Keeper, message []byte, owner sdk.AccAddress, role uint8) ([]byte, []byte, error) {
+	h := sha256.Sum256(message)
+
+	return k.RegisterEntity(
+		ctx,
+		keys.peerPub,
+		ed25519.Sign(keys.peerPriv, message),
+		keys.nodePriv.PubKey().SerializeCompressed(),
+		ecdsa.Sign(keys.nodePriv, h[:]).Serialize(),
+		message,
+		role,
+		owner,
+	)
+}
+
+// rotate signs message with the old keys and with next, and rotates the keys
+// of the entity registered by owner for role to next.
+func (keys testEntityKeys) rotate(ctx sdk.Context, k Keeper, next testEntityKeys, message []byte, owner sdk.AccAddress, role uint8) ([]byte, []byte, error) {
+	h := sha256.Sum256(message)
+
+	return k.RotateEntityKeys(
+		ctx,
+		ed25519.Sign(keys.peerPriv, message),
+		ecdsa.Sign(keys.nodePriv, h[:]).Serialize(),
+		next.peerPub,
+		ed25519.Sign(next.peerPriv, message),
+		next.nodePriv.PubKey().SerializeCompressed(),
+		ecdsa.Sign(next.nodePriv, h[:]).Serialize(),
+		message,
+		role,
+		owner,
+	)
+}
+
+func TestRegisterEntityStoresRecord(t *testing.T) {
+	k, ctx, ica := setupKeeperWithICA(t)
+	ctx = ctx.WithBlockHeight(7)
+
+	owner := sdk.AccAddress("owner_______________")
+	keys := newTestEntityKeys(t)
+
+	did, pid, err := keys.register(ctx, k, owner, types.RoleIndexer)
+	require.NoError(t, err)
+
+	e, found := k.GetEntity(ctx, owner, types.EntityRole_ENTITY_ROLE_INDEXER)
+	require.True(t, found)
+	require.Equal(t, owner.String(), e.Owner)
+	require.Equal(t, string(did), e.Did)
+	require.Equal(t, string(pid), e.Pid)
+	require.Equal(t, []byte(keys.peerPub), e.PeerKeyPubkey)
+	require.Equal(t, int64(7), e.Height)
+	require.Equal(t, types.EntityStatus_ENTITY_STATUS_PENDING, e.Status)
+
+	gotDid, err := k.GetDidByAddress(ctx, owner)
+	require.NoError(t, err)
+	require.Equal(t, did, gotDid)
+
+	gotPid, err := k.GetPidByAddress(ctx, owner)
+	require.NoError(t, err)
+	require.Equal(t, pid, gotPid)
+
+	byDid, err := k.GetEntitiesByDid(ctx, string(did))
+	require.NoError(t, err)
+	require.Equal(t, []types.Entity{e}, byDid)
+
+	byPid, err := k.GetEntitiesByPid(ctx, string(pid))
+	require.NoError(t, err)
+	require.Equal(t, []types.Entity{e}, byPid)
+
+	// The acknowledgement activates the entity.
+	require.NoError(t, k.FlushOutbox(ctx))
+	packet := channeltypes.Packet{SourceChannel: testChannelID, Sequence: ica.sequence}
+	ack := channeltypes.NewResultAcknowledgement([]byte{})
+	require.NoError(t, k.OnAcknowledgementPacket(ctx, packet, ack.Acknowledgement()))
+
+	e, _ = k.GetEntity(ctx, owner, types.EntityRole_ENTITY_ROLE_INDEXER)
+	require.Equal(t, types.EntityStatus_ENTITY_STATUS_ACTIVE, e.Status)
+
+	var ev types.EventEntityStatusChanged
+	require.True(t, lastTypedEvent(t, ctx, &ev))
+	require.Equal(t, types.EventEntityStatusChanged{
+		Owner:  owner.String(),
+		Role:   types.EntityRole_ENTITY_ROLE_INDEXER,
+		Did:    string(did),
+		Status: types.EntityStatus_ENTITY_STATUS_ACTIVE,
+	}, ev)
+}
+
+func TestRegisterEntityTimeoutFails(t *testing.T) {
+	k, ctx, ica := setupKeeperWithICA(t)
+
+	owner := sdk.AccAddress("owner_______________")
+	_, _, err := newTestEntityKeys(t).register(ctx, k, owner, types.RoleHost)
+	require.NoError(t, err)
+	require.NoError(t, k.FlushOutbox(ctx))
+
+	// The entity stays pending while the membership is retried.
+	for range types.DefaultMaxAttempts - 1 {
+		packet := channeltypes.Packet{SourceChannel: testChannelID, Sequence: ica.sequence}
+		require.NoError(t, k.OnTimeoutPacket(ctx, packet))
+
+		e, _ := k.GetEntity(ctx, owner, types.EntityRole_ENTITY_ROLE_HOST)
+		require.Equal(t, types.EntityStatus_ENTITY_STATUS_PENDING, e.Status)
+
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + maxRetryDelay)
+		require.NoError(t, k.FlushOutbox(ctx))
+	}
+
+	packet := channeltypes.Packet{SourceChannel: testChannelID, Sequence: ica.sequence}
+	require.NoError(t, k.OnTimeoutPacket(ctx, packet))
+
+	e, _ := k.GetEntity(ctx, owner, types.EntityRole_ENTITY_ROLE_HOST)
+	require.Equal(t, types.EntityStatus_ENTITY_STATUS_FAILED, e.Status)
+}
+
+func TestRegisterEntityUniqueness(t *testing.T) {
+	k, ctx, _ := setupKeeperWithICA(t)
+
+	alice := sdk.AccAddress("alice_______________")
+	bob := sdk.AccAddress("bob_________________")
+	keys := newTestEntityKeys(t)
+
+	_, _, err := keys.register(ctx, k, alice, types.RoleIndexer)
+	require.NoError(t, err)
+
+	// Re-registering the same keys from the same address is allowed.
+	_, _, err = keys.register(ctx, k, alice, types.RoleIndexer)
+	require.NoError(t, err)
+
+	_, _, err = keys.register(ctx, k, bob, types.RoleIndexer)
+	require.ErrorContains(t, err, "DID already registered")
+
+	_, _, err = newTestEntityKeys(t).register(ctx, k, alice, types.RoleIndexer)
+	require.ErrorContains(t, err, "different DID")
+
+	// The same keys may register for the other role.
+	_, _, err = keys.register(ctx, k, alice, types.RoleHost)
+	require.NoError(t, err)
+
+	_, _, err = keys.register(ctx, k, alice, 7)
+	require.Error(t, err)
+}
+
+func TestRegisterEntityRejectsReplayedMessage(t *testing.T) {
+	k, ctx, ica := setupKeeperWithICA(t)
+	ctx = ctx.WithBlockHeight(10)
+
+	alice := sdk.AccAddress("alice_______________")
+	bob := sdk.AccAddress("bob_________________")
+	keys := newTestEntityKeys(t)
+
+	message := registrationMessage(ctx, alice, types.RoleIndexer)
+	_, _, err := keys.registerWithMessage(ctx, k, message, alice, types.RoleIndexer)
+	require.NoError(t, err)
+
+	// The same signed message cannot be submitted twice.
+	_, _, err = keys.registerWithMessage(ctx, k, message, alice, types.RoleIndexer)
+	require.ErrorContains(t, err, "already used")
+
+	// A message signed for one account, role or network is rejected elsewhere.
+	_, _, err = keys.registerWithMessage(ctx, k, message, bob, types.RoleIndexer)
+	require.ErrorContains(t, err, "for address")
+
+	_, _, err = keys.registerWithMessage(ctx, k, registrationMessage(ctx, alice, types.RoleIndexer), alice, types.RoleHost)
+	require.ErrorContains(t, err, "for role")
+
+	_, _, err = keys.registerWithMessage(ctx.WithChainID("other-1"), k, registrationMessage(ctx, alice, types.RoleHost), alice, types.RoleHost)
+	require.ErrorContains(t, err, "for chain")
+
+	expired := types.NewRegistrationMessage(testChainID, alice, types.EntityRole_ENTITY_ROLE_HOST, 1, 9)
+	_, _, err = keys.registerWithMessage(ctx, k, expired.GetSignBytes(), alice, types.RoleHost)
+	require.ErrorContains(t, err, "expired")
+
+	// A message cannot stay valid for longer than the registration window.
+	distant := types.NewRegistrationMessage(testChainID, alice, types.EntityRole_ENTITY_ROLE_HOST, 1, ctx.BlockHeight()+int64(types.DefaultMaxRegistrationWindow)+1)
+	_, _, err = keys.registerWithMessage(ctx, k, distant.GetSignBytes(), alice, types.RoleHost)
+	require.ErrorContains(t, err, "more than 14400 blocks")
+
+	wrongDomain := types.NewRegistrationMessage(testChainID, alice, types.EntityRole_ENTITY_ROLE_HOST, 1, 20)
+	wrongDomain.Domain = "other"
+	_, _, err = keys.registerWithMessage(ctx, k, wrongDomain.GetSignBytes(), alice, types.RoleHost)
+	require.ErrorContains(t, err, "domain")
+
+	_, _, err = keys.registerWithMessage(ctx, k, []byte("shinzo registration"), alice, types.RoleHost)
+	require.Error(t, err)
+
+	require.NoError(t, k.FlushOutbox(ctx))
+	require.Len(t, ica.sent, 1)
+
+	gs := k.ExportGenesis(ctx)
+	require.Equal(t, []types.UsedRegistrationMessage{{
+		Hash:         types.RegistrationMessageHash(message),
+		ExpiryHeight: 110,
+	}}, gs.UsedRegistrationMessages)
+	require.NoError(t, gs.Validate())
+
+	k2, ctx2, _ := setupKeeperWithICA(t)
+	k2.InitGenesis(ctx2, *gs)
+	_, _, err = keys.registerWithMessage(ctx2.WithBlockHeight(10), k2, message, alice, types.RoleIndexer)
+	require.ErrorContains(t, err, "already used")
+}
+
+func TestPruneUsedRegistrations(t *testing.T) {
+	k, ctx, _ := setupKeeperWithICA(t)
+	ctx = ctx.WithBlockHeight(10)
+
+	alice := sdk.AccAddress("alice_______________")
+	message := registrationMessage(ctx, alice, types.RoleIndexer)
+	_, _, err := newTestEntityKeys(t).registerWithMessage(ctx, k, message, alice, types.RoleIndexer)
+	require.NoError(t, err)
+
+	// The message is remembered up to its expiry height.
+	require.NoError(t, k.PruneUsedRegistrations(ctx.WithBlockHeight(110)))
+	require.Len(t, k.ExportGenesis(ctx).UsedRegistrationMessages, 1)
+
+	// Past it, a replay is rejected as expired and the hash is forgotten.
+	ctx = ctx.WithBlockHeight(111)
+	require.NoError(t, k.PruneUsedRegistrations(ctx))
+	require.Empty(t, k.ExportGenesis(ctx).UsedRegistrationMessages)
+
+	_, _, err = newTestEntityKeys(t).registerWithMessage(ctx, k, message, alice, types.RoleIndexer)
+	require.ErrorContains(t, err, "expired")
+}
+
+func TestGetDidByAddressNotFound(t *testing.T) {
+	k, ctx, _ := setupKeeperWithICA(t)
+
+	_, err := k.GetDidByAddress(ctx, sdk.AccAddress("nobody______________"))
+	require.Error(t, err)
+
+	_, err = k.GetPidByAddress(ctx, sdk.AccAddress("nobody______________"))
+	require.Error(t, err)
+}
+
+func TestQueryDidByAddress(t *testing.T) {
+	k, ctx, _ := setupKeeperWithICA(t)
+	q := NewQueryServerImpl(k)
+
+	owner := sdk.AccAddress("owner_______________")
+	did, pid, err := newTestEntityKeys(t).register(ctx, k, owner, types.RoleIndexer)
+	require.NoError(t, err)
+
+	didRes, err := q.DidByAddress(ctx, &types.QueryDidByAddressRequest{Address: owner.String()})
+	require.NoError(t, err)
+	require.Equal(t, string(did), didRes.Did)
+
+	pidRes, err := q.PidByAddress(ctx, &types.QueryPidByAddressRequest{Address: owner.String()})
+	require.NoError(t, err)
+	require.Equal(t, string(pid), pidRes.Pid)
+
+	entities, err := q.Entities(ctx, &types.QueryEntitiesRequest{FilterRole: true, Role: types.EntityRole_ENTITY_ROLE_HOST})
+	require.NoError(t, err)
+	require.Empty(t, entities.Entities)
+
+	gs := k.ExportGenesis(ctx)
+	require.Len(t, gs.Entities, 1)
+	require.NoError(t, gs.Validate())
+}
+
+func TestUnregisterEntity(t *testing.T) {
+	k, ctx, ica := setupKeeperWithICA(t)
+
+	owner := sdk.AccAddress("owner_______________")
+	did, pid, err := newTestEntityKeys(t).register(ctx, k, owner, types.RoleIndexer)
+	require.NoError(t, err)
+	require.NoError(t, k.FlushOutbox(ctx))
+
+	_, err = k.UnregisterEntity(ctx, types.RoleHost, owner)
+	require.Error(t, err)
+
+	removed, err := k.UnregisterEntity(ctx, types.RoleIndexer, owner)
+	require.NoError(t, err)
+	require.Equal(t, did, removed)
+
+	require.NoError(t, k.FlushOutbox(ctx))
+	require.Len(t, ica.sent, 2)
+	rel := decodePolicyCmd(t, ica.sent[1].Data).Cmd.GetDeleteRelationshipCmd().Relationship
+	require.Equal(t, "indexer", rel.Object.Id)
+	require.Equal(t, string(did), rel.Subject.GetActor().Id)
+
+	p, found := k.GetIcaPacket(ctx, testChannelID, ica.sequence)
+	require.True(t, found)
+	require.Equal(t, types.PacketKind_PACKET_KIND_UNREGISTER_ENTITY, p.Kind)
+
+	_, found = k.GetEntity(ctx, owner, types.EntityRole_ENTITY_ROLE_INDEXER)
+	require.False(t, found)
+
+	byDid, err := k.GetEntitiesByDid(ctx, string(did))
+	require.NoError(t, err)
+	require.Empty(t, byDid)
+
+	byPid, err := k.GetEntitiesByPid(ctx, string(pid))
+	require.NoError(t, err)
+	require.Empty(t, byPid)
+}
+
+func TestRotateEntityKeys(t *testing.T) {
+	k, ctx, ica := setupKeeperWithICA(t)
+
+	owner := sdk.AccAddress("owner_______________")
+	keys := newTestEntityKeys(t)
+	oldDid, oldPid, err := keys.register(ctx, k, owner, types.RoleIndexer)
+	require.NoError(t, err)
+	require.NoError(t, k.FlushOutbox(ctx))
+
+	next := newTestEntityKeys(t)
+	ctx = ctx.WithBlockHeight(9)
+	did, pid, err := keys.rotate(ctx, k, next, registrationMessage(ctx, owner, types.RoleIndexer), owner, types.RoleIndexer)
+	require.NoError(t, err)
+	require.NotEqual(t, oldDid, did)
+	require.NotEqual(t, oldPid, pid)
+
+	e, found := k.GetEntity(ctx, owner, types.EntityRole_ENTITY_ROLE_INDEXER)
+	require.True(t, found)
+	require.Equal(t, string(did), e.Did)
+	require.Equal(t, string(pid), e.Pid)
+	require.Equal(t, []byte(next.peerPub), e.PeerKeyPubkey)
+	require.Equal(t, int64(9), e.Height)
+	require.Equal(t, types.EntityStatus_ENTITY_STATUS_PENDING, e.Status)
+
+	byDid, err := k.GetEntitiesByDid(ctx, string(oldDid))
+	require.NoError(t, err)
+	require.Empty(t, byDid)
+
+	// The old membership is deleted and the new one set in one transaction.
+	require.NoError(t, k.FlushOutbox(ctx))
+	require.Len(t, ica.sent, 2)
+	var tx icatypes.CosmosTx
+	require.NoError(t, gogoproto.Unmarshal(ica.sent[1].Data, &tx))
+	require.Len(t, tx.Messages, 2)
+
+	var deleteCmd, setCmd acptypes.MsgDirectPolicyCmd
+	require.NoError(t, gogoproto.Unmarshal(tx.Messages[0].Value, &deleteCmd))
+	require.NoError(t, gogoproto.Unmarshal(tx.Messages[1].Value, &setCmd))
+	require.Equal(t, string(oldDid), deleteCmd.Cmd.GetDeleteRelationshipCmd().Relationship.Subject.GetActor().Id)
+	require.Equal(t, string(did), setCmd.Cmd.GetSetRelationshipCmd().Relationship.Subject.GetActor().Id)
+
+	packet := channeltypes.Packet{SourceChannel: testChannelID, Sequence: ica.sequence}
+	ack := channeltypes.NewResultAcknowledgement([]byte{})
+	require.NoError(t, k.OnAcknowledgementPacket(ctx, packet, ack.Acknowledgement()))
+
+	e, _ = k.GetEntity(ctx, owner, types.EntityRole_ENTITY_ROLE_INDEXER)
+	require.Equal(t, types.EntityStatus_ENTITY_STATUS_ACTIVE, e.Status)
+
+	// The old keys no longer prove ownership.
+	_, _, err = keys.rotate(ctx, k, newTestEntityKeys(t), registrationMessage(ctx, owner, types.RoleIndexer), owner, types.RoleIndexer)
+	require.ErrorContains(t, err, "old peer key")
+}
+
+func TestRotateEntityKeysIgnoresEarlierCommands(t *testing.T) {
+	k, ctx, ica := setupKeeperWithICA(t)
+
+	owner := sdk.AccAddress("owner_______________")
+	keys := newTestEntityKeys(t)
+	_, _, err := keys.register(ctx, k, owner, types.RoleIndexer)
+	require.NoError(t, err)
+	require.NoError(t, k.FlushOutbox(ctx))
+	registration := channeltypes.Packet{SourceChannel: testChannelID, Sequence: ica.sequence}
+
+	// The keys are rotated while the registration is still in flight.
+	_, _, err = keys.rotate(ctx, k, newTestEntityKeys(t), registrationMessage(ctx, owner, types.RoleIndexer), owner, types.RoleIndexer)
+	require.NoError(t, err)
+	require.NoError(t, k.FlushOutbox(ctx))
+	rotation := channeltypes.Packet{SourceChannel: testChannelID, Sequence: ica.sequence}
+
+	require.NoError(t, k.OnAcknowledgementPacket(ctx, rotation, msgResponsesAck(t, 2)))
+	e, _ := k.GetEntity(ctx, owner, types.EntityRole_ENTITY_ROLE_INDEXER)
+	require.Equal(t, types.EntityStatus_ENTITY_STATUS_ACTIVE, e.Status)
+
+	// The late failure of the registration leaves the rotated entity alone.
+	ack := channeltypes.NewErrorAcknowledgement(errors.New("rejected"))
+	require.NoError(t, k.OnAcknowledgementPacket(ctx, registration, ack.Acknowledgement()))
+	e, _ = k.GetEntity(ctx, owner, types.EntityRole_ENTITY_ROLE_INDEXER)
+	require.Equal(t, types.EntityStatus_ENTITY_STATUS_ACTIVE, e.Status)
+}
+
+func TestRotateEntityKeysRejectsInvalidProofs(t *testing.T) {
+	k, ctx, ica := setupKeeperWithICA(t)
+
+	owner := sdk.AccAddress("owner_______________")
+	keys := newTestEntityKeys(t)
+	message := registrationMessage(ctx, owner, types.RoleHost)
+	_, _, err := keys.registerWithMessage(ctx, k, message, owner, types.RoleHost)
+	require.NoError(t, err)
+
+	_, _, err = keys.rotate(ctx, k, newTestEntityKeys(t), message, owner, types.RoleHost)
+	require.ErrorContains(t, err, "already used")
+
+	_, _, err = newTestEntityKeys(t).rotate(ctx, k, newTestEntityKeys(t), registrationMessage(ctx, owner, types.RoleHost), owner, types.RoleHost)
+	require.ErrorContains(t, err, "old peer key")
+
+	_, _, err = keys.rotate(ctx, k, newTestEntityKeys(t), registrationMessage(ctx, owner, types.RoleIndexer), owner, types.RoleIndexer)
+	require.Error(t, err)
+	require.NoError(t, k.FlushOutbox(ctx))
+	require.Len(t, ica.sent, 1)
+
+	// Entities migrated from the legacy layout have no keys to prove a rotation with.
+	legacy := sdk.AccAddress("legacy______________")
+	require.NoError(t, k.SetEntity(ctx, types.Entity{
+		Owner:  legacy.String(),
+		Role:   types.EntityRole_ENTITY_ROLE_HOST,
+		Did:    "did:key:zQ3legacy",
+		Status: types.EntityStatus_ENTITY_STATUS_ACTIVE,
+	}))
+	_, _, err = keys.rotate(ctx, k, newTestEntityKeys(t), registrationMessage(ctx, legacy, types.RoleHost), legacy, types.RoleHost)
+	require.ErrorContains(t, err, "no recorded keys")
+}
+
+func TestMigrate1to2(t *testing.T) {
+	k, ctx, _ := setupKeeper(t)
+
+	owner := sdk.AccAddress("owner:with:colons___")
+	did := "did:key:zQ3legacy"
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	addrKey := append(append([]byte(types.AddrRolePrefix), owner...), []byte(":1")...)
+	didKey := append(append([]byte(types.DIDRolePrefix), did...), []byte(":1")...)
+	store.Set(addrKey, []byte(did))
+	store.Set(didKey, owner)
+
+	require.NoError(t, NewMigrator(k).Migrate1to2(ctx))
+
+	e, found := k.GetEntity(ctx, owner, types.EntityRole_ENTITY_ROLE_HOST)
+	require.True(t, found)
+	require.Equal(t, did, e.Did)
+	require.Equal(t, types.EntityStatus_ENTITY_STATUS_ACTIVE, e.Status)
+
+	got, err := k.EntitiesByDid.Get(ctx, collections.Join(did, int32(types.EntityRole_ENTITY_ROLE_HOST)))
+	require.NoError(t, err)
+	require.Equal(t, owner, got)
+
+	require.False(t, store.Has(addrKey))
+	require.False(t, store.Has(didKey))
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"cosmossdk.io/collections"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/shinzonetwork/shinzohub/x/sourcehub/types"
 )
@@ -10,26 +11,130 @@ func (k Keeper) InitGenesis(ctx sdk.Context, gs types.GenesisState) {
 		panic(err)
 	}
 
-	// Set all ICA metadata fields
-	k.SetControllerConnectionID(ctx, gs.ControllerConnectionId)
-	k.SetHostConnectionID(ctx, gs.HostConnectionId)
-	k.SetVersion(ctx, gs.Version)
-	k.SetEncoding(ctx, gs.Encoding)
-	k.SetTxType(ctx, gs.TxType)
-	k.SetPolicyId(ctx, gs.PolicyId)
+	targets, defaultTarget := gs.GetTargetsOrLegacy()
+	for _, t := range targets {
+		if err := k.SetTarget(ctx, t); err != nil {
+			panic(err)
+		}
+	}
+	if defaultTarget != "" {
+		if err := k.DefaultTarget.Set(ctx, defaultTarget); err != nil {
+			panic(err)
+		}
+	}
 
 	k.SetParams(ctx, gs.Params)
+
+	for _, v := range gs.PolicyVersions {
+		if err := k.PolicyVersions.Set(ctx, v.Version, v); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, g := range gs.StreamGrants {
+		if err := k.SetGrant(ctx, g); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, p := range gs.StreamPrices {
+		if err := k.StreamPrices.Set(ctx, streamPriceKey(p.Resource, p.StreamId), p); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, p := range gs.StreamPayments {
+		if err := k.StreamPayments.Set(ctx, p.CommandId, p); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, r := range gs.StreamRevenues {
+		if err := k.StreamRevenues.Set(ctx, streamPriceKey(r.Resource, r.StreamId), r); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, r := range gs.PendingRewards {
+		if err := k.PendingRewards.Set(ctx, sdk.MustAccAddressFromBech32(r.Address), r); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, u := range gs.EntityUnbondings {
+		key, err := unbondingKey(u)
+		if err != nil {
+			panic(err)
+		}
+		if err := k.EntityUnbondings.Set(ctx, key, u); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, h := range gs.RoleHolders {
+		if err := k.GrantRole(ctx, h.Role, h.Address); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, e := range gs.Entities {
+		if err := k.SetEntity(ctx, e); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, v := range gs.Views {
+		if err := k.Views.Set(ctx, v.Id, v); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, r := range gs.Relationships {
+		if err := k.SetRelationship(ctx, r); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, p := range gs.IcaPackets {
+		if err := k.IcaPackets.Set(ctx, collections.Join(p.ChannelId, p.Sequence), p); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, u := range gs.UsedRegistrationMessages {
+		if err := k.UsedRegistrations.Set(ctx, collections.Join(u.ExpiryHeight, u.Hash)); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, c := range gs.IcaCommands {
+		if err := k.SetIcaCommand(ctx, c); err != nil {
+			panic(err)
+		}
+	}
+
+	if err := k.NextCommandId.Set(ctx, gs.NextCommandId); err != nil {
+		panic(err)
+	}
+
+	for _, id := range gs.DeadLetters {
+		if err := k.DeadLetters.Set(ctx, id); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, c := range gs.EntityCommands {
+		owner := sdk.MustAccAddressFromBech32(c.Owner)
+		if err := k.EntityCommands.Set(ctx, c.CommandId, entityKey(owner, c.Role)); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // ExportGenesis returns the module's exported genesis state.
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	genesis := types.GenesisState{
-		ControllerConnectionId: k.GetControllerConnectionID(ctx),
-		HostConnectionId:       k.GetHostConnectionID(ctx),
-		Version:                k.GetVersion(ctx),
-		Encoding:               k.GetEncoding(ctx),
-		TxType:                 k.GetTxType(ctx),
-		PolicyId:               k.GetPolicyId(ctx),
+		DefaultTarget: k.GetDefaultTarget(ctx),
 	}
 
 	p, err := k.GetParams(ctx)
@@ -40,5 +145,156 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 
 	genesis.Params = p
 
+	err = k.PolicyVersions.Walk(ctx, nil, func(_ uint64, v types.PolicyVersion) (bool, error) {
+		genesis.PolicyVersions = append(genesis.PolicyVersions, v)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	err = k.Grants.Walk(ctx, nil, func(_ collections.Quad[string, int32, string, string], g types.StreamGrant) (bool, error) {
+		genesis.StreamGrants = append(genesis.StreamGrants, g)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	err = k.StreamPrices.Walk(ctx, nil, func(_ collections.Pair[int32, string], p types.StreamPrice) (bool, error) {
+		genesis.StreamPrices = append(genesis.StreamPrices, p)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	err = k.StreamPayments.Walk(ctx, nil, func(_ uint64, p types.StreamPayment) (bool, error) {
+		genesis.StreamPayments = append(genesis.StreamPayments, p)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	err = k.StreamRevenues.Walk(ctx, nil, func(_ collections.Pair[int32, string], r types.StreamRevenue) (bool, error) {
+		genesis.StreamRevenues = append(genesis.StreamRevenues, r)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	err = k.PendingRewards.Walk(ctx, nil, func(_ sdk.AccAddress, r types.PendingReward) (bool, error) {
+		genesis.PendingRewards = append(genesis.PendingRewards, r)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	err = k.EntityUnbondings.Walk(ctx, nil, func(_ collections.Triple[uint64, sdk.AccAddress, int32], u types.EntityUnbonding) (bool, error) {
+		genesis.EntityUnbondings = append(genesis.EntityUnbondings, u)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	err = k.RoleHolders.Walk(ctx, nil, func(key collections.Pair[int32, string]) (bool, error) {
+		genesis.RoleHolders = append(genesis.RoleHolders, types.RoleHolder{
+			Role:    types.ModuleRole(key.K1()),
+			Address: key.K2(),
+		})
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	err = k.Entities.Walk(ctx, nil, func(_ collections.Pair[sdk.AccAddress, int32], e types.Entity) (bool, error) {
+		genesis.Entities = append(genesis.Entities, e)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	err = k.Views.Walk(ctx, nil, func(_ string, v types.View) (bool, error) {
+		genesis.Views = append(genesis.Views, v)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	err = k.Relationships.Walk(ctx, nil, func(_ collections.Quad[string, string, string, string], r types.Relationship) (bool, error) {
+		genesis.Relationships = append(genesis.Relationships, r)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	err = k.IcaPackets.Walk(ctx, nil, func(_ collections.Pair[string, uint64], p types.IcaPacket) (bool, error) {
+		genesis.IcaPackets = append(genesis.IcaPackets, p)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	err = k.IcaCommands.Walk(ctx, nil, func(_ uint64, c types.IcaCommand) (bool, error) {
+		genesis.IcaCommands = append(genesis.IcaCommands, c)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	genesis.NextCommandId, err = k.NextCommandId.Peek(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	err = k.DeadLetters.Walk(ctx, nil, func(id uint64) (bool, error) {
+		genesis.DeadLetters = append(genesis.DeadLetters, id)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	err = k.Targets.Walk(ctx, nil, func(_ string, t types.SourcehubTarget) (bool, error) {
+		genesis.Targets = append(genesis.Targets, t)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	err = k.EntityCommands.Walk(ctx, nil, func(id uint64, e collections.Pair[sdk.AccAddress, int32]) (bool, error) {
+		genesis.EntityCommands = append(genesis.EntityCommands, types.EntityCommand{
+			CommandId: id,
+			Owner:     e.K1().String(),
+			Role:      types.EntityRole(e.K2()),
+		})
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	err = k.UsedRegistrations.Walk(ctx, nil, func(key collections.Pair[int64, []byte]) (bool, error) {
+		genesis.UsedRegistrationMessages = append(genesis.UsedRegistrationMessages, types.UsedRegistrationMessage{
+			Hash:         key.K2(),
+			ExpiryHeight: key.K1(),
+		})
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
 	return &genesis
 }
@@ -0,0 +1,290 @@
+package keeper
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/shinzonetwork/shinzohub/x/sourcehub/types"
+)
+
+func TestGenesisRoundTripRegistry(t *testing.T) {
+	k, ctx, ica := setupKeeperWithICA(t)
+	ctx = ctx.WithBlockHeight(3)
+
+	indexer := sdk.AccAddress("indexer_____________")
+	host := sdk.AccAddress("host________________")
+	creator := sdk.AccAddress("creator_____________")
+
+	did, _, err := newTestEntityKeys(t).register(ctx, k, indexer, types.RoleIndexer)
+	require.NoError(t, err)
+	_, _, err = newTestEntityKeys(t).register(ctx, k, host, types.RoleHost)
+	require.NoError(t, err)
+	registerPrimitives(t, k, ctx, "Log")
+	require.NoError(t, k.RegisterObject(ctx, "", "Logs_0x01", creator, "Log {address topics}"))
+
+	// One command stays queued across the export.
+	require.NoError(t, k.FlushOutbox(ctx))
+	require.Equal(t, uint64(1), ica.sequence)
+	_, _, err = newTestEntityKeys(t).register(ctx, k, sdk.AccAddress("late________________"), types.RoleHost)
+	require.NoError(t, err)
+
+	gs := k.ExportGenesis(ctx)
+	require.NoError(t, gs.Validate())
+	require.Len(t, gs.Entities, 3)
+	require.Equal(t, []types.View{{Id: "Logs_0x01", Creator: creator.String(), Height: 3, Target: testTarget}}, gs.Views)
+	require.Len(t, gs.IcaPackets, 1)
+	require.Len(t, gs.IcaCommands, 4)
+	require.Equal(t, uint64(4), gs.NextCommandId)
+	require.Len(t, gs.EntityCommands, 3)
+	require.NotEmpty(t, gs.Relationships)
+
+	k2, ctx2, _ := setupKeeper(t)
+	k2.InitGenesis(ctx2, *gs)
+	require.Equal(t, gs, k2.ExportGenesis(ctx2))
+
+	gotDid, err := k2.GetDidByAddress(ctx2, indexer)
+	require.NoError(t, err)
+	require.Equal(t, did, gotDid)
+
+	v, err := k2.Views.Get(ctx2, "Logs_0x01")
+	require.NoError(t, err)
+	require.Equal(t, creator.String(), v.Creator)
+
+	owner, found := k2.GetRelationship(ctx2, "", types.ViewResourceName, "Logs_0x01", "owner", testIcaAddress)
+	require.True(t, found)
+	require.Equal(t, types.RelationshipStatus_RELATIONSHIP_STATUS_PENDING, owner.Status)
+
+	// Acknowledgements of packets sent before the export still resolve the entities.
+	entityKey, err := k2.EntityCommands.Get(ctx2, 0)
+	require.NoError(t, err)
+	require.Equal(t, indexer, entityKey.K1())
+
+	queued, err := k2.Outbox.Has(ctx2, 3)
+	require.NoError(t, err)
+	require.True(t, queued)
+}
+
+func TestGenesisValidateRegistry(t *testing.T) {
+	alice := sdk.AccAddress("alice_______________").String()
+	bob := sdk.AccAddress("bob_________________").String()
+
+	valid := func() *types.GenesisState {
+		gs := types.DefaultGenesis()
+		gs.Targets = []types.SourcehubTarget{types.NewSourcehubTarget(testTarget, "connection-0", "connection-0")}
+		gs.DefaultTarget = testTarget
+		gs.PolicyVersions = []types.PolicyVersion{{Version: 1, Hash: policyHash(policy), Policy: policy, Authority: alice}}
+		gs.Targets[0].PolicyVersion = 1
+		gs.Entities = []types.Entity{
+			{Owner: alice, Role: types.EntityRole_ENTITY_ROLE_INDEXER, Did: "did:key:alice", Pid: "alice-pid"},
+			{Owner: alice, Role: types.EntityRole_ENTITY_ROLE_HOST, Did: "did:key:alice", Pid: "alice-pid"},
+			{Owner: bob, Role: types.EntityRole_ENTITY_ROLE_INDEXER, Did: "did:key:bob", Pid: "bob-pid"},
+		}
+		gs.Views = []types.View{{Id: "Logs_0x01", Creator: bob}}
+		gs.Relationships = []types.Relationship{
+			{Resource: types.ViewResourceName, ObjectId: "Logs_0x01", Relation: "owner", Actor: "did:key:bob", Status: types.RelationshipStatus_RELATIONSHIP_STATUS_CONFIRMED},
+		}
+		gs.IcaCommands = []types.IcaCommand{
+			{Id: 0, Attempts: 5, Status: types.PacketStatus_PACKET_STATUS_TIMED_OUT},
+			{Id: 1, ChannelId: testChannelID, Sequence: 1, Status: types.PacketStatus_PACKET_STATUS_PENDING},
+			{Id: 2, Status: types.PacketStatus_PACKET_STATUS_QUEUED},
+			{Id: 3, Status: types.PacketStatus_PACKET_STATUS_QUEUED},
+		}
+		gs.NextCommandId = 4
+		gs.DeadLetters = []uint64{0}
+		gs.IcaPackets = []types.IcaPacket{{ChannelId: testChannelID, Sequence: 1, Status: types.PacketStatus_PACKET_STATUS_PENDING, CommandIds: []uint64{1}}}
+		gs.EntityCommands = []types.EntityCommand{
+			{CommandId: 1, Owner: bob, Role: types.EntityRole_ENTITY_ROLE_INDEXER},
+			{CommandId: 2, Owner: alice, Role: types.EntityRole_ENTITY_ROLE_HOST},
+		}
+		gs.StreamPrices = []types.StreamPrice{
+			{Resource: types.Resource_RESOURCE_VIEW, StreamId: "Logs_0x01", Amount: sdk.NewCoins(sdk.NewInt64Coin("ushinzo", 100)), Setter: bob},
+		}
+		gs.StreamPayments = []types.StreamPayment{
+			{CommandId: 3, Payer: alice, Resource: types.Resource_RESOURCE_VIEW, StreamId: "Logs_0x01", Did: "did:key:alice", Amount: sdk.NewCoins(sdk.NewInt64Coin("ushinzo", 100))},
+		}
+		gs.StreamRevenues = []types.StreamRevenue{
+			{Resource: types.Resource_RESOURCE_VIEW, StreamId: "Logs_0x01", Amount: sdk.NewCoins(sdk.NewInt64Coin("ushinzo", 200))},
+		}
+		gs.PendingRewards = []types.PendingReward{
+			{Address: bob, Amount: sdk.NewCoins(sdk.NewInt64Coin("ushinzo", 40))},
+		}
+		gs.EntityUnbondings = []types.EntityUnbonding{
+			{Owner: bob, Role: types.EntityRole_ENTITY_ROLE_HOST, Did: "did:key:bob-host", Amount: sdk.NewCoins(sdk.NewInt64Coin("ushinzo", 1000)), CompletionTime: 100},
+		}
+		return gs
+	}
+	require.NoError(t, valid().Validate())
+
+	for name, mutate := range map[string]func(gs *types.GenesisState){
+		"one address with two DIDs": func(gs *types.GenesisState) {
+			gs.Entities = append(gs.Entities, types.Entity{Owner: bob, Role: types.EntityRole_ENTITY_ROLE_INDEXER, Did: "did:key:other"})
+		},
+		"one DID with two addresses": func(gs *types.GenesisState) {
+			gs.Entities[2].Did = "did:key:alice"
+		},
+		"one PID with two addresses": func(gs *types.GenesisState) {
+			gs.Entities[2].Pid = "alice-pid"
+		},
+		"duplicate view": func(gs *types.GenesisState) {
+			gs.Views = append(gs.Views, gs.Views[0])
+		},
+		"view without creator": func(gs *types.GenesisState) {
+			gs.Views[0].Creator = ""
+		},
+		"embedded policy version": func(gs *types.GenesisState) {
+			gs.PolicyVersions[0].Version = 0
+		},
+		"duplicate policy version": func(gs *types.GenesisState) {
+			gs.PolicyVersions = append(gs.PolicyVersions, gs.PolicyVersions[0])
+		},
+		"policy version with wrong hash": func(gs *types.GenesisState) {
+			gs.PolicyVersions[0].Policy = "name: other"
+		},
+		"target on unknown policy version": func(gs *types.GenesisState) {
+			gs.Targets[0].PolicyVersion = 2
+		},
+		"duplicate relationship": func(gs *types.GenesisState) {
+			gs.Relationships = append(gs.Relationships, gs.Relationships[0])
+		},
+		"relationship without actor": func(gs *types.GenesisState) {
+			gs.Relationships[0].Actor = ""
+		},
+		"relationship without status": func(gs *types.GenesisState) {
+			gs.Relationships[0].Status = types.RelationshipStatus_RELATIONSHIP_STATUS_UNSPECIFIED
+		},
+		"relationship on unknown target": func(gs *types.GenesisState) {
+			gs.Relationships[0].Target = "other"
+		},
+		"duplicate ICA packet": func(gs *types.GenesisState) {
+			gs.IcaPackets = append(gs.IcaPackets, gs.IcaPackets[0])
+		},
+		"duplicate ICA command": func(gs *types.GenesisState) {
+			gs.IcaCommands = append(gs.IcaCommands, gs.IcaCommands[0])
+		},
+		"ICA command at the next command ID": func(gs *types.GenesisState) {
+			gs.NextCommandId = 2
+		},
+		"pending ICA command without packet": func(gs *types.GenesisState) {
+			gs.IcaCommands[1].Sequence = 2
+		},
+		"pending ICA command not carried by its packet": func(gs *types.GenesisState) {
+			gs.IcaPackets[0].CommandIds = []uint64{2}
+		},
+		"open ICA channel without ID": func(gs *types.GenesisState) {
+			gs.Targets[0].Channel = types.IcaChannel{State: types.IcaChannelState_ICA_CHANNEL_STATE_OPEN}
+		},
+		"duplicate target": func(gs *types.GenesisState) {
+			gs.Targets = append(gs.Targets, gs.Targets[0])
+		},
+		"target without connection": func(gs *types.GenesisState) {
+			gs.Targets[0].ControllerConnectionId = ""
+		},
+		"unknown default target": func(gs *types.GenesisState) {
+			gs.DefaultTarget = "other"
+		},
+		"targets without default": func(gs *types.GenesisState) {
+			gs.DefaultTarget = ""
+		},
+		"view on unknown target": func(gs *types.GenesisState) {
+			gs.Views[0].Target = "other"
+		},
+		"ICA command for unknown target": func(gs *types.GenesisState) {
+			gs.IcaCommands[2].Target = "other"
+		},
+		"duplicate dead letter": func(gs *types.GenesisState) {
+			gs.DeadLetters = []uint64{0, 0}
+		},
+		"dead letter without ICA command": func(gs *types.GenesisState) {
+			gs.DeadLetters = []uint64{7}
+		},
+		"dead letter for queued ICA command": func(gs *types.GenesisState) {
+			gs.DeadLetters = []uint64{2}
+		},
+		"entity command without ICA command": func(gs *types.GenesisState) {
+			gs.EntityCommands[0].CommandId = 7
+		},
+		"entity command for resolved ICA command": func(gs *types.GenesisState) {
+			gs.IcaCommands[1].Status = types.PacketStatus_PACKET_STATUS_ACKNOWLEDGED
+		},
+		"entity command for unknown entity": func(gs *types.GenesisState) {
+			gs.EntityCommands[0].Role = types.EntityRole_ENTITY_ROLE_HOST
+		},
+		"duplicate stream price": func(gs *types.GenesisState) {
+			gs.StreamPrices = append(gs.StreamPrices, gs.StreamPrices[0])
+		},
+		"stream price without amount": func(gs *types.GenesisState) {
+			gs.StreamPrices[0].Amount = nil
+		},
+		"stream price with invalid setter": func(gs *types.GenesisState) {
+			gs.StreamPrices[0].Setter = "bob"
+		},
+		"duplicate stream payment": func(gs *types.GenesisState) {
+			gs.StreamPayments = append(gs.StreamPayments, gs.StreamPayments[0])
+		},
+		"stream payment without DID": func(gs *types.GenesisState) {
+			gs.StreamPayments[0].Did = ""
+		},
+		"stream payment for acknowledged ICA command": func(gs *types.GenesisState) {
+			gs.IcaCommands[3].Status = types.PacketStatus_PACKET_STATUS_ACKNOWLEDGED
+		},
+		"duplicate stream revenue": func(gs *types.GenesisState) {
+			gs.StreamRevenues = append(gs.StreamRevenues, gs.StreamRevenues[0])
+		},
+		"stream revenue without amount": func(gs *types.GenesisState) {
+			gs.StreamRevenues[0].Amount = nil
+		},
+		"duplicate pending reward": func(gs *types.GenesisState) {
+			gs.PendingRewards = append(gs.PendingRewards, gs.PendingRewards[0])
+		},
+		"pending reward with invalid address": func(gs *types.GenesisState) {
+			gs.PendingRewards[0].Address = "bob"
+		},
+		"duplicate entity unbonding": func(gs *types.GenesisState) {
+			gs.EntityUnbondings = append(gs.EntityUnbondings, gs.EntityUnbondings[0])
+		},
+		"entity unbonding without amount": func(gs *types.GenesisState) {
+			gs.EntityUnbondings[0].Amount = nil
+		},
+		"entity unbonding with invalid role": func(gs *types.GenesisState) {
+			gs.EntityUnbondings[0].Role = 7
+		},
+		"slash fraction above one": func(gs *types.GenesisState) {
+			gs.Params.HostBond.SlashFraction = math.LegacyNewDec(2)
+		},
+		"revenue shares not summing to 10000": func(gs *types.GenesisState) {
+			gs.Params.RevenueShares = types.RevenueShares{Creator: 5000, Hosts: 4000}
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gs := valid()
+			mutate(gs)
+			require.Error(t, gs.Validate())
+		})
+	}
+}
+
+func TestGenesisImportsLegacyLink(t *testing.T) {
+	k, ctx, _ := setupKeeper(t)
+
+	gs := types.DefaultGenesis()
+	gs.ControllerConnectionId = "connection-0"
+	gs.HostConnectionId = "connection-1"
+	gs.Version = types.DefaultIcaVersion
+	gs.Encoding = types.DefaultIcaEncoding
+	gs.TxType = types.DefaultIcaTxType
+	gs.PolicyId = "policy-1"
+	k.InitGenesis(ctx, *gs)
+
+	require.Equal(t, types.LegacyTargetName, k.GetDefaultTarget(ctx))
+	target, found := k.GetTarget(ctx, types.LegacyTargetName)
+	require.True(t, found)
+	require.Equal(t, "connection-1", target.HostConnectionId)
+	require.Equal(t, "policy-1", target.PolicyId)
+
+	exported := k.ExportGenesis(ctx)
+	require.Empty(t, exported.ControllerConnectionId)
+	require.Equal(t, []types.SourcehubTarget{target}, exported.Targets)
+}
@@ -0,0 +1,317 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	coretypes "github.com/sourcenetwork/acp_core/pkg/types"
+	acptypes "github.com/sourcenetwork/sourcehub/x/acp/types"
+
+	"github.com/shinzonetwork/shinzohub/x/sourcehub/types"
+)
+
+// subscriberRelation is the policy relation granting read access to a stream.
+const subscriberRelation = "subscriber"
+
+// maxRevocationsPerBlock bounds the number of expired grants visited in a
+// single EndBlocker, the rest are picked up in the following blocks.
+const maxRevocationsPerBlock = 100
+
+// grantExpiryKey is the key of a grant in GrantsByExpiration.
+type grantExpiryKey = collections.Pair[uint64, collections.Quad[string, int32, string, string]]
+
+var grantKeyCodec = collections.QuadKeyCodec(collections.StringKey, collections.Int32Key, collections.StringKey, collections.StringKey)
+
+func grantKey(target string, resource types.Resource, streamId, did string) collections.Quad[string, int32, string, string] {
+	return collections.Join4(target, int32(resource), streamId, did)
+}
+
+// resourceName returns the SourceHub policy resource for a stream resource.
+func resourceName(resource types.Resource) (string, error) {
+	switch resource {
+	case types.Resource_RESOURCE_PRIMITIVE:
+		return types.PrimitiveResourceName, nil
+	case types.Resource_RESOURCE_VIEW:
+		return types.ViewResourceName, nil
+	default:
+		return "", fmt.Errorf("invalid resource %q, expected \"0\" or \"1\"", resource)
+	}
+}
+
+// GetGrant returns the stream access grant held by did on the given stream of
+// target, the default target if empty.
+func (k Keeper) GetGrant(ctx sdk.Context, target string, resource types.Resource, streamId, did string) (types.StreamGrant, bool) {
+	g, err := k.Grants.Get(ctx, grantKey(k.targetName(ctx, target), resource, streamId, did))
+	if err != nil {
+		return types.StreamGrant{}, false
+	}
+	return g, true
+}
+
+// SetGrant stores a grant and keeps the expiration index in sync with it. A
+// grant without a target is stored on the default target.
+func (k Keeper) SetGrant(ctx sdk.Context, g types.StreamGrant) error {
+	g.Target = k.targetName(ctx, g.Target)
+	key := grantKey(g.Target, g.Resource, g.StreamId, g.Did)
+
+	if previous, found := k.GetGrant(ctx, g.Target, g.Resource, g.StreamId, g.Did); found && previous.Expiration != 0 {
+		if err := k.GrantsByExpiration.Remove(ctx, collections.Join(previous.Expiration, key)); err != nil {
+			return err
+		}
+	}
+
+	if err := k.Grants.Set(ctx, key, g); err != nil {
+		return err
+	}
+
+	if g.Expiration != 0 {
+		return k.GrantsByExpiration.Set(ctx, collections.Join(g.Expiration, key))
+	}
+	return nil
+}
+
+// RemoveGrant deletes a grant and its expiration index entry.
+func (k Keeper) RemoveGrant(ctx sdk.Context, g types.StreamGrant) error {
+	key := grantKey(k.targetName(ctx, g.Target), g.Resource, g.StreamId, g.Did)
+
+	if g.Expiration != 0 {
+		if err := k.GrantsByExpiration.Remove(ctx, collections.Join(g.Expiration, key)); err != nil {
+			return err
+		}
+	}
+	return k.Grants.Remove(ctx, key)
+}
+
+// grantStreamAccess queues the subscriber relationship of did on a stream of
+// target and stores the grant, on behalf of signer.
+func (k Keeper) grantStreamAccess(ctx sdk.Context, target string, resource types.Resource, streamId, did string, expiration uint64, signer string) (types.IcaCommand, types.StreamGrant, error) {
+	name, err := resourceName(resource)
+	if err != nil {
+		return types.IcaCommand{}, types.StreamGrant{}, err
+	}
+
+	t, addr, policyId, err := k.targetAccount(ctx, target)
+	if err != nil {
+		return types.IcaCommand{}, types.StreamGrant{}, err
+	}
+
+	cmd := acptypes.NewMsgDirectPolicyCmd(
+		addr,
+		policyId,
+		acptypes.NewSetRelationshipCmd(coretypes.NewActorRelationship(name, streamId, subscriberRelation, did)),
+	)
+
+	command, err := k.queueCommand(ctx, t.ChainId, types.PacketKind_PACKET_KIND_REQUEST_STREAM_ACCESS, signer, cmd)
+	if err != nil {
+		return types.IcaCommand{}, types.StreamGrant{}, err
+	}
+
+	grant := types.StreamGrant{
+		Resource:      resource,
+		StreamId:      streamId,
+		Did:           did,
+		Expiration:    expiration,
+		Signer:        signer,
+		GrantedHeight: ctx.BlockHeight(),
+		Target:        t.ChainId,
+	}
+	if err := k.SetGrant(ctx, grant); err != nil {
+		return types.IcaCommand{}, types.StreamGrant{}, err
+	}
+
+	if err := k.Hooks().AfterStreamAccessGranted(ctx, grant); err != nil {
+		return types.IcaCommand{}, types.StreamGrant{}, err
+	}
+
+	return command, grant, nil
+}
+
+// grantConfirmed reports whether SourceHub confirmed the subscriber
+// relationship of grant g, so that paying for it only moves its expiration.
+// It fails while the relationship is pending: the command setting it may
+// still fail and take the grant with it. A grant whose relationship failed is
+// not confirmed and is bought again.
+func (k Keeper) grantConfirmed(ctx sdk.Context, g types.StreamGrant) (bool, error) {
+	name, err := resourceName(g.Resource)
+	if err != nil {
+		return false, err
+	}
+
+	r, found := k.GetRelationship(ctx, g.Target, name, g.StreamId, subscriberRelation, g.Did)
+	switch {
+	case found && r.Status == types.RelationshipStatus_RELATIONSHIP_STATUS_CONFIRMED:
+		return true, nil
+	case found && r.Status == types.RelationshipStatus_RELATIONSHIP_STATUS_PENDING:
+		return false, fmt.Errorf("access of %s to stream %s is not confirmed by SourceHub yet", g.Did, g.StreamId)
+	default:
+		return false, nil
+	}
+}
+
+// extendStreamAccess moves the expiration of a grant whose subscriber
+// relationship already exists on SourceHub, on behalf of signer.
+func (k Keeper) extendStreamAccess(ctx sdk.Context, grant types.StreamGrant, expiration uint64, signer string) (types.StreamGrant, error) {
+	grant.Expiration = expiration
+	grant.Signer = signer
+	if err := k.SetGrant(ctx, grant); err != nil {
+		return types.StreamGrant{}, err
+	}
+
+	if err := k.Hooks().AfterStreamAccessGranted(ctx, grant); err != nil {
+		return types.StreamGrant{}, err
+	}
+
+	return grant, nil
+}
+
+// RevokeExpiredGrants deletes the subscriber relationship on SourceHub for
+// the grants whose expiration is at or before the block time. At most
+// maxRevocationsPerBlock expired grants are visited per block, whether they
+// can be revoked or not, and the next block resumes after the last one
+// visited. Grants that cannot be revoked yet, e.g. because no policy is
+// registered, are kept and visited again once the walk wrapped around.
+func (k Keeper) RevokeExpiredGrants(ctx sdk.Context) error {
+	now := uint64(ctx.BlockTime().Unix())
+
+	cursor, err := k.RevocationCursor.Get(ctx)
+	resume := err == nil
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	visited := 0
+	for pass := 0; pass < 2; pass++ {
+		// The first pass resumes after the cursor, the second one wraps
+		// around and stops at it.
+		var rng collections.Ranger[grantExpiryKey]
+		switch {
+		case resume && pass == 0:
+			rng = new(collections.Range[grantExpiryKey]).StartExclusive(cursor)
+		case resume:
+			rng = new(collections.Range[grantExpiryKey]).EndInclusive(cursor)
+		}
+
+		var expired []grantExpiryKey
+		err := k.GrantsByExpiration.Walk(ctx, rng, func(key grantExpiryKey) (bool, error) {
+			if key.K1() > now || visited+len(expired) >= maxRevocationsPerBlock {
+				return true, nil
+			}
+			expired = append(expired, key)
+			return false, nil
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, key := range expired {
+			if err := k.revokeExpiredGrant(ctx, key.K2()); err != nil {
+				return err
+			}
+		}
+		visited += len(expired)
+
+		if visited >= maxRevocationsPerBlock {
+			return k.RevocationCursor.Set(ctx, expired[len(expired)-1])
+		}
+		if !resume {
+			break
+		}
+	}
+
+	return k.RevocationCursor.Remove(ctx)
+}
+
+// revokeExpiredGrant revokes the grant with the given key. A grant that
+// cannot be revoked is logged and left in place.
+func (k Keeper) revokeExpiredGrant(ctx sdk.Context, key collections.Quad[string, int32, string, string]) error {
+	g, err := k.Grants.Get(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	t, addr, policyId, err := k.targetAccount(ctx, g.Target)
+	if err != nil {
+		k.Logger(ctx).Error("cannot revoke expired grant", "stream", g.StreamId, "did", g.Did, "error", err)
+		return nil
+	}
+
+	// Revoke in a cached context so a failure leaves the grant in place.
+	cacheCtx, write := ctx.CacheContext()
+	if err := k.revokeGrant(cacheCtx, t.ChainId, addr, policyId, g); err != nil {
+		k.Logger(ctx).Error("failed to revoke expired grant", "stream", g.StreamId, "did", g.Did, "error", err)
+		return nil
+	}
+	write()
+	return nil
+}
+
+// cancelPendingGrant makes sure the subscriber relationship of g cannot be
+// set on SourceHub after it is deleted. A command still waiting in the outbox
+// to set it is cancelled, and an error is returned while one is in flight so
+// the revocation waits for its outcome.
+func (k Keeper) cancelPendingGrant(ctx sdk.Context, resource string, g types.StreamGrant) error {
+	r, found := k.GetRelationship(ctx, g.Target, resource, g.StreamId, subscriberRelation, g.Did)
+	if !found || r.Status != types.RelationshipStatus_RELATIONSHIP_STATUS_PENDING {
+		return nil
+	}
+	c, found := k.GetIcaCommand(ctx, r.CommandId)
+	if !found {
+		return nil
+	}
+
+	switch c.Status {
+	case types.PacketStatus_PACKET_STATUS_QUEUED:
+		return k.cancelCommand(ctx, c, "cancelled by the revocation of the stream access")
+	case types.PacketStatus_PACKET_STATUS_PENDING:
+		return fmt.Errorf("access of %s to stream %s is still being sent to SourceHub by command %d", g.Did, g.StreamId, c.Id)
+	}
+	return nil
+}
+
+func (k Keeper) revokeGrant(ctx sdk.Context, target, addr, policyId string, g types.StreamGrant) error {
+	resource, err := resourceName(g.Resource)
+	if err != nil {
+		return err
+	}
+
+	cmd := acptypes.NewMsgDirectPolicyCmd(
+		addr,
+		policyId,
+		acptypes.NewDeleteRelationshipCmd(coretypes.NewActorRelationship(resource, g.StreamId, subscriberRelation, g.Did)),
+	)
+
+	if err := k.cancelPendingGrant(ctx, resource, g); err != nil {
+		return err
+	}
+
+	command, err := k.queueCommand(ctx, target, types.PacketKind_PACKET_KIND_REVOKE_STREAM_ACCESS, types.ModuleAddress.String(), cmd)
+	if err != nil {
+		return err
+	}
+
+	return k.dropGrant(ctx, target, g, command.Id)
+}
+
+// dropGrant removes a grant whose subscriber relationship is deleted on
+// SourceHub by the command with the given ID.
+func (k Keeper) dropGrant(ctx sdk.Context, target string, g types.StreamGrant, commandId uint64) error {
+	if err := k.RemoveGrant(ctx, g); err != nil {
+		return err
+	}
+
+	k.callHookSafely(ctx, "AfterStreamAccessRevoked", func(ctx context.Context) error {
+		return k.Hooks().AfterStreamAccessRevoked(ctx, g)
+	})
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventStreamAccessRevoked{
+		Target:     target,
+		Resource:   g.Resource,
+		StreamId:   g.StreamId,
+		Did:        g.Did,
+		Expiration: g.Expiration,
+		CommandId:  commandId,
+	})
+}
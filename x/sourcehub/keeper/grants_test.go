@@ -0,0 +1,311 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	acptypes "github.com/sourcenetwork/sourcehub/x/acp/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/shinzonetwork/shinzohub/x/sourcehub/types"
+)
+
+func requestTimedStreamAccess(ctx sdk.Context, k Keeper, expiration uint64) error {
+	_, err := NewMsgServerImpl(k).RequestStreamAccess(ctx, &types.MsgRequestStreamAccess{
+		Signer:     k.GetAuthority(),
+		Resource:   types.Resource_RESOURCE_VIEW,
+		StreamId:   "view-1",
+		Did:        "did:key:alice",
+		Expiration: expiration,
+	})
+	return err
+}
+
+func decodePolicyCmd(t *testing.T, data []byte) *acptypes.MsgDirectPolicyCmd {
+	t.Helper()
+
+	var tx icatypes.CosmosTx
+	require.NoError(t, gogoproto.Unmarshal(data, &tx))
+	require.Len(t, tx.Messages, 1)
+
+	var cmd acptypes.MsgDirectPolicyCmd
+	require.NoError(t, gogoproto.Unmarshal(tx.Messages[0].Value, &cmd))
+	return &cmd
+}
+
+func TestRequestStreamAccessStoresGrant(t *testing.T) {
+	k, ctx, ica := setupKeeperWithICA(t)
+	ctx = ctx.WithBlockTime(time.Unix(1000, 0))
+
+	require.NoError(t, requestTimedStreamAccess(ctx, k, 2000))
+	require.NoError(t, k.FlushOutbox(ctx))
+	require.Len(t, ica.sent, 1)
+	require.NotNil(t, decodePolicyCmd(t, ica.sent[0].Data).Cmd.GetSetRelationshipCmd())
+
+	g, found := k.GetGrant(ctx, "", types.Resource_RESOURCE_VIEW, "view-1", "did:key:alice")
+	require.True(t, found)
+	require.Equal(t, uint64(2000), g.Expiration)
+
+	var ev types.EventStreamAccessRequested
+	require.True(t, lastTypedEvent(t, ctx, &ev))
+	require.Equal(t, types.EventStreamAccessRequested{
+		Target:     testTarget,
+		Resource:   types.Resource_RESOURCE_VIEW,
+		StreamId:   "view-1",
+		Did:        "did:key:alice",
+		Expiration: 2000,
+		Signer:     k.GetAuthority(),
+		CommandId:  0,
+	}, ev)
+
+	has, err := k.GrantsByExpiration.Has(ctx, collections.Join(uint64(2000), grantKey(testTarget, g.Resource, g.StreamId, g.Did)))
+	require.NoError(t, err)
+	require.True(t, has)
+}
+
+func TestRequestStreamAccessRejectsPastExpiration(t *testing.T) {
+	k, ctx, ica := setupKeeperWithICA(t)
+	ctx = ctx.WithBlockTime(time.Unix(1000, 0))
+
+	require.Error(t, requestTimedStreamAccess(ctx, k, 1000))
+	require.Empty(t, ica.sent)
+}
+
+func TestRequestStreamAccessRenewsGrant(t *testing.T) {
+	k, ctx, ica := setupKeeperWithICA(t)
+	ctx = ctx.WithBlockTime(time.Unix(1000, 0))
+
+	require.NoError(t, requestTimedStreamAccess(ctx, k, 2000))
+	require.NoError(t, k.FlushOutbox(ctx))
+	packet := channeltypes.Packet{SourceChannel: testChannelID, Sequence: ica.sequence}
+	require.NoError(t, k.OnAcknowledgementPacket(ctx, packet, msgResponsesAck(t, 1)))
+
+	require.NoError(t, requestTimedStreamAccess(ctx, k, 5000))
+	require.NoError(t, k.FlushOutbox(ctx))
+	require.Len(t, ica.sent, 1, "renewal must not resend the relationship")
+
+	g, _ := k.GetGrant(ctx, "", types.Resource_RESOURCE_VIEW, "view-1", "did:key:alice")
+	require.Equal(t, uint64(5000), g.Expiration)
+
+	// The grant is no longer revoked at its original expiration.
+	require.NoError(t, k.RevokeExpiredGrants(ctx.WithBlockTime(time.Unix(3000, 0))))
+	require.NoError(t, k.FlushOutbox(ctx))
+	require.Len(t, ica.sent, 1)
+
+	_, found := k.GetGrant(ctx, "", types.Resource_RESOURCE_VIEW, "view-1", "did:key:alice")
+	require.True(t, found)
+}
+
+func TestRequestStreamAccessResendsFailedGrant(t *testing.T) {
+	k, ctx, ica := setupKeeperWithICA(t)
+	ctx = ctx.WithBlockTime(time.Unix(1000, 0))
+
+	require.NoError(t, requestTimedStreamAccess(ctx, k, 2000))
+	require.NoError(t, k.FlushOutbox(ctx))
+	packet := channeltypes.Packet{SourceChannel: testChannelID, Sequence: ica.sequence}
+	ack := channeltypes.NewErrorAcknowledgement(errors.New("rejected"))
+	require.NoError(t, k.OnAcknowledgementPacket(ctx, packet, ack.Acknowledgement()))
+
+	// The grant outlived its command, renewing it sends the relationship
+	// again.
+	_, found := k.GetGrant(ctx, "", types.Resource_RESOURCE_VIEW, "view-1", "did:key:alice")
+	require.True(t, found)
+	require.NoError(t, requestTimedStreamAccess(ctx, k, 5000))
+	require.NoError(t, k.FlushOutbox(ctx))
+	require.Len(t, ica.sent, 2)
+	require.NotNil(t, decodePolicyCmd(t, ica.sent[1].Data).Cmd.GetSetRelationshipCmd())
+	require.Equal(t, types.RelationshipStatus_RELATIONSHIP_STATUS_PENDING, subscriberStatus(t, k, ctx, "did:key:alice"))
+
+	g, _ := k.GetGrant(ctx, "", types.Resource_RESOURCE_VIEW, "view-1", "did:key:alice")
+	require.Equal(t, uint64(5000), g.Expiration)
+}
+
+func TestRevokeExpiredGrants(t *testing.T) {
+	k, ctx, ica := setupKeeperWithICA(t)
+	ctx = ctx.WithBlockTime(time.Unix(1000, 0))
+
+	require.NoError(t, requestTimedStreamAccess(ctx, k, 2000))
+	require.NoError(t, k.FlushOutbox(ctx))
+
+	require.NoError(t, k.RevokeExpiredGrants(ctx.WithBlockTime(time.Unix(1999, 0))))
+	require.NoError(t, k.FlushOutbox(ctx))
+	require.Len(t, ica.sent, 1)
+
+	// The revocation waits for the relationship in flight.
+	ctx = ctx.WithBlockTime(time.Unix(2000, 0))
+	require.NoError(t, k.RevokeExpiredGrants(ctx))
+	require.NoError(t, k.FlushOutbox(ctx))
+	require.Len(t, ica.sent, 1)
+	_, found := k.GetGrant(ctx, "", types.Resource_RESOURCE_VIEW, "view-1", "did:key:alice")
+	require.True(t, found)
+
+	packet := channeltypes.Packet{SourceChannel: testChannelID, Sequence: ica.sequence}
+	require.NoError(t, k.OnAcknowledgementPacket(ctx, packet, msgResponsesAck(t, 1)))
+	require.NoError(t, k.RevokeExpiredGrants(ctx))
+	require.NoError(t, k.FlushOutbox(ctx))
+	require.Len(t, ica.sent, 2)
+
+	cmd := decodePolicyCmd(t, ica.sent[1].Data)
+	rel := cmd.Cmd.GetDeleteRelationshipCmd().Relationship
+	require.Equal(t, "view-1", rel.Object.Id)
+	require.Equal(t, "did:key:alice", rel.Subject.GetActor().Id)
+
+	p, found := k.GetIcaPacket(ctx, testChannelID, ica.sequence)
+	require.True(t, found)
+	require.Equal(t, types.PacketKind_PACKET_KIND_REVOKE_STREAM_ACCESS, p.Kind)
+
+	_, found = k.GetGrant(ctx, "", types.Resource_RESOURCE_VIEW, "view-1", "did:key:alice")
+	require.False(t, found)
+
+	var ev types.EventStreamAccessRevoked
+	require.True(t, lastTypedEvent(t, ctx, &ev))
+	require.Equal(t, uint64(1), ev.CommandId)
+	require.Equal(t, testTarget, ev.Target)
+}
+
+func TestRevokeExpiredGrantsCancelsQueuedGrant(t *testing.T) {
+	k, ctx, ica := setupKeeperWithICA(t)
+	ctx = ctx.WithBlockTime(time.Unix(1000, 0))
+
+	require.NoError(t, requestTimedStreamAccess(ctx, k, 2000))
+
+	// The grant expires before its relationship left the outbox, only the
+	// deletion is sent.
+	ctx = ctx.WithBlockTime(time.Unix(2000, 0))
+	require.NoError(t, k.RevokeExpiredGrants(ctx))
+	require.NoError(t, k.FlushOutbox(ctx))
+	require.Len(t, ica.sent, 1)
+	require.NotNil(t, decodePolicyCmd(t, ica.sent[0].Data).Cmd.GetDeleteRelationshipCmd())
+
+	c, found := k.GetIcaCommand(ctx, 0)
+	require.True(t, found)
+	require.Equal(t, types.PacketStatus_PACKET_STATUS_FAILED, c.Status)
+	require.Contains(t, c.Error, "cancelled")
+	require.Equal(t, types.RelationshipStatus_RELATIONSHIP_STATUS_DELETING, subscriberStatus(t, k, ctx, "did:key:alice"))
+}
+
+func TestRevokeExpiredGrantsRetriesOnSendFailure(t *testing.T) {
+	k, ctx, ica := setupKeeperWithICA(t)
+	ctx = ctx.WithBlockTime(time.Unix(1000, 0))
+
+	require.NoError(t, requestTimedStreamAccess(ctx, k, 2000))
+	require.NoError(t, k.FlushOutbox(ctx))
+	packet := channeltypes.Packet{SourceChannel: testChannelID, Sequence: ica.sequence}
+	require.NoError(t, k.OnAcknowledgementPacket(ctx, packet, msgResponsesAck(t, 1)))
+
+	ica.sendErr = errors.New("channel closed")
+	ctx = ctx.WithBlockTime(time.Unix(3000, 0))
+	require.NoError(t, k.RevokeExpiredGrants(ctx))
+	require.NoError(t, k.FlushOutbox(ctx))
+	require.Len(t, ica.sent, 1)
+
+	_, found := k.GetGrant(ctx, "", types.Resource_RESOURCE_VIEW, "view-1", "did:key:alice")
+	require.False(t, found)
+
+	// The revocation stays in the outbox until the channel is back.
+	ica.sendErr = nil
+	require.NoError(t, k.FlushOutbox(ctx))
+	require.Len(t, ica.sent, 2)
+	require.NotNil(t, decodePolicyCmd(t, ica.sent[1].Data).Cmd.GetDeleteRelationshipCmd())
+}
+
+func TestRevokeExpiredGrantsSkipsStuckGrants(t *testing.T) {
+	k, ctx, ica := setupKeeperWithICA(t)
+	ctx = ctx.WithBlockTime(time.Unix(1000, 0))
+
+	// Grants on a target that is gone expire first and cannot be revoked.
+	for i := range maxRevocationsPerBlock + 1 {
+		require.NoError(t, k.SetGrant(ctx, types.StreamGrant{
+			Resource:   types.Resource_RESOURCE_VIEW,
+			StreamId:   "view-1",
+			Did:        fmt.Sprintf("did:key:stuck%d", i),
+			Expiration: 1500,
+			Target:     "gone",
+		}))
+	}
+	require.NoError(t, requestTimedStreamAccess(ctx, k, 2000))
+	require.NoError(t, k.FlushOutbox(ctx))
+	packet := channeltypes.Packet{SourceChannel: testChannelID, Sequence: ica.sequence}
+	require.NoError(t, k.OnAcknowledgementPacket(ctx, packet, msgResponsesAck(t, 1)))
+
+	// The stuck grants use up the first block, the next one resumes after
+	// them.
+	ctx = ctx.WithBlockTime(time.Unix(3000, 0))
+	require.NoError(t, k.RevokeExpiredGrants(ctx))
+	require.NoError(t, k.FlushOutbox(ctx))
+	require.Len(t, ica.sent, 1)
+
+	require.NoError(t, k.RevokeExpiredGrants(ctx))
+	require.NoError(t, k.FlushOutbox(ctx))
+	require.Len(t, ica.sent, 2)
+	require.NotNil(t, decodePolicyCmd(t, ica.sent[1].Data).Cmd.GetDeleteRelationshipCmd())
+
+	_, found := k.GetGrant(ctx, "", types.Resource_RESOURCE_VIEW, "view-1", "did:key:alice")
+	require.False(t, found)
+	_, found = k.GetGrant(ctx, "gone", types.Resource_RESOURCE_VIEW, "view-1", "did:key:stuck0")
+	require.True(t, found)
+}
+
+func TestStreamAccessIsGrantedPerTarget(t *testing.T) {
+	k, ctx, ica := setupKeeperWithICA(t)
+	ctx = ctx.WithBlockTime(time.Unix(1000, 0))
+	addTarget(t, k, ctx, ica, "sourcehub-test", "connection-1")
+
+	require.NoError(t, requestTimedStreamAccess(ctx, k, 2000))
+
+	_, err := NewMsgServerImpl(k).RequestStreamAccess(ctx, &types.MsgRequestStreamAccess{
+		Signer:     k.GetAuthority(),
+		Resource:   types.Resource_RESOURCE_VIEW,
+		StreamId:   "view-1",
+		Did:        "did:key:alice",
+		Expiration: 5000,
+		Target:     "sourcehub-test",
+	})
+	require.NoError(t, err)
+
+	g, _ := k.GetGrant(ctx, "", types.Resource_RESOURCE_VIEW, "view-1", "did:key:alice")
+	require.Equal(t, testTarget, g.Target)
+	require.Equal(t, uint64(2000), g.Expiration)
+
+	g, found := k.GetGrant(ctx, "sourcehub-test", types.Resource_RESOURCE_VIEW, "view-1", "did:key:alice")
+	require.True(t, found)
+	require.Equal(t, "sourcehub-test", g.Target)
+	require.Equal(t, uint64(5000), g.Expiration)
+}
+
+func TestPermanentGrantIsNotRevoked(t *testing.T) {
+	k, ctx, ica := setupKeeperWithICA(t)
+	ctx = ctx.WithBlockTime(time.Unix(1000, 0))
+
+	require.NoError(t, requestTimedStreamAccess(ctx, k, 0))
+	require.NoError(t, k.RevokeExpiredGrants(ctx.WithBlockTime(time.Unix(1<<40, 0))))
+	require.NoError(t, k.FlushOutbox(ctx))
+	require.Len(t, ica.sent, 1)
+}
+
+func TestGrantsGenesisRoundTrip(t *testing.T) {
+	k, ctx, _ := setupKeeperWithICA(t)
+	ctx = ctx.WithBlockTime(time.Unix(1000, 0))
+
+	require.NoError(t, requestTimedStreamAccess(ctx, k, 2000))
+	gs := k.ExportGenesis(ctx)
+	require.Len(t, gs.StreamGrants, 1)
+	require.NoError(t, gs.Validate())
+
+	k2, ctx2, _ := setupKeeper(t)
+	k2.InitGenesis(ctx2, *gs)
+
+	has, err := k2.GrantsByExpiration.Has(ctx2, collections.Join(uint64(2000), grantKey(testTarget, types.Resource_RESOURCE_VIEW, "view-1", "did:key:alice")))
+	require.NoError(t, err)
+	require.True(t, has)
+
+	gs.StreamGrants = append(gs.StreamGrants, gs.StreamGrants[0])
+	require.Error(t, gs.Validate())
+}
@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/shinzonetwork/shinzohub/x/sourcehub/types"
+)
+
+// SetHooks sets the hooks called on entity and stream access lifecycle
+// events. The keeper is passed around by value, e.g. to the precompiles and
+// the ICA controller stack, and every copy sees the hooks set on any of them.
+// SetHooks can only be called once.
+func (k Keeper) SetHooks(hooks ...types.SourcehubHooks) Keeper {
+	if len(*k.hooks) > 0 {
+		panic("cannot set sourcehub hooks twice")
+	}
+
+	*k.hooks = types.NewMultiSourcehubHooks(hooks...)
+	return k
+}
+
+// Hooks returns the registered hooks.
+func (k Keeper) Hooks() types.SourcehubHooks {
+	if k.hooks == nil {
+		return types.MultiSourcehubHooks{}
+	}
+	return *k.hooks
+}
+
+// callHookSafely calls hook in a cached context, for hooks that must not fail
+// the acknowledgement or block that runs them. The changes of a failing hook
+// are dropped and its error is logged.
+func (k Keeper) callHookSafely(ctx sdk.Context, name string, hook func(context.Context) error) {
+	cacheCtx, write := ctx.CacheContext()
+	if err := hook(cacheCtx); err != nil {
+		k.Logger(ctx).Error("sourcehub hook failed", "hook", name, "error", err)
+		return
+	}
+	write()
+}
@@ -0,0 +1,120 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/shinzonetwork/shinzohub/x/sourcehub/types"
+)
+
+// recordingHooks records the calls it receives and fails them with err.
+type recordingHooks struct {
+	calls []string
+	err   error
+}
+
+func (h *recordingHooks) AfterEntityRegistered(_ context.Context, e types.Entity) error {
+	h.calls = append(h.calls, "registered "+e.Did)
+	return h.err
+}
+
+func (h *recordingHooks) AfterEntityRemoved(_ context.Context, e types.Entity) error {
+	h.calls = append(h.calls, "removed "+e.Did)
+	return h.err
+}
+
+func (h *recordingHooks) AfterStreamAccessGranted(_ context.Context, g types.StreamGrant) error {
+	h.calls = append(h.calls, "granted "+g.Did)
+	return h.err
+}
+
+func (h *recordingHooks) AfterStreamAccessRevoked(_ context.Context, g types.StreamGrant) error {
+	h.calls = append(h.calls, "revoked "+g.Did)
+	return h.err
+}
+
+func (h *recordingHooks) AfterIcaAck(_ context.Context, p types.IcaPacket) error {
+	h.calls = append(h.calls, "ack "+p.Kind.String())
+	return h.err
+}
+
+func TestHooksAreCalled(t *testing.T) {
+	k, ctx, ica := setupKeeperWithICA(t)
+	ctx = ctx.WithBlockTime(time.Unix(1000, 0))
+	first, second := &recordingHooks{}, &recordingHooks{}
+	k.SetHooks(first, second)
+
+	owner := sdk.AccAddress("owner_______________")
+	did, _, err := newTestEntityKeys(t).register(ctx, k, owner, types.RoleIndexer)
+	require.NoError(t, err)
+	_, err = k.UnregisterEntity(ctx, types.RoleIndexer, owner)
+	require.NoError(t, err)
+
+	// A blocked entity is removed too.
+	host, _, err := newTestEntityKeys(t).register(ctx, k, owner, types.RoleHost)
+	require.NoError(t, err)
+	_, err = k.BlockEntity(ctx, owner, types.EntityRole_ENTITY_ROLE_HOST, k.GetAuthority())
+	require.NoError(t, err)
+
+	require.NoError(t, requestTimedStreamAccess(ctx, k, 2000))
+	require.NoError(t, k.RevokeExpiredGrants(ctx.WithBlockTime(time.Unix(2000, 0))))
+
+	require.NoError(t, k.FlushOutbox(ctx))
+	packet := channeltypes.Packet{SourceChannel: testChannelID, Sequence: ica.sequence}
+	require.NoError(t, k.OnAcknowledgementPacket(ctx, packet, channeltypes.NewResultAcknowledgement([]byte{}).Acknowledgement()))
+
+	want := []string{
+		"registered " + string(did),
+		"removed " + string(did),
+		"registered " + string(host),
+		"removed " + string(host),
+		"granted did:key:alice",
+		"revoked did:key:alice",
+		"ack " + types.PacketKind_PACKET_KIND_BATCH.String(),
+	}
+	require.Equal(t, want, first.calls)
+	require.Equal(t, want, second.calls)
+}
+
+func TestHookErrors(t *testing.T) {
+	k, ctx, ica := setupKeeperWithICA(t)
+	hooks := &recordingHooks{err: errors.New("hook failed")}
+	k.SetHooks(hooks)
+
+	// A failing transaction hook aborts the grant.
+	_, err := NewMsgServerImpl(k).RequestStreamAccess(ctx, &types.MsgRequestStreamAccess{
+		Signer:   k.GetAuthority(),
+		Resource: types.Resource_RESOURCE_VIEW,
+		StreamId: "view-1",
+		Did:      "did:key:alice",
+	})
+	require.ErrorContains(t, err, "hook failed")
+
+	// A failing AfterIcaAck hook does not fail the acknowledgement.
+	hooks.err = nil
+	queueStreamAccess(t, k, ctx, "did:key:bob")
+	require.NoError(t, k.FlushOutbox(ctx))
+	hooks.err = errors.New("hook failed")
+
+	packet := channeltypes.Packet{SourceChannel: testChannelID, Sequence: ica.sequence}
+	require.NoError(t, k.OnAcknowledgementPacket(ctx, packet, msgResponsesAck(t, 1)))
+	p, _ := k.GetIcaPacket(ctx, testChannelID, ica.sequence)
+	require.Equal(t, types.PacketStatus_PACKET_STATUS_ACKNOWLEDGED, p.Status)
+}
+
+func TestSetHooksIsSharedByCopies(t *testing.T) {
+	k, _, _ := setupKeeper(t)
+	copied := k
+
+	hooks := &recordingHooks{}
+	k.SetHooks(hooks)
+	require.Equal(t, types.MultiSourcehubHooks{hooks}, copied.Hooks())
+
+	require.Panics(t, func() { copied.SetHooks(hooks) })
+}
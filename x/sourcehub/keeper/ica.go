@@ -1,102 +1,130 @@
 package keeper
 
 import (
-	"encoding/json"
+	"fmt"
 
-	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/shinzonetwork/shinzohub/x/sourcehub/types"
 )
 
-func (k Keeper) SetControllerConnectionID(ctx sdk.Context, connectionID string) {
-	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	store.Set([]byte(types.KeyConnectionID), []byte(connectionID))
-}
-
-func (k Keeper) GetControllerConnectionID(ctx sdk.Context) string {
-	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	bz := store.Get([]byte(types.KeyConnectionID))
-	if bz == nil {
-		return ""
+// GetTarget returns the SourceHub target with the given chain ID.
+func (k Keeper) GetTarget(ctx sdk.Context, chainId string) (types.SourcehubTarget, bool) {
+	t, err := k.Targets.Get(ctx, chainId)
+	if err != nil {
+		return types.SourcehubTarget{}, false
 	}
-	return string(bz)
+	return t, true
 }
 
-func (k Keeper) SetHostConnectionID(ctx sdk.Context, hostConnectionID string) {
-	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	store.Set([]byte(types.KeyHostConnectionID), []byte(hostConnectionID))
+// SetTarget stores a SourceHub target under its chain ID.
+func (k Keeper) SetTarget(ctx sdk.Context, t types.SourcehubTarget) error {
+	return k.Targets.Set(ctx, t.ChainId, t)
 }
 
-func (k Keeper) GetHostConnectionID(ctx sdk.Context) string {
-	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	bz := store.Get([]byte(types.KeyHostConnectionID))
-	if bz == nil {
+// GetDefaultTarget returns the chain ID of the target used when none is
+// given, or an empty string if no target is registered.
+func (k Keeper) GetDefaultTarget(ctx sdk.Context) string {
+	chainId, err := k.DefaultTarget.Get(ctx)
+	if err != nil {
 		return ""
 	}
-	return string(bz)
+	return chainId
 }
 
-func (k Keeper) SetVersion(ctx sdk.Context, version string) {
-	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	store.Set([]byte(types.KeyVersion), []byte(version))
-}
-
-func (k Keeper) GetVersion(ctx sdk.Context) string {
-	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	bz := store.Get([]byte(types.KeyVersion))
-	if bz == nil {
-		return ""
+// SetDefaultTarget selects the target used when none is given.
+func (k Keeper) SetDefaultTarget(ctx sdk.Context, chainId string) error {
+	if _, found := k.GetTarget(ctx, chainId); !found {
+		return fmt.Errorf("unknown SourceHub target %s", chainId)
 	}
-	return string(bz)
+	return k.DefaultTarget.Set(ctx, chainId)
 }
 
-func (k Keeper) SetEncoding(ctx sdk.Context, encoding string) {
-	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	store.Set([]byte(types.KeyEncoding), []byte(encoding))
+// targetName returns chainId, or the chain ID of the default target if empty.
+// Grants and mirrored relationships are keyed by the name of their target.
+func (k Keeper) targetName(ctx sdk.Context, chainId string) string {
+	if chainId == "" {
+		return k.GetDefaultTarget(ctx)
+	}
+	return chainId
 }
 
-func (k Keeper) GetEncoding(ctx sdk.Context) string {
-	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	bz := store.Get([]byte(types.KeyEncoding))
-	if bz == nil {
-		return ""
+// resolveTarget returns the target with the given chain ID, or the default
+// target if chainId is empty.
+func (k Keeper) resolveTarget(ctx sdk.Context, chainId string) (types.SourcehubTarget, error) {
+	if chainId == "" {
+		chainId = k.GetDefaultTarget(ctx)
+		if chainId == "" {
+			return types.SourcehubTarget{}, fmt.Errorf("no SourceHub target registered")
+		}
 	}
-	return string(bz)
-}
 
-func (k Keeper) SetTxType(ctx sdk.Context, txType string) {
-	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	store.Set([]byte(types.KeyTxType), []byte(txType))
+	t, found := k.GetTarget(ctx, chainId)
+	if !found {
+		return types.SourcehubTarget{}, fmt.Errorf("unknown SourceHub target %s", chainId)
+	}
+	return t, nil
 }
 
-func (k Keeper) GetTxType(ctx sdk.Context) string {
-	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	bz := store.Get([]byte(types.KeyTxType))
-	if bz == nil {
-		return ""
+// targetByConnection returns the target using the given controller
+// connection.
+func (k Keeper) targetByConnection(ctx sdk.Context, connectionID string) (types.SourcehubTarget, bool) {
+	var found *types.SourcehubTarget
+	err := k.Targets.Walk(ctx, nil, func(_ string, t types.SourcehubTarget) (bool, error) {
+		if t.ControllerConnectionId == connectionID {
+			found = &t
+			return true, nil
+		}
+		return false, nil
+	})
+	if err != nil || found == nil {
+		return types.SourcehubTarget{}, false
 	}
-	return string(bz)
+	return *found, true
 }
 
-func (k Keeper) GetICAMetadata(ctx sdk.Context) string {
-	controllerConnID := k.GetControllerConnectionID(ctx)
-	hostConnID := k.GetHostConnectionID(ctx)
-	version := k.GetVersion(ctx)
-	encoding := k.GetEncoding(ctx)
-	txType := k.GetTxType(ctx)
+// targetByChannel returns the target whose ICA channel is channelID. A channel
+// the module has not seen yet is matched against the active ICA channel of
+// each target's connection.
+func (k Keeper) targetByChannel(ctx sdk.Context, channelID string) (types.SourcehubTarget, bool) {
+	var found *types.SourcehubTarget
+	err := k.Targets.Walk(ctx, nil, func(_ string, t types.SourcehubTarget) (bool, error) {
+		if t.Channel.ChannelId == channelID {
+			found = &t
+			return true, nil
+		}
+		return false, nil
+	})
+	if err != nil {
+		return types.SourcehubTarget{}, false
+	}
+	if found != nil {
+		return *found, true
+	}
 
-	meta := map[string]string{
-		"version":                  version,
-		"controller_connection_id": controllerConnID,
-		"host_connection_id":       hostConnID,
-		"encoding":                 encoding,
-		"tx_type":                  txType,
+	err = k.Targets.Walk(ctx, nil, func(_ string, t types.SourcehubTarget) (bool, error) {
+		if active, ok := k.IcaCtrlKeeper.GetActiveChannelID(ctx, t.ControllerConnectionId, k.GetICAPortID()); ok && active == channelID {
+			found = &t
+			return true, nil
+		}
+		return false, nil
+	})
+	if err != nil || found == nil {
+		return types.SourcehubTarget{}, false
 	}
+	return *found, true
+}
 
-	bz, err := json.Marshal(meta)
-	if err != nil {
-		return ""
+// GetICAPortID returns the ICS-27 controller port owned by the module account.
+func (k Keeper) GetICAPortID() string {
+	return fmt.Sprintf("icacontroller-%s", types.ModuleAddress.String())
+}
+
+// GetICAAddress returns the interchain account address of the module on the
+// target.
+func (k Keeper) GetICAAddress(ctx sdk.Context, t types.SourcehubTarget) (string, bool) {
+	if t.ControllerConnectionId == "" {
+		return "", false
 	}
 
-	return string(bz)
+	return k.IcaCtrlKeeper.GetInterchainAccountAddress(ctx, t.ControllerConnectionId, k.GetICAPortID())
 }
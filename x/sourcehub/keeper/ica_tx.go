@@ -0,0 +1,128 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/collections"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+
+	"github.com/shinzonetwork/shinzohub/x/sourcehub/types"
+)
+
+// icaPacketTimeout is how long SourceHub has to receive an ICA packet before
+// it times out and its commands are retried.
+const icaPacketTimeout = 5 * time.Minute
+
+// requireICAAddress returns the interchain account address on the target or
+// an error if the account has not been registered yet.
+func (k Keeper) requireICAAddress(ctx sdk.Context, t types.SourcehubTarget) (string, error) {
+	connectionID := t.ControllerConnectionId
+	if connectionID == "" {
+		return "", fmt.Errorf("no connection ID set for target %s", t.ChainId)
+	}
+
+	portID := k.GetICAPortID()
+
+	addr, _ := k.IcaCtrlKeeper.GetInterchainAccountAddress(ctx, connectionID, portID)
+	if addr == "" {
+		return "", fmt.Errorf("ICA address not found for portID %s on connection %s", portID, connectionID)
+	}
+
+	return addr, nil
+}
+
+// requirePolicyId returns the shinzohub policy ID on the target or an error if
+// it is not set.
+func requirePolicyId(t types.SourcehubTarget) (string, error) {
+	if t.PolicyId == "" {
+		return "", fmt.Errorf("no policy ID set for target %s", t.ChainId)
+	}
+	return t.PolicyId, nil
+}
+
+// targetAccount resolves the target with the given chain ID, the default
+// target if empty, and returns it along with the module's interchain account
+// address and the shinzohub policy ID on it.
+func (k Keeper) targetAccount(ctx sdk.Context, chainId string) (types.SourcehubTarget, string, string, error) {
+	t, err := k.resolveTarget(ctx, chainId)
+	if err != nil {
+		return types.SourcehubTarget{}, "", "", err
+	}
+
+	addr, err := k.requireICAAddress(ctx, t)
+	if err != nil {
+		return types.SourcehubTarget{}, "", "", err
+	}
+
+	policyId, err := requirePolicyId(t)
+	if err != nil {
+		return types.SourcehubTarget{}, "", "", err
+	}
+
+	return t, addr, policyId, nil
+}
+
+// sendIcaMsgs sends packed msgs to the target in one ICA CosmosTx and records
+// the outgoing packet along with the outbox commands it carries.
+func (k Keeper) sendIcaMsgs(ctx sdk.Context, t types.SourcehubTarget, kind types.PacketKind, sender string, anyMsgs []*codectypes.Any, commandIds []uint64) (types.IcaPacket, error) {
+	connectionID := t.ControllerConnectionId
+	if connectionID == "" {
+		return types.IcaPacket{}, fmt.Errorf("no connection ID set for target %s", t.ChainId)
+	}
+
+	portID := k.GetICAPortID()
+
+	cosmosTx := &icatypes.CosmosTx{Messages: anyMsgs}
+	bz, err := gogoproto.Marshal(cosmosTx)
+	if err != nil {
+		return types.IcaPacket{}, fmt.Errorf("marshal CosmosTx: %w", err)
+	}
+
+	packetData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: bz,
+		Memo: "",
+	}
+
+	timeout := uint64(ctx.BlockTime().Add(icaPacketTimeout).UnixNano())
+
+	sequence, err := k.IcaCtrlKeeper.SendTx(ctx, connectionID, portID, packetData, timeout)
+	if err != nil {
+		return types.IcaPacket{}, err
+	}
+
+	channelID, _ := k.IcaCtrlKeeper.GetActiveChannelID(ctx, connectionID, portID)
+
+	p := types.IcaPacket{
+		ChannelId:  channelID,
+		Sequence:   sequence,
+		Kind:       kind,
+		Payload:    bz,
+		Sender:     sender,
+		Height:     ctx.BlockHeight(),
+		Status:     types.PacketStatus_PACKET_STATUS_PENDING,
+		CommandIds: commandIds,
+		Target:     t.ChainId,
+	}
+	if err := k.IcaPackets.Set(ctx, collections.Join(channelID, sequence), p); err != nil {
+		return types.IcaPacket{}, err
+	}
+
+	return p, nil
+}
+
+func packMsgs(msgs ...gogoproto.Message) ([]*codectypes.Any, error) {
+	anyMsgs := make([]*codectypes.Any, 0, len(msgs))
+	for _, msg := range msgs {
+		anyMsg, err := codectypes.NewAnyWithValue(msg)
+		if err != nil {
+			return nil, err
+		}
+		anyMsgs = append(anyMsgs, anyMsg)
+	}
+	return anyMsgs, nil
+}
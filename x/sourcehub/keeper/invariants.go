@@ -0,0 +1,230 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/shinzonetwork/shinzohub/x/sourcehub/types"
+)
+
+// RegisterInvariants registers the sourcehub module invariants.
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "entity-mapping", EntityMappingInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "policy-id", PolicyIdInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "module-balance", ModuleBalanceInvariant(k))
+}
+
+// AllInvariants runs all invariants of the sourcehub module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, inv := range []sdk.Invariant{
+			EntityMappingInvariant(k),
+			PolicyIdInvariant(k),
+			ModuleBalanceInvariant(k),
+		} {
+			if res, stop := inv(ctx); stop {
+				return res, stop
+			}
+		}
+		return "", false
+	}
+}
+
+// EntityMappingInvariant checks that, for every role, the address to entity
+// records and the DID and PID indexes map one to one onto each other.
+func EntityMappingInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var broken []string
+
+		err := k.Entities.Walk(ctx, nil, func(key collections.Pair[sdk.AccAddress, int32], e types.Entity) (bool, error) {
+			owner := key.K1()
+			if e.Owner != owner.String() || int32(e.Role) != key.K2() {
+				broken = append(broken, fmt.Sprintf("entity stored under %s/%d belongs to %s/%d", owner, key.K2(), e.Owner, e.Role))
+				return false, nil
+			}
+			if byDid, err := k.EntitiesByDid.Get(ctx, collections.Join(e.Did, key.K2())); err != nil || !byDid.Equals(owner) {
+				broken = append(broken, fmt.Sprintf("DID %s of %s %s does not map back to it", e.Did, RoleToString(uint8(e.Role)), owner))
+			}
+			if e.Pid == "" {
+				return false, nil
+			}
+			if byPid, err := k.EntitiesByPid.Get(ctx, collections.Join(e.Pid, key.K2())); err != nil || !byPid.Equals(owner) {
+				broken = append(broken, fmt.Sprintf("PID %s of %s %s does not map back to it", e.Pid, RoleToString(uint8(e.Role)), owner))
+			}
+			return false, nil
+		})
+		if err != nil {
+			broken = append(broken, err.Error())
+		}
+
+		for _, index := range []struct {
+			name  string
+			m     collections.Map[collections.Pair[string, int32], sdk.AccAddress]
+			field func(types.Entity) string
+		}{
+			{"DID", k.EntitiesByDid, func(e types.Entity) string { return e.Did }},
+			{"PID", k.EntitiesByPid, func(e types.Entity) string { return e.Pid }},
+		} {
+			err := index.m.Walk(ctx, nil, func(key collections.Pair[string, int32], owner sdk.AccAddress) (bool, error) {
+				e, found := k.GetEntity(ctx, owner, types.EntityRole(key.K2()))
+				if !found || index.field(e) != key.K1() {
+					broken = append(broken, fmt.Sprintf("%s %s maps to %s, which has no %s with that %s", index.name, key.K1(), owner, RoleToString(uint8(key.K2())), index.name))
+				}
+				return false, nil
+			})
+			if err != nil {
+				broken = append(broken, err.Error())
+			}
+		}
+
+		return invariantMessage("entity-mapping", broken), len(broken) > 0
+	}
+}
+
+// GenesisEntityMappingInvariant checks that, for every role, no DID or PID of
+// the exported entities of gs is registered by more than one address. It runs
+// on the exported state itself, since importing it rebuilds the DID and PID
+// indexes and refuses duplicates, so EntityMappingInvariant cannot see them.
+func GenesisEntityMappingInvariant(gs *types.GenesisState) (string, bool) {
+	type indexKey struct {
+		id   string
+		role types.EntityRole
+	}
+
+	var broken []string
+	for _, index := range []struct {
+		name  string
+		field func(types.Entity) string
+	}{
+		{"DID", func(e types.Entity) string { return e.Did }},
+		{"PID", func(e types.Entity) string { return e.Pid }},
+	} {
+		var keys []indexKey
+		owners := make(map[indexKey][]string)
+		for _, e := range gs.Entities {
+			id := index.field(e)
+			if id == "" {
+				continue
+			}
+			key := indexKey{id, e.Role}
+			if _, ok := owners[key]; !ok {
+				keys = append(keys, key)
+			}
+			owners[key] = append(owners[key], e.Owner)
+		}
+		for _, key := range keys {
+			if len(owners[key]) > 1 {
+				broken = append(broken, fmt.Sprintf("%s %s registered by more than one %s: %s", index.name, key.id, RoleToString(uint8(key.role)), strings.Join(owners[key], ", ")))
+			}
+		}
+	}
+	return invariantMessage("entity-mapping", broken), len(broken) > 0
+}
+
+// PolicyIdInvariant checks that the SourceHub target of every view and
+// relationship mirrored by the module has a shinzohub policy ID.
+func PolicyIdInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var broken []string
+		checkTarget := func(object, chainId string) {
+			t, err := k.resolveTarget(ctx, chainId)
+			if err != nil {
+				broken = append(broken, fmt.Sprintf("%s: %s", object, err))
+			} else if t.PolicyId == "" {
+				broken = append(broken, fmt.Sprintf("%s exists but target %s has no policy ID", object, t.ChainId))
+			}
+		}
+
+		err := k.Views.Walk(ctx, nil, func(id string, v types.View) (bool, error) {
+			checkTarget("view "+id, v.Target)
+			return false, nil
+		})
+		if err != nil {
+			broken = append(broken, err.Error())
+		}
+
+		err = k.Relationships.Walk(ctx, nil, func(_ collections.Quad[string, string, string, string], r types.Relationship) (bool, error) {
+			checkTarget(fmt.Sprintf("relationship %s#%s@%s", objectKey(r.Resource, r.ObjectId), r.Relation, r.Actor), r.Target)
+			return false, nil
+		})
+		if err != nil {
+			broken = append(broken, err.Error())
+		}
+
+		return invariantMessage("policy-id", broken), len(broken) > 0
+	}
+}
+
+// ModuleBalanceInvariant checks that the module account holds at least the
+// funds it keeps on behalf of others: escrowed stream payments, fees not
+// distributed yet, pending rewards, entity bonds and bonds unbonding.
+func ModuleBalanceInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		escrowed, err := k.escrowedFunds(ctx)
+		if err != nil {
+			return invariantMessage("module-balance", []string{err.Error()}), true
+		}
+
+		balance := k.bankKeeper.GetAllBalances(ctx, types.ModuleAddress)
+		if balance.IsAllGTE(escrowed) {
+			return invariantMessage("module-balance", nil), false
+		}
+		held := balance.String()
+		if balance.IsZero() {
+			held = "nothing"
+		}
+		return invariantMessage("module-balance", []string{
+			fmt.Sprintf("module account holds %s, less than the %s it escrows", held, escrowed),
+		}), true
+	}
+}
+
+// escrowedFunds returns the funds the module account holds on behalf of
+// others.
+func (k Keeper) escrowedFunds(ctx sdk.Context) (sdk.Coins, error) {
+	total := sdk.NewCoins()
+
+	if err := k.StreamPayments.Walk(ctx, nil, func(_ uint64, p types.StreamPayment) (bool, error) {
+		total = total.Add(p.Amount...)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := k.StreamRevenues.Walk(ctx, nil, func(_ collections.Pair[int32, string], r types.StreamRevenue) (bool, error) {
+		total = total.Add(r.Amount...)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := k.PendingRewards.Walk(ctx, nil, func(_ sdk.AccAddress, r types.PendingReward) (bool, error) {
+		total = total.Add(r.Amount...)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := k.Entities.Walk(ctx, nil, func(_ collections.Pair[sdk.AccAddress, int32], e types.Entity) (bool, error) {
+		total = total.Add(e.Bond...)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := k.EntityUnbondings.Walk(ctx, nil, func(_ collections.Triple[uint64, sdk.AccAddress, int32], u types.EntityUnbonding) (bool, error) {
+		total = total.Add(u.Amount...)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return total, nil
+}
+
+func invariantMessage(route string, broken []string) string {
+	msg := fmt.Sprintf("%d broken\n", len(broken))
+	for _, b := range broken {
+		msg += "\t" + b + "\n"
+	}
+	return sdk.FormatInvariant(types.ModuleName, route, msg)
+}
@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/shinzonetwork/shinzohub/x/sourcehub/types"
+)
+
+func TestEntityMappingInvariant(t *testing.T) {
+	k, ctx, _ := setupKeeperWithICA(t)
+
+	owner := sdk.AccAddress("owner_______________")
+	keys := newTestEntityKeys(t)
+	_, _, err := keys.register(ctx, k, owner, types.RoleIndexer)
+	require.NoError(t, err)
+	_, _, err = keys.rotate(ctx, k, newTestEntityKeys(t), registrationMessage(ctx, owner, types.RoleIndexer), owner, types.RoleIndexer)
+	require.NoError(t, err)
+
+	_, broken := AllInvariants(k)(ctx)
+	require.False(t, broken)
+
+	e, found := k.GetEntity(ctx, owner, types.EntityRole_ENTITY_ROLE_INDEXER)
+	require.True(t, found)
+
+	// A DID index entry pointing nowhere breaks the bijection.
+	stray := collections.Join("did:key:stray", int32(types.EntityRole_ENTITY_ROLE_INDEXER))
+	require.NoError(t, k.EntitiesByDid.Set(ctx, stray, owner))
+	msg, broken := EntityMappingInvariant(k)(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "did:key:stray")
+	require.NoError(t, k.EntitiesByDid.Remove(ctx, stray))
+
+	// So does an entity whose PID is missing from the index.
+	require.NoError(t, k.EntitiesByPid.Remove(ctx, collections.Join(e.Pid, int32(e.Role))))
+	msg, broken = EntityMappingInvariant(k)(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, e.Pid)
+}
+
+func TestGenesisEntityMappingInvariant(t *testing.T) {
+	owner1 := sdk.AccAddress("owner1______________").String()
+	owner2 := sdk.AccAddress("owner2______________").String()
+	gs := types.GenesisState{Entities: []types.Entity{
+		{Owner: owner1, Role: types.EntityRole_ENTITY_ROLE_INDEXER, Did: "did:key:a", Pid: "pid-a"},
+		{Owner: owner2, Role: types.EntityRole_ENTITY_ROLE_INDEXER, Did: "did:key:b"},
+		// The same DID under another role is a different entity.
+		{Owner: owner2, Role: types.EntityRole_ENTITY_ROLE_HOST, Did: "did:key:a", Pid: "pid-a"},
+	}}
+	_, broken := GenesisEntityMappingInvariant(&gs)
+	require.False(t, broken)
+
+	gs.Entities[1].Did = "did:key:a"
+	gs.Entities[1].Pid = "pid-a"
+	msg, broken := GenesisEntityMappingInvariant(&gs)
+	require.True(t, broken)
+	require.Contains(t, msg, "2 broken")
+	require.Contains(t, msg, "DID did:key:a registered by more than one indexer: "+owner1+", "+owner2)
+	require.Contains(t, msg, "PID pid-a registered by more than one indexer: "+owner1+", "+owner2)
+}
+
+func TestPolicyIdInvariant(t *testing.T) {
+	k, ctx, _ := setupKeeper(t)
+
+	_, broken := PolicyIdInvariant(k)(ctx)
+	require.False(t, broken)
+
+	require.NoError(t, k.SetTarget(ctx, types.NewSourcehubTarget(testTarget, "connection-0", "connection-0")))
+	require.NoError(t, k.Views.Set(ctx, "view-1", types.View{Id: "view-1", Target: testTarget}))
+	msg, broken := PolicyIdInvariant(k)(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "view view-1 exists but target "+testTarget+" has no policy ID")
+
+	t2, found := k.GetTarget(ctx, testTarget)
+	require.True(t, found)
+	t2.PolicyId = "policy-1"
+	require.NoError(t, k.SetTarget(ctx, t2))
+	_, broken = PolicyIdInvariant(k)(ctx)
+	require.False(t, broken)
+
+	// A relationship on a target that is not registered has no policy either.
+	require.NoError(t, k.SetRelationship(ctx, types.Relationship{Resource: types.ViewResourceName, ObjectId: "view-1", Relation: "owner", Actor: "did:key:a", Target: "other"}))
+	_, broken = PolicyIdInvariant(k)(ctx)
+	require.True(t, broken)
+}
+
+func TestModuleBalanceInvariant(t *testing.T) {
+	k, ctx, _ := setupKeeperWithICA(t)
+	bank := testBank(k)
+
+	owner := sdk.AccAddress("owner_______________")
+	require.NoError(t, k.creditReward(ctx, owner, ushinzo(40)))
+	require.NoError(t, k.addStreamRevenue(ctx, types.Resource_RESOURCE_VIEW, "view-1", ushinzo(10)))
+	require.NoError(t, k.StreamPayments.Set(ctx, 1, types.StreamPayment{CommandId: 1, Amount: ushinzo(20)}))
+	require.NoError(t, k.SetEntity(ctx, types.Entity{Owner: owner.String(), Role: types.EntityRole_ENTITY_ROLE_HOST, Did: "did:key:host", Bond: ushinzo(100)}))
+	require.NoError(t, k.startEntityUnbonding(ctx, types.Entity{Owner: owner.String(), Role: types.EntityRole_ENTITY_ROLE_INDEXER}, ushinzo(30)))
+
+	bank.balances[types.ModuleAddress.String()] = ushinzo(199)
+	msg, broken := ModuleBalanceInvariant(k)(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "less than the 200ushinzo it escrows")
+
+	bank.balances[types.ModuleAddress.String()] = ushinzo(200)
+	_, broken = ModuleBalanceInvariant(k)(ctx)
+	require.False(t, broken)
+}
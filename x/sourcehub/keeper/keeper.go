@@ -2,17 +2,15 @@ package keeper
 
 import (
 	"fmt"
-	"time"
 
 	"cosmossdk.io/collections"
+	collcodec "cosmossdk.io/collections/codec"
 	storetypes "cosmossdk.io/core/store"
 	"cosmossdk.io/log"
 	"github.com/cosmos/cosmos-sdk/codec"
-	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
-	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	gogoproto "github.com/cosmos/gogoproto/proto"
-	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+	lru "github.com/hashicorp/golang-lru/v2"
 	"github.com/shinzonetwork/shinzohub/x/sourcehub/types"
 	coretypes "github.com/sourcenetwork/acp_core/pkg/types"
 	acptypes "github.com/sourcenetwork/sourcehub/x/acp/types"
@@ -22,16 +20,98 @@ type Keeper struct {
 	cdc           codec.BinaryCodec
 	storeService  storetypes.KVStoreService
 	IcaCtrlKeeper types.ICAControllerKeeper
+	bankKeeper    types.BankKeeper
+	distrKeeper   types.DistrKeeper
 
 	// cached authority string for quick equality check
-	authority string
-	Params    collections.Item[types.Params]
+	authority  string
+	Params     collections.Item[types.Params]
+	IcaPackets collections.Map[collections.Pair[string, uint64], types.IcaPacket]
+
+	// Grants is keyed by (target, resource, stream ID, DID)
+	Grants collections.Map[collections.Quad[string, int32, string, string], types.StreamGrant]
+	// GrantsByExpiration indexes expiring grants by their expiration time
+	GrantsByExpiration collections.KeySet[grantExpiryKey]
+
+	// RoleHolders is keyed by (module role, address)
+	RoleHolders collections.KeySet[collections.Pair[int32, string]]
+
+	// Entities is keyed by (owner, entity role)
+	Entities collections.Map[collections.Pair[sdk.AccAddress, int32], types.Entity]
+	// EntitiesByDid maps (DID, entity role) to the owner
+	EntitiesByDid collections.Map[collections.Pair[string, int32], sdk.AccAddress]
+	// EntitiesByPid maps (PID, entity role) to the owner
+	EntitiesByPid collections.Map[collections.Pair[string, int32], sdk.AccAddress]
+	// EntityCommands maps an unresolved outbox command to the entity whose
+	// group membership it carries
+	EntityCommands collections.Map[uint64, collections.Pair[sdk.AccAddress, int32]]
+	// UsedRegistrations holds the hashes of the registration messages already
+	// used, keyed by (expiry height, hash) so they can be pruned once expired
+	UsedRegistrations collections.KeySet[collections.Pair[int64, []byte]]
+
+	// IcaCommands is keyed by command ID
+	IcaCommands   collections.Map[uint64, types.IcaCommand]
+	NextCommandId collections.Sequence
+	// Outbox holds the IDs of the commands waiting to be sent
+	Outbox collections.KeySet[uint64]
+	// DeadLetters holds the IDs of the commands that exhausted their attempts
+	DeadLetters collections.KeySet[uint64]
+
+	// Targets is keyed by the chain ID of the SourceHub target
+	Targets collections.Map[string, types.SourcehubTarget]
+	// DefaultTarget is the chain ID of the target used when none is given
+	DefaultTarget collections.Item[string]
+
+	// Views is keyed by the SourceHub object ID of the view
+	Views collections.Map[string, types.View]
+
+	// Relationships mirrors the ACP relationships sent to SourceHub, keyed by
+	// (target, <resource>:<object ID>, relation, actor)
+	Relationships collections.Map[collections.Quad[string, string, string, string], types.Relationship]
+	// RelationshipsByActor indexes Relationships by (target, actor, object,
+	// relation)
+	RelationshipsByActor collections.KeySet[collections.Quad[string, string, string, string]]
+
+	// PolicyVersions holds the versions of the shinzohub policy adopted through
+	// governance, keyed by version. The last one is active.
+	PolicyVersions collections.Map[uint64, types.PolicyVersion]
+
+	// StreamPrices is keyed by (resource, stream ID)
+	StreamPrices collections.Map[collections.Pair[int32, string], types.StreamPrice]
+	// StreamPayments holds the escrowed payments, keyed by the ID of the
+	// command carrying the access they bought
+	StreamPayments collections.Map[uint64, types.StreamPayment]
+	// StreamRevenues holds the subscription fees collected in the current
+	// epoch, keyed by (resource, stream ID)
+	StreamRevenues collections.Map[collections.Pair[int32, string], types.StreamRevenue]
+	// PendingRewards holds the rewards credited and not withdrawn yet, keyed
+	// by owner
+	PendingRewards collections.Map[sdk.AccAddress, types.PendingReward]
+
+	// EntityUnbondings holds the bonds of removed or blocked entities, keyed by
+	// (completion time, owner, entity role)
+	EntityUnbondings collections.Map[collections.Triple[uint64, sdk.AccAddress, int32], types.EntityUnbonding]
+
+	// RevocationCursor holds the last expired grant visited by
+	// RevokeExpiredGrants, unset once every expired grant was visited
+	RevocationCursor collections.Item[grantExpiryKey]
+	// RevenueCursor holds the last stream whose revenue DistributeRewards
+	// shared, unset once the revenue of every stream was shared
+	RevenueCursor collections.Item[revenueKey]
+
+	// hooks is shared by every copy of the keeper, see SetHooks
+	hooks *types.MultiSourcehubHooks
+
+	// policies caches the parsed policy versions, see parsedPolicy
+	policies *lru.Cache[uint64, *coretypes.Policy]
 }
 
 func NewKeeper(
 	cdc codec.BinaryCodec,
 	storeService storetypes.KVStoreService,
 	icaCtrlKeeper types.ICAControllerKeeper,
+	bankKeeper types.BankKeeper,
+	distrKeeper types.DistrKeeper,
 	authority string,
 ) Keeper {
 	_, err := sdk.AccAddressFromBech32(authority)
@@ -41,12 +121,188 @@ func NewKeeper(
 
 	sb := collections.NewSchemaBuilder(storeService)
 
+	policies, err := lru.New[uint64, *coretypes.Policy](policyCacheSize)
+	if err != nil {
+		panic(err)
+	}
+
 	return Keeper{
 		cdc:           cdc,
 		storeService:  storeService,
 		IcaCtrlKeeper: icaCtrlKeeper,
+		bankKeeper:    bankKeeper,
+		distrKeeper:   distrKeeper,
 		authority:     authority,
+		hooks:         &types.MultiSourcehubHooks{},
+		policies:      policies,
 		Params:        collections.NewItem(sb, types.KeyPrefixParams, "params", codec.CollValue[types.Params](cdc)),
+		IcaPackets: collections.NewMap(
+			sb,
+			types.KeyPrefixIcaPackets,
+			"ica_packets",
+			collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
+			codec.CollValue[types.IcaPacket](cdc),
+		),
+		Grants: collections.NewMap(
+			sb,
+			types.KeyPrefixGrants,
+			"grants",
+			grantKeyCodec,
+			codec.CollValue[types.StreamGrant](cdc),
+		),
+		GrantsByExpiration: collections.NewKeySet(
+			sb,
+			types.KeyPrefixGrantsByExpiration,
+			"grants_by_expiration",
+			collections.PairKeyCodec(collections.Uint64Key, grantKeyCodec),
+		),
+		RoleHolders: collections.NewKeySet(
+			sb,
+			types.KeyPrefixRoleHolders,
+			"role_holders",
+			collections.PairKeyCodec(collections.Int32Key, collections.StringKey),
+		),
+		Entities: collections.NewMap(
+			sb,
+			types.KeyPrefixEntities,
+			"entities",
+			entityKeyCodec,
+			codec.CollValue[types.Entity](cdc),
+		),
+		EntitiesByDid: collections.NewMap(
+			sb,
+			types.KeyPrefixEntitiesByDid,
+			"entities_by_did",
+			collections.PairKeyCodec(collections.StringKey, collections.Int32Key),
+			collcodec.KeyToValueCodec(sdk.AccAddressKey),
+		),
+		EntitiesByPid: collections.NewMap(
+			sb,
+			types.KeyPrefixEntitiesByPid,
+			"entities_by_pid",
+			collections.PairKeyCodec(collections.StringKey, collections.Int32Key),
+			collcodec.KeyToValueCodec(sdk.AccAddressKey),
+		),
+		EntityCommands: collections.NewMap(
+			sb,
+			types.KeyPrefixEntityCommands,
+			"entity_commands",
+			collections.Uint64Key,
+			collcodec.KeyToValueCodec(entityKeyCodec),
+		),
+		IcaCommands: collections.NewMap(
+			sb,
+			types.KeyPrefixIcaCommands,
+			"ica_commands",
+			collections.Uint64Key,
+			codec.CollValue[types.IcaCommand](cdc),
+		),
+		NextCommandId: collections.NewSequence(sb, types.KeyPrefixNextCommandId, "next_command_id"),
+		Outbox: collections.NewKeySet(
+			sb,
+			types.KeyPrefixOutbox,
+			"outbox",
+			collections.Uint64Key,
+		),
+		DeadLetters: collections.NewKeySet(
+			sb,
+			types.KeyPrefixDeadLetters,
+			"dead_letters",
+			collections.Uint64Key,
+		),
+		Targets: collections.NewMap(
+			sb,
+			types.KeyPrefixTargets,
+			"targets",
+			collections.StringKey,
+			codec.CollValue[types.SourcehubTarget](cdc),
+		),
+		DefaultTarget: collections.NewItem(
+			sb,
+			types.KeyPrefixDefaultTarget,
+			"default_target",
+			collections.StringValue,
+		),
+		UsedRegistrations: collections.NewKeySet(
+			sb,
+			types.KeyPrefixUsedRegistrations,
+			"used_registrations",
+			collections.PairKeyCodec(collections.Int64Key, collections.BytesKey),
+		),
+		Views: collections.NewMap(
+			sb,
+			types.KeyPrefixViews,
+			"views",
+			collections.StringKey,
+			codec.CollValue[types.View](cdc),
+		),
+		Relationships: collections.NewMap(
+			sb,
+			types.KeyPrefixRelationships,
+			"relationships",
+			relationshipKeyCodec,
+			codec.CollValue[types.Relationship](cdc),
+		),
+		RelationshipsByActor: collections.NewKeySet(
+			sb,
+			types.KeyPrefixRelationshipsByActor,
+			"relationships_by_actor",
+			relationshipKeyCodec,
+		),
+		PolicyVersions: collections.NewMap(
+			sb,
+			types.KeyPrefixPolicyVersions,
+			"policy_versions",
+			collections.Uint64Key,
+			codec.CollValue[types.PolicyVersion](cdc),
+		),
+		StreamPrices: collections.NewMap(
+			sb,
+			types.KeyPrefixStreamPrices,
+			"stream_prices",
+			collections.PairKeyCodec(collections.Int32Key, collections.StringKey),
+			codec.CollValue[types.StreamPrice](cdc),
+		),
+		StreamPayments: collections.NewMap(
+			sb,
+			types.KeyPrefixStreamPayments,
+			"stream_payments",
+			collections.Uint64Key,
+			codec.CollValue[types.StreamPayment](cdc),
+		),
+		StreamRevenues: collections.NewMap(
+			sb,
+			types.KeyPrefixStreamRevenues,
+			"stream_revenues",
+			collections.PairKeyCodec(collections.Int32Key, collections.StringKey),
+			codec.CollValue[types.StreamRevenue](cdc),
+		),
+		PendingRewards: collections.NewMap(
+			sb,
+			types.KeyPrefixPendingRewards,
+			"pending_rewards",
+			sdk.AccAddressKey,
+			codec.CollValue[types.PendingReward](cdc),
+		),
+		EntityUnbondings: collections.NewMap(
+			sb,
+			types.KeyPrefixEntityUnbondings,
+			"entity_unbondings",
+			collections.TripleKeyCodec(collections.Uint64Key, sdk.AccAddressKey, collections.Int32Key),
+			codec.CollValue[types.EntityUnbonding](cdc),
+		),
+		RevocationCursor: collections.NewItem(
+			sb,
+			types.KeyPrefixRevocationCursor,
+			"revocation_cursor",
+			collcodec.KeyToValueCodec(collections.PairKeyCodec(collections.Uint64Key, grantKeyCodec)),
+		),
+		RevenueCursor: collections.NewItem(
+			sb,
+			types.KeyPrefixRevenueCursor,
+			"revenue_cursor",
+			collcodec.KeyToValueCodec(collections.PairKeyCodec(collections.Int32Key, collections.StringKey)),
+		),
 	}
 }
 
@@ -102,25 +358,24 @@ func (k Keeper) RegisterEntity(
 	address []byte, // tx signer address (raw bytes, not bech32 string)
 ) ([]byte, []byte, error) {
 
-	connectionID := k.GetControllerConnectionID(ctx)
-	if connectionID == "" {
-		return nil, nil, fmt.Errorf("no connection ID set in module state")
+	role := entity
+	if _, ok := types.EntityRole_name[int32(role)]; !ok {
+		return nil, nil, fmt.Errorf("invalid entity %d, expected 0 (indexer) or 1 (host)", role)
 	}
 
-	portID := fmt.Sprintf("icacontroller-%s", types.ModuleAddress.String())
+	owner := sdk.AccAddress(address)
+	entityRole := types.EntityRole(role)
 
-	addr, _ := k.IcaCtrlKeeper.GetInterchainAccountAddress(ctx, connectionID, portID)
-	if addr == "" {
-		return nil, nil, fmt.Errorf("ICA address not found for portID %s on connection %s", portID, connectionID)
+	registration, err := k.useRegistrationMessage(ctx, message, owner, entityRole)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	policyId := k.GetPolicyId(ctx)
-	if policyId == "" {
-		return nil, nil, fmt.Errorf("no policy ID set in module state")
+	t, addr, policyId, err := k.targetAccount(ctx, registration.Target)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	role := entity
-
 	if err := verifyPeerKeySignature(peerKeyPubkey, message, peerKeySignature); err != nil {
 		return nil, nil, err
 	}
@@ -139,25 +394,32 @@ func (k Keeper) RegisterEntity(
 		return nil, nil, err
 	}
 
-	didBytes := []byte(did)
-	pidBytes := []byte(pid)
-
-	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-
-	addrKey := addrRoleKey(address, role)
-	didKey := didRoleKey(didBytes, role)
-
-	existingDidForAddr := store.Get(addrKey)
-	if len(existingDidForAddr) > 0 {
-		if !bytesEqual(existingDidForAddr, didBytes) {
+	existing, found := k.GetEntity(ctx, owner, entityRole)
+	if found {
+		if existing.Status == types.EntityStatus_ENTITY_STATUS_BLOCKED {
+			return nil, nil, fmt.Errorf("address is blocked for this role")
+		}
+		if existing.Did != did {
 			return nil, nil, fmt.Errorf("address already registered for this role with a different DID")
 		}
+		if existing.Target != "" && existing.Target != t.ChainId {
+			return nil, nil, fmt.Errorf("address already registered for this role on target %s", existing.Target)
+		}
+	}
+
+	if existingOwner, err := k.EntitiesByDid.Get(ctx, collections.Join(did, int32(entityRole))); err == nil && !existingOwner.Equals(owner) {
+		return nil, nil, fmt.Errorf("DID already registered for this role with a different address")
 	}
 
-	existingAddrForDid := store.Get(didKey)
-	if len(existingAddrForDid) > 0 {
-		if !bytesEqual(existingAddrForDid, address) {
-			return nil, nil, fmt.Errorf("DID already registered for this role with a different address")
+	if existingOwner, err := k.EntitiesByPid.Get(ctx, collections.Join(pid, int32(entityRole))); err == nil && !existingOwner.Equals(owner) {
+		return nil, nil, fmt.Errorf("PID already registered for this role with a different address")
+	}
+
+	// An entity registering again keeps the bond it locked the first time.
+	bond := existing.Bond
+	if !found {
+		if bond, err = k.lockEntityBond(ctx, owner, entityRole); err != nil {
+			return nil, nil, err
 		}
 	}
 
@@ -167,96 +429,103 @@ func (k Keeper) RegisterEntity(
 		acptypes.NewSetRelationshipCmd(coretypes.NewActorRelationship("group", RoleToString(role), "guest", did)),
 	)
 
-	anyMsg, err := codectypes.NewAnyWithValue(cmd)
+	command, err := k.queueCommand(ctx, t.ChainId, types.PacketKind_PACKET_KIND_REGISTER_ENTITY, owner.String(), cmd)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	cosmosTx := &icatypes.CosmosTx{Messages: []*codectypes.Any{anyMsg}}
-	bz, err := gogoproto.Marshal(cosmosTx)
-	if err != nil {
+	e := types.Entity{
+		Owner:                 owner.String(),
+		Role:                  entityRole,
+		Did:                   did,
+		Pid:                   pid,
+		PeerKeyPubkey:         peerKeyPubkey,
+		NodeIdentityKeyPubkey: nodeIdentityKeyPubkey,
+		Message:               message,
+		Height:                ctx.BlockHeight(),
+		Status:                types.EntityStatus_ENTITY_STATUS_PENDING,
+		Target:                t.ChainId,
+		Bond:                  bond,
+	}
+	if err := k.SetEntity(ctx, e); err != nil {
 		return nil, nil, err
 	}
 
-	packetData := icatypes.InterchainAccountPacketData{
-		Type: icatypes.EXECUTE_TX,
-		Data: bz,
-		Memo: "",
+	if err := k.setEntityCommand(ctx, command.Id, entityKey(owner, entityRole)); err != nil {
+		return nil, nil, err
 	}
 
-	timeout := uint64(ctx.BlockTime().Add(5 * time.Minute).UnixNano())
-
-	_, err = k.IcaCtrlKeeper.SendTx(ctx, connectionID, portID, packetData, timeout)
-	if err != nil {
+	if err := k.Hooks().AfterEntityRegistered(ctx, e); err != nil {
 		return nil, nil, err
 	}
 
-	store.Set(addrKey, didBytes)
-	store.Set(didKey, address)
-
-	return didBytes, pidBytes, nil
+	return []byte(did), []byte(pid), nil
 }
 
 func (k Keeper) GetDidForAddressRole(ctx sdk.Context, address []byte, role uint8) ([]byte, bool) {
-	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	v := store.Get(addrRoleKey(address, role))
-	if len(v) == 0 {
+	e, found := k.GetEntity(ctx, address, types.EntityRole(role))
+	if !found {
 		return nil, false
 	}
-	return v, true
+	return []byte(e.Did), true
 }
 
-func (k Keeper) GetDidByAddress(ctx sdk.Context, address []byte) ([]byte, error) {
-	return []byte{}, fmt.Errorf("")
-}
-
-func (k Keeper) GetPidByAddress(ctx sdk.Context, address []byte) ([]byte, error) {
-	return []byte{}, fmt.Errorf("")
-}
-
-func (k Keeper) RegisterObject(ctx sdk.Context, id string) error {
-	connectionID := k.GetControllerConnectionID(ctx)
-	if connectionID == "" {
-		return fmt.Errorf("no connection ID set in module state")
+// RegisterObject registers the view id on the SourceHub target, the default
+// target if empty. The view's parent relationships to the collections its
+// query selects and its creator relationship to the DID of the creator's
+// entity, if any, are sent in the same command. A view ID belongs to a single target, registering
+// it on another one fails.
+func (k Keeper) RegisterObject(ctx sdk.Context, target, id string, creator []byte, query string) error {
+	t, addr, policyId, err := k.targetAccount(ctx, target)
+	if err != nil {
+		return err
 	}
 
-	portID := fmt.Sprintf("icacontroller-%s", types.ModuleAddress.String())
-
-	addr, _ := k.IcaCtrlKeeper.GetInterchainAccountAddress(ctx, connectionID, portID)
-	if addr == "" {
-		return fmt.Errorf("ICA address not found for portID %s on connection %s", portID, connectionID)
+	if v, err := k.Views.Get(ctx, id); err == nil && v.Target != t.ChainId {
+		return types.ErrInvalidView.Wrapf("view %s is registered on target %s", id, v.Target)
 	}
 
-	policyId := k.GetPolicyId(ctx)
-	if policyId == "" {
-		return fmt.Errorf("no policy ID set in module state")
+	parents, err := k.viewParents(ctx, t, addr, query)
+	if err != nil {
+		return err
 	}
 
-	cmd := acptypes.NewMsgDirectPolicyCmd(
-		addr,
-		policyId,
-		acptypes.NewRegisterObjectCmd(coretypes.NewObject(types.ViewResourceName, id)),
-	)
+	msgs := []gogoproto.Message{
+		acptypes.NewMsgDirectPolicyCmd(
+			addr,
+			policyId,
+			acptypes.NewRegisterObjectCmd(coretypes.NewObject(types.ViewResourceName, id)),
+		),
+	}
+	for _, parent := range parents {
+		msgs = append(msgs, acptypes.NewMsgDirectPolicyCmd(
+			addr,
+			policyId,
+			acptypes.NewSetRelationshipCmd(coretypes.NewRelationship(types.ViewResourceName, id, parentRelation, parent.Resource, parent.Id)),
+		))
+	}
 
-	anyMsg, err := codectypes.NewAnyWithValue(cmd)
+	entities, err := k.GetEntitiesByAddress(ctx, creator)
 	if err != nil {
 		return err
 	}
+	if len(entities) > 0 {
+		msgs = append(msgs, acptypes.NewMsgDirectPolicyCmd(
+			addr,
+			policyId,
+			acptypes.NewSetRelationshipCmd(coretypes.NewActorRelationship(types.ViewResourceName, id, creatorRelation, entities[0].Did)),
+		))
+	}
 
-	cosmosTx := &icatypes.CosmosTx{Messages: []*codectypes.Any{anyMsg}}
-	bz, err := gogoproto.Marshal(cosmosTx)
+	_, err = k.queueCommand(ctx, t.ChainId, types.PacketKind_PACKET_KIND_REGISTER_OBJECT, sdk.AccAddress(creator).String(), msgs...)
 	if err != nil {
 		return err
 	}
 
-	packetData := icatypes.InterchainAccountPacketData{
-		Type: icatypes.EXECUTE_TX,
-		Data: bz,
-		Memo: "",
-	}
-
-	timeout := uint64(ctx.BlockTime().Add(5 * time.Minute).UnixNano())
-
-	_, err = k.IcaCtrlKeeper.SendTx(ctx, connectionID, portID, packetData, timeout)
-	return err
+	return k.Views.Set(ctx, id, types.View{
+		Id:      id,
+		Creator: sdk.AccAddress(creator).String(),
+		Height:  ctx.BlockHeight(),
+		Target:  t.ChainId,
+	})
 }
@@ -0,0 +1,207 @@
+package keeper
+
+import (
+	"context"
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/shinzonetwork/shinzohub/x/sourcehub/types"
+)
+
+const (
+	testIcaAddress = "source1icaaddress"
+	testChannelID  = "channel-0"
+	testChainID    = "shinzo_9000-1"
+	testTarget     = "sourcehub-dev"
+)
+
+var (
+	testOutsider         = sdk.AccAddress("outsider____________").String()
+	communityPoolAddress = authtypes.NewModuleAddress(distrtypes.ModuleName)
+)
+
+// mockICAControllerKeeper records every packet sent by the module and
+// resolves interchain account addresses and active channels from in-memory
+// maps. Connections without a channel use testChannelID.
+type mockICAControllerKeeper struct {
+	addresses map[string]string
+	channels  map[string]string
+	sent      []icatypes.InterchainAccountPacketData
+	sequence  uint64
+	sendErr   error
+
+	registerErr error
+}
+
+// mockBankKeeper holds account balances in memory, the module account under
+// types.ModuleAddress.
+type mockBankKeeper struct {
+	balances map[string]sdk.Coins
+}
+
+func (m *mockBankKeeper) send(from, to sdk.AccAddress, amt sdk.Coins) error {
+	balance, negative := m.balances[from.String()].SafeSub(amt...)
+	if negative {
+		return sdkerrors.ErrInsufficientFunds.Wrapf("%s is smaller than %s", m.balances[from.String()], amt)
+	}
+	m.balances[from.String()] = balance
+	m.balances[to.String()] = m.balances[to.String()].Add(amt...)
+	return nil
+}
+
+func (m *mockBankKeeper) GetAllBalances(_ context.Context, addr sdk.AccAddress) sdk.Coins {
+	return m.balances[addr.String()]
+}
+
+func (m *mockBankKeeper) SendCoinsFromAccountToModule(_ context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	return m.send(senderAddr, authtypes.NewModuleAddress(recipientModule), amt)
+}
+
+func (m *mockBankKeeper) SendCoinsFromModuleToAccount(_ context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	return m.send(authtypes.NewModuleAddress(senderModule), recipientAddr, amt)
+}
+
+// mockDistrKeeper funds the community pool, held by the distribution module
+// account of the bank keeper.
+type mockDistrKeeper struct {
+	bank *mockBankKeeper
+}
+
+func (m mockDistrKeeper) FundCommunityPool(_ context.Context, amount sdk.Coins, sender sdk.AccAddress) error {
+	return m.bank.send(sender, communityPoolAddress, amount)
+}
+
+// testBank returns the bank keeper of a keeper built by setupKeeper.
+func testBank(k Keeper) *mockBankKeeper {
+	return k.bankKeeper.(*mockBankKeeper)
+}
+
+func (m *mockICAControllerKeeper) RegisterInterchainAccount(
+	_ sdk.Context,
+	connectionID string,
+	owner string,
+	_ string,
+	_ channeltypes.Order,
+) error {
+	if m.registerErr != nil {
+		return m.registerErr
+	}
+	m.addresses[connectionID+"/icacontroller-"+owner] = testIcaAddress
+	return nil
+}
+
+func (m *mockICAControllerKeeper) SendTx(
+	_ sdk.Context,
+	_ string,
+	_ string,
+	icaPacketData icatypes.InterchainAccountPacketData,
+	_ uint64,
+) (uint64, error) {
+	if m.sendErr != nil {
+		return 0, m.sendErr
+	}
+	m.sequence++
+	m.sent = append(m.sent, icaPacketData)
+	return m.sequence, nil
+}
+
+func (m *mockICAControllerKeeper) GetInterchainAccountAddress(
+	_ sdk.Context,
+	connectionID string,
+	portID string,
+) (string, bool) {
+	addr, ok := m.addresses[connectionID+"/"+portID]
+	return addr, ok
+}
+
+func (m *mockICAControllerKeeper) GetActiveChannelID(
+	_ sdk.Context,
+	connectionID string,
+	_ string,
+) (string, bool) {
+	if channelID, ok := m.channels[connectionID]; ok {
+		return channelID, true
+	}
+	return testChannelID, true
+}
+
+func setupKeeper(t *testing.T) (Keeper, sdk.Context, *mockICAControllerKeeper) {
+	t.Helper()
+
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+
+	ica := &mockICAControllerKeeper{addresses: map[string]string{}, channels: map[string]string{}}
+	bank := &mockBankKeeper{balances: map[string]sdk.Coins{}}
+
+	k := NewKeeper(
+		encCfg.Codec,
+		runtime.NewKVStoreService(key),
+		ica,
+		bank,
+		mockDistrKeeper{bank: bank},
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	return k, testCtx.Ctx.WithChainID(testChainID), ica
+}
+
+// setupKeeperWithICA returns a keeper with a default SourceHub target that
+// has a registered interchain account and a policy ID, ready to send ACP
+// commands.
+func setupKeeperWithICA(t *testing.T) (Keeper, sdk.Context, *mockICAControllerKeeper) {
+	t.Helper()
+
+	k, ctx, ica := setupKeeper(t)
+	k.InitGenesis(ctx, *types.DefaultGenesis())
+	addTarget(t, k, ctx, ica, testTarget, "connection-0")
+	require.NoError(t, k.SetDefaultTarget(ctx, testTarget))
+
+	return k, ctx, ica
+}
+
+// addTarget registers a SourceHub target on connectionID with an interchain
+// account and the policy ID "policy-1".
+func addTarget(t *testing.T, k Keeper, ctx sdk.Context, ica *mockICAControllerKeeper, chainId, connectionID string) {
+	t.Helper()
+
+	target := types.NewSourcehubTarget(chainId, connectionID, connectionID)
+	target.PolicyId = "policy-1"
+	require.NoError(t, k.SetTarget(ctx, target))
+	ica.addresses[connectionID+"/"+k.GetICAPortID()] = testIcaAddress
+}
+
+// lastTypedEvent decodes the last event of ev's type emitted on ctx into ev
+// and reports whether there was one.
+func lastTypedEvent(t *testing.T, ctx sdk.Context, ev gogoproto.Message) bool {
+	t.Helper()
+
+	events := ctx.EventManager().ABCIEvents()
+	for i := len(events) - 1; i >= 0; i-- {
+		if events[i].Type != gogoproto.MessageName(ev) {
+			continue
+		}
+		parsed, err := sdk.ParseTypedEvent(events[i])
+		require.NoError(t, err)
+		// Merge cannot copy math.Int amounts, go through the wire format.
+		bz, err := gogoproto.Marshal(parsed)
+		require.NoError(t, err)
+		require.NoError(t, gogoproto.Unmarshal(bz, ev))
+		return true
+	}
+	return false
+}
@@ -0,0 +1,193 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/shinzonetwork/shinzohub/x/sourcehub/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(k Keeper) Migrator {
+	return Migrator{keeper: k}
+}
+
+// Migrate1to2 moves the raw addr_role:/did_role: mappings written by v1 into
+// the entity store. Legacy registrations only recorded the owner and the DID,
+// so the remaining fields are left empty and the entity is marked active.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	store := runtime.KVStoreAdapter(m.keeper.storeService.OpenKVStore(ctx))
+
+	var (
+		legacyKeys [][]byte
+		entities   []types.Entity
+	)
+
+	iter := storetypes.KVStorePrefixIterator(store, []byte(types.AddrRolePrefix))
+	for ; iter.Valid(); iter.Next() {
+		// addr_role:<address-bytes>:<role>
+		rest := iter.Key()[len(types.AddrRolePrefix):]
+		sep := bytes.LastIndexByte(rest, ':')
+		if sep < 0 {
+			iter.Close()
+			return fmt.Errorf("malformed legacy entity key %q", iter.Key())
+		}
+
+		role, err := strconv.ParseUint(string(rest[sep+1:]), 10, 8)
+		if err != nil {
+			iter.Close()
+			return fmt.Errorf("malformed legacy entity role in key %q: %w", iter.Key(), err)
+		}
+
+		entities = append(entities, types.Entity{
+			Owner:  sdk.AccAddress(rest[:sep]).String(),
+			Role:   types.EntityRole(role),
+			Did:    string(iter.Value()),
+			Status: types.EntityStatus_ENTITY_STATUS_ACTIVE,
+		})
+		legacyKeys = append(legacyKeys, bytes.Clone(iter.Key()))
+	}
+	iter.Close()
+
+	iter = storetypes.KVStorePrefixIterator(store, []byte(types.DIDRolePrefix))
+	for ; iter.Valid(); iter.Next() {
+		legacyKeys = append(legacyKeys, bytes.Clone(iter.Key()))
+	}
+	iter.Close()
+
+	for _, e := range entities {
+		if err := m.keeper.SetEntity(ctx, e); err != nil {
+			return err
+		}
+	}
+
+	for _, key := range legacyKeys {
+		store.Delete(key)
+	}
+
+	return nil
+}
+
+// Migrate2to3 moves the single SourceHub link of v2, stored as raw metadata
+// keys and the ICA channel item, into the "sourcehub" target and makes it the
+// default. Records written before the migration carry no target and keep
+// being sent to the default target.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	store := runtime.KVStoreAdapter(m.keeper.storeService.OpenKVStore(ctx))
+
+	get := func(key string) string {
+		return string(store.Get([]byte(key)))
+	}
+
+	t := types.SourcehubTarget{
+		ChainId:                types.LegacyTargetName,
+		ControllerConnectionId: get(types.KeyConnectionID),
+		HostConnectionId:       get(types.KeyHostConnectionID),
+		Version:                get(types.KeyVersion),
+		Encoding:               get(types.KeyEncoding),
+		TxType:                 get(types.KeyTxType),
+		PolicyId:               get(types.PolicyId),
+	}
+
+	if bz := store.Get(types.KeyPrefixIcaChannel); bz != nil {
+		if err := m.keeper.cdc.Unmarshal(bz, &t.Channel); err != nil {
+			return fmt.Errorf("malformed legacy ICA channel: %w", err)
+		}
+	}
+
+	if t.ControllerConnectionId != "" {
+		if err := m.keeper.SetTarget(ctx, t); err != nil {
+			return err
+		}
+		if err := m.keeper.SetDefaultTarget(ctx, t.ChainId); err != nil {
+			return err
+		}
+	}
+
+	for _, key := range []string{
+		types.KeyConnectionID,
+		types.KeyHostConnectionID,
+		types.KeyVersion,
+		types.KeyEncoding,
+		types.KeyTxType,
+		types.PolicyId,
+	} {
+		store.Delete([]byte(key))
+	}
+	store.Delete(types.KeyPrefixIcaChannel)
+
+	return nil
+}
+
+// Migrate3to4 keys the grants and the mirrored relationships of v3 by the
+// target they are held on, so that a subscriber can hold access to a stream
+// on several targets. Records written without a target move to the default
+// target. The revocation cursor points into the old keys and is dropped.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	store := runtime.KVStoreAdapter(m.keeper.storeService.OpenKVStore(ctx))
+
+	var (
+		legacyKeys    [][]byte
+		grants        []types.StreamGrant
+		relationships []types.Relationship
+	)
+
+	iter := storetypes.KVStorePrefixIterator(store, types.KeyPrefixGrantsV3.Bytes())
+	for ; iter.Valid(); iter.Next() {
+		var g types.StreamGrant
+		if err := m.keeper.cdc.Unmarshal(iter.Value(), &g); err != nil {
+			iter.Close()
+			return fmt.Errorf("malformed legacy stream grant %q: %w", iter.Key(), err)
+		}
+		grants = append(grants, g)
+		legacyKeys = append(legacyKeys, bytes.Clone(iter.Key()))
+	}
+	iter.Close()
+
+	iter = storetypes.KVStorePrefixIterator(store, types.KeyPrefixRelationshipsV3.Bytes())
+	for ; iter.Valid(); iter.Next() {
+		var r types.Relationship
+		if err := m.keeper.cdc.Unmarshal(iter.Value(), &r); err != nil {
+			iter.Close()
+			return fmt.Errorf("malformed legacy relationship %q: %w", iter.Key(), err)
+		}
+		relationships = append(relationships, r)
+		legacyKeys = append(legacyKeys, bytes.Clone(iter.Key()))
+	}
+	iter.Close()
+
+	for _, prefix := range [][]byte{types.KeyPrefixGrantsByExpirationV3.Bytes(), types.KeyPrefixRelationshipsByActorV3.Bytes()} {
+		iter = storetypes.KVStorePrefixIterator(store, prefix)
+		for ; iter.Valid(); iter.Next() {
+			legacyKeys = append(legacyKeys, bytes.Clone(iter.Key()))
+		}
+		iter.Close()
+	}
+
+	for _, key := range legacyKeys {
+		store.Delete(key)
+	}
+
+	for _, g := range grants {
+		if err := m.keeper.SetGrant(ctx, g); err != nil {
+			return err
+		}
+	}
+	for _, r := range relationships {
+		if err := m.keeper.SetRelationship(ctx, r); err != nil {
+			return err
+		}
+	}
+
+	return m.keeper.RevocationCursor.Remove(ctx)
+}
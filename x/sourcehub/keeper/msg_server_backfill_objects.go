@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/shinzonetwork/shinzohub/x/sourcehub/types"
+)
+
+func (m msgServer) BackfillObjects(goCtx context.Context, msg *types.MsgBackfillObjects) (*types.MsgBackfillObjectsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !m.Keeper.IsAdmin(ctx, msg.Signer) {
+		return nil, sdkerrors.ErrUnauthorized.Wrap("admin required")
+	}
+
+	t, backfilled, err := m.Keeper.BackfillObjects(ctx, msg.Target, msg.Resource, msg.Ids)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventObjectsBackfilled{
+		Target:   t.ChainId,
+		Signer:   msg.Signer,
+		Resource: msg.Resource,
+		Ids:      backfilled,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgBackfillObjectsResponse{Backfilled: uint64(len(backfilled))}, nil
+}
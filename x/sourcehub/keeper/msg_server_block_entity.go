@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/shinzonetwork/shinzohub/x/sourcehub/types"
+)
+
+func (m msgServer) BlockEntity(goCtx context.Context, msg *types.MsgBlockEntity) (*types.MsgBlockEntityResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !m.Keeper.IsAdmin(ctx, msg.Signer) {
+		return nil, sdkerrors.ErrUnauthorized.Wrap("admin required")
+	}
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	e, found := m.Keeper.GetEntity(ctx, owner, msg.Role)
+	if !found {
+		return nil, sdkerrors.ErrNotFound.Wrapf("no %s registered for address %s", RoleToString(uint8(msg.Role)), msg.Owner)
+	}
+
+	slashed, err := m.Keeper.BlockEntity(ctx, owner, msg.Role, msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventEntityBlocked{
+		Owner:   msg.Owner,
+		Role:    msg.Role,
+		Did:     e.Did,
+		Signer:  msg.Signer,
+		Slashed: slashed,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgBlockEntityResponse{Slashed: slashed}, nil
+}
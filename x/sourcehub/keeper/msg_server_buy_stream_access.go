@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/shinzonetwork/shinzohub/x/sourcehub/types"
+)
+
+func (m msgServer) BuyStreamAccess(goCtx context.Context, msg *types.MsgBuyStreamAccess) (*types.MsgBuyStreamAccessResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	price, found := m.Keeper.GetStreamPrice(ctx, msg.Resource, msg.StreamId)
+	if !found {
+		return nil, fmt.Errorf("stream %s is not for sale", msg.StreamId)
+	}
+
+	now := uint64(ctx.BlockTime().Unix())
+
+	// The relationship already exists on SourceHub, the payment only moves
+	// the expiration of the grant and is settled at once. A grant whose
+	// relationship failed is bought again.
+	grant, found := m.Keeper.GetGrant(ctx, msg.Target, msg.Resource, msg.StreamId, msg.Did)
+	confirmed := false
+	if found {
+		var err error
+		if confirmed, err = m.Keeper.grantConfirmed(ctx, grant); err != nil {
+			return nil, err
+		}
+	}
+	if confirmed {
+		if grant.Expiration == 0 {
+			return nil, fmt.Errorf("%s already has access to stream %s that never expires", msg.Did, msg.StreamId)
+		}
+
+		if err := m.Keeper.lockPayment(ctx, msg.Buyer, price.Amount); err != nil {
+			return nil, err
+		}
+		if err := m.Keeper.addStreamRevenue(ctx, msg.Resource, msg.StreamId, price.Amount); err != nil {
+			return nil, err
+		}
+
+		grant, err := m.Keeper.extendStreamAccess(ctx, grant, accessExpiration(now, grant.Expiration, price.Duration), msg.Buyer)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventStreamAccessPurchased{
+			Target:     grant.Target,
+			Resource:   grant.Resource,
+			StreamId:   grant.StreamId,
+			Did:        grant.Did,
+			Buyer:      msg.Buyer,
+			Amount:     price.Amount,
+			Expiration: grant.Expiration,
+		}); err != nil {
+			return nil, err
+		}
+
+		return &types.MsgBuyStreamAccessResponse{Expiration: grant.Expiration}, nil
+	}
+
+	// Lock the funds before the subscriber relationship is queued, they are
+	// refunded if SourceHub never sets it.
+	if err := m.Keeper.lockPayment(ctx, msg.Buyer, price.Amount); err != nil {
+		return nil, err
+	}
+
+	// Access bought again keeps the time left on the failed grant.
+	command, grant, err := m.Keeper.grantStreamAccess(ctx, msg.Target, msg.Resource, msg.StreamId, msg.Did, accessExpiration(now, grant.Expiration, price.Duration), msg.Buyer)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.Keeper.StreamPayments.Set(ctx, command.Id, types.StreamPayment{
+		CommandId: command.Id,
+		Payer:     msg.Buyer,
+		Resource:  msg.Resource,
+		StreamId:  msg.StreamId,
+		Did:       msg.Did,
+		Amount:    price.Amount,
+		Height:    ctx.BlockHeight(),
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventStreamAccessPurchased{
+		Target:     grant.Target,
+		Resource:   grant.Resource,
+		StreamId:   grant.StreamId,
+		Did:        grant.Did,
+		Buyer:      msg.Buyer,
+		Amount:     price.Amount,
+		Expiration: grant.Expiration,
+		CommandId:  command.Id,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgBuyStreamAccessResponse{Expiration: grant.Expiration}, nil
+}
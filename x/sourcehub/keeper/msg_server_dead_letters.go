@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/shinzonetwork/shinzohub/x/sourcehub/types"
+)
+
+func (m msgServer) PurgeDeadLetters(goCtx context.Context, msg *types.MsgPurgeDeadLetters) (*types.MsgPurgeDeadLettersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !m.Keeper.IsAdmin(ctx, msg.Signer) {
+		return nil, sdkerrors.ErrUnauthorized.Wrap("admin required")
+	}
+
+	purged, err := m.Keeper.PurgeDeadLetters(ctx, msg.Ids)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventDeadLettersPurged{CommandIds: purged}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgPurgeDeadLettersResponse{Purged: uint64(len(purged))}, nil
+}
@@ -3,13 +3,8 @@ package keeper
 import (
 	"context"
 	"fmt"
-	"time"
 
-	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
-	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
-
 	gogoproto "github.com/cosmos/gogoproto/proto"
 
 	"github.com/shinzonetwork/shinzohub/x/sourcehub/types"
@@ -24,90 +19,56 @@ func (m msgServer) RegisterShinzoObjects(
 ) (*types.MsgRegisterShinzoObjectsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	if !m.Keeper.IsAdmin(ctx, msg.Signer) {
-		return nil, sdkerrors.ErrUnauthorized.Wrap("admin required")
-	}
-
-	connectionID := m.Keeper.GetControllerConnectionID(ctx)
-	if connectionID == "" {
-		return nil, fmt.Errorf("no connection ID set in module state")
-	}
-	portID := fmt.Sprintf("icacontroller-%s", types.ModuleAddress.String())
-
-	addr, _ := m.Keeper.IcaCtrlKeeper.GetInterchainAccountAddress(ctx, connectionID, portID)
-	if addr == "" {
-		return nil, fmt.Errorf("ICA address not found for portID %s on connection %s", portID, connectionID)
+	if err := m.Keeper.requireRole(ctx, msg.Signer, types.ModuleRole_MODULE_ROLE_OBJECT_REGISTRAR); err != nil {
+		return nil, err
 	}
 
-	policyID := m.Keeper.GetPolicyId(ctx)
-	if policyID == "" {
-		return nil, fmt.Errorf("no policy ID set in module state")
+	t, addr, policyID, err := m.Keeper.targetAccount(ctx, msg.Target)
+	if err != nil {
+		return nil, err
 	}
 
-	var anyMsgs []*codectypes.Any
+	var cmds []gogoproto.Message
 
 	for _, r := range msg.Resources {
-		cmd := acptypes.NewMsgDirectPolicyCmd(
+		cmds = append(cmds, acptypes.NewMsgDirectPolicyCmd(
 			addr,
 			policyID,
 			acptypes.NewRegisterObjectCmd(
 				coretypes.NewObject(types.PrimitiveResourceName, r),
 			),
-		)
-
-		anyMsg, err := codectypes.NewAnyWithValue(cmd)
-		if err != nil {
-			return nil, fmt.Errorf("wrap primitive %q: %w", r, err)
-		}
-
-		anyMsgs = append(anyMsgs, anyMsg)
+		))
 	}
 
-	{
-		cmd := acptypes.NewMsgDirectPolicyCmd(
+	cmds = append(cmds,
+		acptypes.NewMsgDirectPolicyCmd(
 			addr,
 			policyID,
 			acptypes.NewRegisterObjectCmd(
 				coretypes.NewObject(types.GroupObjectName, types.GroupIndexerName),
 			),
-		)
-		anyMsg, err := codectypes.NewAnyWithValue(cmd)
-		if err != nil {
-			return nil, fmt.Errorf("wrap group indexer: %w", err)
-		}
-		anyMsgs = append(anyMsgs, anyMsg)
-	}
-	{
-		cmd := acptypes.NewMsgDirectPolicyCmd(
+		),
+		acptypes.NewMsgDirectPolicyCmd(
 			addr,
 			policyID,
 			acptypes.NewRegisterObjectCmd(
 				coretypes.NewObject(types.GroupObjectName, types.GroupHostName),
 			),
-		)
-		anyMsg, err := codectypes.NewAnyWithValue(cmd)
-		if err != nil {
-			return nil, fmt.Errorf("wrap group host: %w", err)
-		}
-		anyMsgs = append(anyMsgs, anyMsg)
-	}
+		),
+	)
 
-	cosmosTx := &icatypes.CosmosTx{Messages: anyMsgs}
-	bz, err := gogoproto.Marshal(cosmosTx)
+	command, err := m.Keeper.queueCommand(ctx, t.ChainId, types.PacketKind_PACKET_KIND_REGISTER_OBJECTS, msg.Signer, cmds...)
 	if err != nil {
-		return nil, fmt.Errorf("marshal CosmosTx: %w", err)
-	}
-
-	packetData := icatypes.InterchainAccountPacketData{
-		Type: icatypes.EXECUTE_TX,
-		Data: bz,
-		Memo: "",
+		return nil, fmt.Errorf("ICA SendTx: %w", err)
 	}
 
-	timeout := uint64(ctx.BlockTime().Add(5 * time.Minute).UnixNano())
-	_, err = m.Keeper.IcaCtrlKeeper.SendTx(ctx, connectionID, portID, packetData, timeout)
-	if err != nil {
-		return nil, fmt.Errorf("ICA SendTx: %w", err)
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventObjectsSubmitted{
+		Target:    t.ChainId,
+		Sender:    msg.Signer,
+		Resources: msg.Resources,
+		CommandId: command.Id,
+	}); err != nil {
+		return nil, err
 	}
 
 	return &types.MsgRegisterShinzoObjectsResponse{}, nil
@@ -2,14 +2,8 @@ package keeper
 
 import (
 	"context"
-	"fmt"
-	"time"
 
-	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
-	gogoproto "github.com/cosmos/gogoproto/proto"
-	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
 	"github.com/shinzonetwork/shinzohub/x/sourcehub/types"
 	coretypes "github.com/sourcenetwork/acp_core/pkg/types"
 	acptypes "github.com/sourcenetwork/sourcehub/x/acp/types"
@@ -18,51 +12,46 @@ import (
 func (m msgServer) RegisterShinzoPolicy(goCtx context.Context, msg *types.MsgRegisterShinzoPolicy) (*types.MsgRegisterShinzoPolicyResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	if !m.Keeper.IsAdmin(ctx, msg.Signer) {
-		return nil, sdkerrors.ErrUnauthorized.Wrap("admin required")
+	if err := m.Keeper.requireRole(ctx, msg.Signer, types.ModuleRole_MODULE_ROLE_POLICY_ADMIN); err != nil {
+		return nil, err
 	}
 
-	connectionID := m.Keeper.GetControllerConnectionID(ctx)
-	if connectionID == "" {
-		return &types.MsgRegisterShinzoPolicyResponse{}, fmt.Errorf("no connection ID set in module state")
+	t, err := m.Keeper.resolveTarget(ctx, msg.Target)
+	if err != nil {
+		return &types.MsgRegisterShinzoPolicyResponse{}, err
 	}
 
-	portID := fmt.Sprintf("icacontroller-%s", types.ModuleAddress.String())
+	addr, err := m.Keeper.requireICAAddress(ctx, t)
+	if err != nil {
+		return &types.MsgRegisterShinzoPolicyResponse{}, err
+	}
 
-	addr, _ := m.Keeper.IcaCtrlKeeper.GetInterchainAccountAddress(ctx, connectionID, portID)
-	if addr == "" {
-		return &types.MsgRegisterShinzoPolicyResponse{},
-			fmt.Errorf("ICA address not found for portID %s on connection %s", portID, connectionID)
+	active, err := m.Keeper.ActivePolicy(ctx)
+	if err != nil {
+		return nil, err
 	}
 
 	mt := coretypes.PolicyMarshalingType_YAML
 
 	policyMsg := &acptypes.MsgCreatePolicy{
 		Creator:     addr,
-		Policy:      policy,
+		Policy:      active.Policy,
 		MarshalType: mt,
 	}
 
-	anyMsg, err := codectypes.NewAnyWithValue(policyMsg)
+	// The policy ID is captured once SourceHub acknowledged the command.
+	c, err := m.Keeper.queueCommand(ctx, t.ChainId, types.PacketKind_PACKET_KIND_REGISTER_POLICY, msg.Signer, policyMsg)
 	if err != nil {
 		return &types.MsgRegisterShinzoPolicyResponse{}, err
 	}
 
-	cosmosTx := &icatypes.CosmosTx{Messages: []*codectypes.Any{anyMsg}}
-	bz, err := gogoproto.Marshal(cosmosTx)
-	if err != nil {
-		return &types.MsgRegisterShinzoPolicyResponse{}, err
-	}
-
-	packetData := icatypes.InterchainAccountPacketData{
-		Type: icatypes.EXECUTE_TX,
-		Data: bz,
-		Memo: "",
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventPolicySubmitted{
+		Target:    t.ChainId,
+		Sender:    msg.Signer,
+		CommandId: c.Id,
+	}); err != nil {
+		return nil, err
 	}
 
-	timeout := uint64(ctx.BlockTime().Add(5 * time.Minute).UnixNano())
-
-	_, err = m.Keeper.IcaCtrlKeeper.SendTx(ctx, connectionID, portID, packetData, timeout)
-
-	return &types.MsgRegisterShinzoPolicyResponse{}, err
+	return &types.MsgRegisterShinzoPolicyResponse{}, nil
 }
@@ -2,33 +2,83 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
-	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
 	"github.com/shinzonetwork/shinzohub/x/sourcehub/types"
 )
 
-// RegisterSourcehubICA handles MsgRegisterSourcehubICA
+// RegisterSourcehubICA handles MsgRegisterSourcehubICA. Registering an existing
+// target again moves it to the given connections and opens a new channel.
 func (m msgServer) RegisterSourcehubICA(goCtx context.Context, msg *types.MsgRegisterSourcehubICA) (*types.MsgRegisterSourcehubICAResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	if !m.Keeper.IsAdmin(ctx, msg.Signer) {
-		return nil, sdkerrors.ErrUnauthorized.Wrap("admin required")
+	if err := m.Keeper.requireRole(ctx, msg.Signer, types.ModuleRole_MODULE_ROLE_POLICY_ADMIN); err != nil {
+		return nil, err
+	}
+
+	if other, found := m.Keeper.targetByConnection(ctx, msg.ControllerConnectionId); found && other.ChainId != msg.ChainId {
+		return nil, fmt.Errorf("connection %s is used by target %s", msg.ControllerConnectionId, other.ChainId)
+	}
+
+	t, found := m.Keeper.GetTarget(ctx, msg.ChainId)
+	if !found {
+		t = types.NewSourcehubTarget(msg.ChainId, msg.ControllerConnectionId, msg.HostConnectionId)
+	}
+	t.ControllerConnectionId = msg.ControllerConnectionId
+	t.HostConnectionId = msg.HostConnectionId
+
+	if err := m.Keeper.openICAChannel(ctx, t); err != nil {
+		return nil, err
 	}
 
-	m.Keeper.SetControllerConnectionID(ctx, msg.ControllerConnectionId)
-	m.Keeper.SetHostConnectionID(ctx, msg.HostConnectionId)
+	if m.Keeper.GetDefaultTarget(ctx) == "" {
+		if err := m.Keeper.SetDefaultTarget(ctx, t.ChainId); err != nil {
+			return nil, err
+		}
+	}
 
-	if err := m.Keeper.IcaCtrlKeeper.RegisterInterchainAccount(
-		ctx,
-		m.Keeper.GetControllerConnectionID(ctx),
-		types.ModuleAddress.String(),
-		m.Keeper.GetICAMetadata(ctx),
-		channeltypes.ORDERED,
-	); err != nil {
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventTargetRegistered{
+		Target:                 t.ChainId,
+		ControllerConnectionId: t.ControllerConnectionId,
+		HostConnectionId:       t.HostConnectionId,
+	}); err != nil {
 		return nil, err
 	}
 
 	return &types.MsgRegisterSourcehubICAResponse{}, nil
 }
+
+// ReopenICAChannel handles MsgReopenICAChannel
+func (m msgServer) ReopenICAChannel(goCtx context.Context, msg *types.MsgReopenICAChannel) (*types.MsgReopenICAChannelResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.Keeper.requireRole(ctx, msg.Signer, types.ModuleRole_MODULE_ROLE_POLICY_ADMIN); err != nil {
+		return nil, err
+	}
+
+	if err := m.Keeper.ReopenICAChannel(ctx, msg.Target); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgReopenICAChannelResponse{}, nil
+}
+
+// SetDefaultTarget handles MsgSetDefaultTarget
+func (m msgServer) SetDefaultTarget(goCtx context.Context, msg *types.MsgSetDefaultTarget) (*types.MsgSetDefaultTargetResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.Keeper.requireRole(ctx, msg.Signer, types.ModuleRole_MODULE_ROLE_POLICY_ADMIN); err != nil {
+		return nil, err
+	}
+
+	if err := m.Keeper.SetDefaultTarget(ctx, msg.ChainId); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventDefaultTargetSet{Target: msg.ChainId}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetDefaultTargetResponse{}, nil
+}
@@ -3,87 +3,73 @@ package keeper
 import (
 	"context"
 	"fmt"
-	"time"
 
-	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
-	gogoproto "github.com/cosmos/gogoproto/proto"
-	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
 	"github.com/shinzonetwork/shinzohub/x/sourcehub/types"
-	coretypes "github.com/sourcenetwork/acp_core/pkg/types"
-	acptypes "github.com/sourcenetwork/sourcehub/x/acp/types"
 )
 
 func (m msgServer) RequestStreamAccess(goCtx context.Context, msg *types.MsgRequestStreamAccess) (*types.MsgRequestStreamAccessResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	if !m.Keeper.IsAdmin(ctx, msg.Signer) {
-		return nil, sdkerrors.ErrUnauthorized.Wrap("admin required")
+	if err := m.Keeper.requireRole(ctx, msg.Signer, types.ModuleRole_MODULE_ROLE_ACCESS_GRANTER); err != nil {
+		return nil, err
 	}
 
-	connectionID := m.Keeper.GetControllerConnectionID(ctx)
-	if connectionID == "" {
-		return nil, fmt.Errorf("no connection ID set in module state")
-	}
-
-	portID := fmt.Sprintf("icacontroller-%s", types.ModuleAddress.String())
-
-	addr, _ := m.Keeper.IcaCtrlKeeper.GetInterchainAccountAddress(ctx, connectionID, portID)
-	if addr == "" {
-		return nil, fmt.Errorf("ICA address not found for portID %s on connection %s", portID, connectionID)
+	name, err := resourceName(msg.Resource)
+	if err != nil {
+		return nil, err
 	}
 
-	policyId := m.Keeper.GetPolicyId(ctx)
-	if policyId == "" {
-		return nil, fmt.Errorf("no policy ID set in module state")
+	if msg.Expiration != 0 && msg.Expiration <= uint64(ctx.BlockTime().Unix()) {
+		return nil, fmt.Errorf("expiration %d is not in the future", msg.Expiration)
 	}
 
 	actor := msg.Did
 
-	resMap := map[uint]string{0: "primitive", 1: "view"}
-
-	resource, ok := resMap[uint(msg.Resource)]
-	if !ok {
-		return nil, fmt.Errorf("invalid resource %q, expected \"0\" or \"1\"", msg.Resource)
+	// The relationship already exists on SourceHub, only move its expiration.
+	// A grant whose relationship is still pending or failed is sent again.
+	grant, found := m.Keeper.GetGrant(ctx, msg.Target, msg.Resource, msg.StreamId, actor)
+	if found && m.Keeper.HasRelationship(ctx, grant.Target, name, msg.StreamId, subscriberRelation, actor) {
+		grant.Expiration = msg.Expiration
+		grant.Signer = msg.Signer
+		if err := m.Keeper.SetGrant(ctx, grant); err != nil {
+			return nil, err
+		}
+
+		if err := m.Keeper.Hooks().AfterStreamAccessGranted(ctx, grant); err != nil {
+			return nil, err
+		}
+
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventStreamAccessRenewed{
+			Target:     grant.Target,
+			Resource:   grant.Resource,
+			StreamId:   grant.StreamId,
+			Did:        grant.Did,
+			Expiration: grant.Expiration,
+			Signer:     grant.Signer,
+		}); err != nil {
+			return nil, err
+		}
+
+		return &types.MsgRequestStreamAccessResponse{}, nil
 	}
 
-	cmd := acptypes.NewMsgDirectPolicyCmd(
-		addr,
-		policyId,
-		acptypes.NewSetRelationshipCmd(coretypes.NewActorRelationship(resource, msg.StreamId, "subscriber", actor)),
-	)
-
-	anyMsg, err := codectypes.NewAnyWithValue(cmd)
+	command, grant, err := m.Keeper.grantStreamAccess(ctx, msg.Target, msg.Resource, msg.StreamId, actor, msg.Expiration, msg.Signer)
 	if err != nil {
-		return &types.MsgRequestStreamAccessResponse{}, err
+		return nil, err
 	}
 
-	cosmosTx := &icatypes.CosmosTx{Messages: []*codectypes.Any{anyMsg}}
-	bz, err := gogoproto.Marshal(cosmosTx)
-	if err != nil {
-		return &types.MsgRequestStreamAccessResponse{}, err
-	}
-
-	packetData := icatypes.InterchainAccountPacketData{
-		Type: icatypes.EXECUTE_TX,
-		Data: bz,
-		Memo: "",
-	}
-
-	timeout := uint64(ctx.BlockTime().Add(5 * time.Minute).UnixNano())
-
-	_, err = m.Keeper.IcaCtrlKeeper.SendTx(ctx, connectionID, portID, packetData, timeout)
-
-	if err == nil {
-		ctx.EventManager().EmitEvent(
-			sdk.NewEvent(
-				"AccessRequestSuccess",
-				sdk.NewAttribute("did", actor),
-				sdk.NewAttribute("object", msg.StreamId),
-			),
-		)
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventStreamAccessRequested{
+		Target:     grant.Target,
+		Resource:   msg.Resource,
+		StreamId:   msg.StreamId,
+		Did:        actor,
+		Expiration: msg.Expiration,
+		Signer:     msg.Signer,
+		CommandId:  command.Id,
+	}); err != nil {
+		return nil, err
 	}
 
-	return &types.MsgRequestStreamAccessResponse{}, err
+	return &types.MsgRequestStreamAccessResponse{}, nil
 }
@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/shinzonetwork/shinzohub/x/sourcehub/types"
+)
+
+func (m msgServer) GrantRole(goCtx context.Context, msg *types.MsgGrantRole) (*types.MsgGrantRoleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !m.Keeper.IsAdmin(ctx, msg.Signer) {
+		return nil, sdkerrors.ErrUnauthorized.Wrap("admin required")
+	}
+
+	if err := m.Keeper.GrantRole(ctx, msg.Role, msg.Address); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventRoleGranted{
+		Role:    msg.Role,
+		Address: msg.Address,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgGrantRoleResponse{}, nil
+}
+
+func (m msgServer) RevokeRole(goCtx context.Context, msg *types.MsgRevokeRole) (*types.MsgRevokeRoleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !m.Keeper.IsAdmin(ctx, msg.Signer) {
+		return nil, sdkerrors.ErrUnauthorized.Wrap("admin required")
+	}
+
+	if err := m.Keeper.RevokeRole(ctx, msg.Role, msg.Address); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventRoleRevoked{
+		Role:    msg.Role,
+		Address: msg.Address,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRevokeRoleResponse{}, nil
+}
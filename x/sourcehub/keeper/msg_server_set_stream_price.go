@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/shinzonetwork/shinzohub/x/sourcehub/types"
+)
+
+func (m msgServer) SetStreamPrice(goCtx context.Context, msg *types.MsgSetStreamPrice) (*types.MsgSetStreamPriceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := resourceName(msg.Resource); err != nil {
+		return nil, err
+	}
+
+	if err := m.Keeper.requirePriceSetter(ctx, msg.Signer, msg.Resource, msg.StreamId); err != nil {
+		return nil, err
+	}
+
+	key := streamPriceKey(msg.Resource, msg.StreamId)
+	if !msg.Amount.IsZero() {
+		exists, err := m.Keeper.streamExists(ctx, msg.Resource, msg.StreamId)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			return nil, types.ErrUnknownStream.Wrapf("%s %s is not registered", msg.Resource, msg.StreamId)
+		}
+	}
+
+	if msg.Amount.IsZero() {
+		if err := m.Keeper.StreamPrices.Remove(ctx, key); err != nil {
+			return nil, err
+		}
+	} else if err := m.Keeper.StreamPrices.Set(ctx, key, types.StreamPrice{
+		Resource: msg.Resource,
+		StreamId: msg.StreamId,
+		Amount:   msg.Amount,
+		Duration: msg.Duration,
+		Setter:   msg.Signer,
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventStreamPriceSet{
+		Resource: msg.Resource,
+		StreamId: msg.StreamId,
+		Amount:   msg.Amount,
+		Duration: msg.Duration,
+		Signer:   msg.Signer,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetStreamPriceResponse{}, nil
+}
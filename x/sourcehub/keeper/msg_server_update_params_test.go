@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/shinzonetwork/shinzohub/x/sourcehub/types"
+)
+
+func TestUpdateParams(t *testing.T) {
+	k, ctx, _ := setupKeeper(t)
+	k.InitGenesis(ctx, *types.DefaultGenesis())
+	ms := NewMsgServerImpl(k)
+
+	admin := sdk.AccAddress("admin_______________").String()
+
+	_, err := ms.UpdateParams(ctx, &types.MsgUpdateParams{
+		Authority: admin,
+		Params:    types.Params{Admin: admin},
+	})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = ms.UpdateParams(ctx, &types.MsgUpdateParams{
+		Authority: k.GetAuthority(),
+		Params:    types.Params{Admin: "not-an-address"},
+	})
+	require.Error(t, err)
+
+	for _, connections := range [][]string{{"channel-0"}, {"connection-0", "connection-0"}} {
+		_, err = ms.UpdateParams(ctx, &types.MsgUpdateParams{
+			Authority: k.GetAuthority(),
+			Params:    types.Params{Admin: admin, OutpostConnections: connections},
+		})
+		require.ErrorContains(t, err, "outpost connection", connections)
+	}
+
+	_, err = ms.UpdateParams(ctx, &types.MsgUpdateParams{
+		Authority: k.GetAuthority(),
+		Params:    types.Params{Admin: admin, RevenueShares: types.RevenueShares{Creator: 6000, Hosts: 3000}},
+	})
+	require.ErrorContains(t, err, "revenue shares sum to 9000 basis points")
+
+	_, err = ms.UpdateParams(ctx, &types.MsgUpdateParams{
+		Authority: k.GetAuthority(),
+		Params:    types.Params{Admin: admin, OutpostConnections: []string{"connection-0"}},
+	})
+	require.NoError(t, err)
+	require.True(t, k.IsAdmin(ctx, admin))
+	require.True(t, k.IsAdmin(ctx, k.GetAuthority()))
+	params, err := k.GetParams(ctx)
+	require.NoError(t, err)
+	require.True(t, params.IsOutpostConnection("connection-0"))
+
+	// Clearing the admin leaves administration to governance only.
+	_, err = ms.UpdateParams(ctx, &types.MsgUpdateParams{Authority: k.GetAuthority()})
+	require.NoError(t, err)
+	require.False(t, k.IsAdmin(ctx, admin))
+}
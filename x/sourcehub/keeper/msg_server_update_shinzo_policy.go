@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	coretypes "github.com/sourcenetwork/acp_core/pkg/types"
+	acptypes "github.com/sourcenetwork/sourcehub/x/acp/types"
+
+	"github.com/shinzonetwork/shinzohub/x/sourcehub/types"
+)
+
+func (m msgServer) UpdateShinzoPolicy(goCtx context.Context, msg *types.MsgUpdateShinzoPolicy) (*types.MsgUpdateShinzoPolicyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != m.Keeper.GetAuthority() {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("invalid authority; expected %s, got %s", m.Keeper.GetAuthority(), msg.Authority)
+	}
+
+	v, err := m.Keeper.AdoptPolicy(ctx, msg.Authority, msg.Policy)
+	if err != nil {
+		return nil, err
+	}
+
+	// Targets without a policy ID get the new version when it is registered.
+	var targets []types.SourcehubTarget
+	if err := m.Keeper.Targets.Walk(ctx, nil, func(_ string, t types.SourcehubTarget) (bool, error) {
+		if t.PolicyId != "" {
+			targets = append(targets, t)
+		}
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	// The edit is queued in the outbox of each target, so a target whose
+	// channel is unavailable gets it once the channel is usable again.
+	edited := make([]string, 0, len(targets))
+	for _, t := range targets {
+		addr, err := m.Keeper.requireICAAddress(ctx, t)
+		if err != nil {
+			return nil, err
+		}
+
+		editMsg := acptypes.NewMsgEditPolicy(addr, t.PolicyId, msg.Policy, coretypes.PolicyMarshalingType_YAML)
+		c, err := m.Keeper.queueCommand(ctx, t.ChainId, types.PacketKind_PACKET_KIND_UPDATE_POLICY, msg.Authority, editMsg)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventPolicySubmitted{
+			Target:    t.ChainId,
+			Sender:    msg.Authority,
+			CommandId: c.Id,
+		}); err != nil {
+			return nil, err
+		}
+		edited = append(edited, t.ChainId)
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventPolicyUpdated{
+		Version:   v.Version,
+		Hash:      v.Hash,
+		Authority: msg.Authority,
+		Targets:   edited,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateShinzoPolicyResponse{Version: v.Version, Hash: v.Hash}, nil
+}
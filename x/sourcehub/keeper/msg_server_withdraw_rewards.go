@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/shinzonetwork/shinzohub/x/sourcehub/types"
+)
+
+func (m msgServer) WithdrawRewards(goCtx context.Context, msg *types.MsgWithdrawRewards) (*types.MsgWithdrawRewardsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	amount, err := m.Keeper.GetPendingRewards(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+	if amount.IsZero() {
+		return nil, types.ErrNoRewards.Wrapf("%s has no rewards to withdraw", msg.Owner)
+	}
+
+	if err := m.Keeper.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, amount); err != nil {
+		return nil, err
+	}
+	if err := m.Keeper.PendingRewards.Remove(ctx, owner); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventRewardsWithdrawn{
+		Owner:  msg.Owner,
+		Amount: amount,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWithdrawRewardsResponse{Amount: amount}, nil
+}
@@ -0,0 +1,432 @@
+package keeper
+
+import (
+	"fmt"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+
+	"github.com/shinzonetwork/shinzohub/x/sourcehub/types"
+)
+
+const (
+	// retryBaseDelay is the number of blocks a command waits before its first
+	// retry, the delay doubles with every failed attempt.
+	retryBaseDelay int64 = 10
+	// maxRetryDelay caps the backoff, roughly one day of blocks.
+	maxRetryDelay int64 = 14400
+)
+
+// retryDelay returns the number of blocks to wait before sending a command
+// again after it failed attempts times.
+func retryDelay(attempts uint32) int64 {
+	shift := min(max(attempts, 1)-1, 16)
+	return min(retryBaseDelay<<shift, maxRetryDelay)
+}
+
+// txHash returns the hash of the transaction being executed, or an empty
+// string outside of a transaction, e.g. in EndBlocker.
+func txHash(ctx sdk.Context) string {
+	if len(ctx.TxBytes()) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%X", cmttypes.Tx(ctx.TxBytes()).Hash())
+}
+
+// GetIcaCommand returns the outbox command with the given ID.
+func (k Keeper) GetIcaCommand(ctx sdk.Context, id uint64) (types.IcaCommand, bool) {
+	c, err := k.IcaCommands.Get(ctx, id)
+	if err != nil {
+		return types.IcaCommand{}, false
+	}
+	return c, true
+}
+
+// SetIcaCommand stores a command and keeps the outbox in sync with its status.
+func (k Keeper) SetIcaCommand(ctx sdk.Context, c types.IcaCommand) error {
+	if err := k.IcaCommands.Set(ctx, c.Id, c); err != nil {
+		return err
+	}
+	if c.Status == types.PacketStatus_PACKET_STATUS_QUEUED {
+		return k.Outbox.Set(ctx, c.Id)
+	}
+	return k.Outbox.Remove(ctx, c.Id)
+}
+
+// queueCommand stores msgs as one command for the target in the outbox. Queued
+// commands are sent to their target together in EndBlocker. Policy commands
+// are checked against the policy version of the target first.
+func (k Keeper) queueCommand(ctx sdk.Context, target string, kind types.PacketKind, sender string, msgs ...gogoproto.Message) (types.IcaCommand, error) {
+	if err := k.validatePolicyCmds(ctx, target, msgs...); err != nil {
+		return types.IcaCommand{}, err
+	}
+
+	anyMsgs, err := packMsgs(msgs...)
+	if err != nil {
+		return types.IcaCommand{}, err
+	}
+
+	payload, err := gogoproto.Marshal(&icatypes.CosmosTx{Messages: anyMsgs})
+	if err != nil {
+		return types.IcaCommand{}, fmt.Errorf("marshal CosmosTx: %w", err)
+	}
+
+	id, err := k.NextCommandId.Next(ctx)
+	if err != nil {
+		return types.IcaCommand{}, err
+	}
+
+	c := types.IcaCommand{
+		Id:      id,
+		Kind:    kind,
+		Sender:  sender,
+		TxHash:  txHash(ctx),
+		Height:  ctx.BlockHeight(),
+		Payload: payload,
+		Status:  types.PacketStatus_PACKET_STATUS_QUEUED,
+		Target:  target,
+	}
+	if err := k.SetIcaCommand(ctx, c); err != nil {
+		return types.IcaCommand{}, err
+	}
+
+	if err := k.mirrorCommand(ctx, c, anyMsgs); err != nil {
+		return types.IcaCommand{}, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventIcaCommandQueued{
+		CommandId: c.Id,
+		Kind:      c.Kind,
+		Sender:    c.Sender,
+		TxHash:    c.TxHash,
+		Target:    c.Target,
+	}); err != nil {
+		return types.IcaCommand{}, err
+	}
+
+	return c, nil
+}
+
+// FlushOutbox sends the queued commands to their SourceHub target, at most
+// MaxBatchSize commands per ICA packet. Commands being retried are sent in a
+// packet of their own so that a command SourceHub keeps rejecting cannot fail
+// the others. Commands that cannot be sent, e.g. because the ICA channel is
+// unavailable, stay queued and are retried in the next block. Nothing is sent
+// to a target whose ICA channel is closed or reopening.
+func (k Keeper) FlushOutbox(ctx sdk.Context) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	batchSize := int(params.BatchSize())
+
+	// Commands are grouped by target in the order the targets are first seen.
+	// Commands queued before targets existed go to the default target.
+	defaultTarget := k.GetDefaultTarget(ctx)
+	var targets []string
+	fresh := make(map[string][]uint64)
+	batches := make(map[string][][]uint64)
+	err = k.Outbox.Walk(ctx, nil, func(id uint64) (bool, error) {
+		c, err := k.IcaCommands.Get(ctx, id)
+		if err != nil {
+			return true, err
+		}
+		if c.NextAttemptHeight > ctx.BlockHeight() {
+			return false, nil
+		}
+
+		target := c.Target
+		if target == "" {
+			target = defaultTarget
+		}
+		if len(fresh[target]) == 0 && len(batches[target]) == 0 {
+			targets = append(targets, target)
+		}
+		if c.Attempts > 0 {
+			batches[target] = append(batches[target], []uint64{id})
+		} else {
+			fresh[target] = append(fresh[target], id)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, target := range targets {
+		t, err := k.resolveTarget(ctx, target)
+		if err != nil {
+			k.Logger(ctx).Error("cannot flush outbox", "target", target, "error", err)
+			continue
+		}
+		if !t.ChannelUsable() {
+			continue
+		}
+
+		for ids := fresh[target]; len(ids) > 0; {
+			n := min(batchSize, len(ids))
+			batches[target] = append(batches[target], ids[:n])
+			ids = ids[n:]
+		}
+
+		for _, batch := range batches[target] {
+			// Send in a cached context so a failed send leaves the batch queued.
+			cacheCtx, write := ctx.CacheContext()
+			if err := k.sendBatch(cacheCtx, t, batch); err != nil {
+				k.Logger(ctx).Error("failed to send outbox batch", "target", t.ChainId, "commands", len(batch), "error", err)
+				break
+			}
+			write()
+		}
+	}
+
+	return nil
+}
+
+func (k Keeper) sendBatch(ctx sdk.Context, t types.SourcehubTarget, ids []uint64) error {
+	commands := make([]types.IcaCommand, 0, len(ids))
+	var msgs []*codectypes.Any
+	for _, id := range ids {
+		c, err := k.IcaCommands.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		var tx icatypes.CosmosTx
+		if err := gogoproto.Unmarshal(c.Payload, &tx); err != nil {
+			return fmt.Errorf("command %d: %w", c.Id, err)
+		}
+		msgs = append(msgs, tx.Messages...)
+		commands = append(commands, c)
+	}
+
+	kind, sender := commands[0].Kind, commands[0].Sender
+	for _, c := range commands[1:] {
+		if c.Kind != kind {
+			kind = types.PacketKind_PACKET_KIND_BATCH
+		}
+		if c.Sender != sender {
+			sender = types.ModuleAddress.String()
+		}
+	}
+
+	p, err := k.sendIcaMsgs(ctx, t, kind, sender, msgs, ids)
+	if err != nil {
+		return err
+	}
+
+	for _, c := range commands {
+		c.ChannelId = p.ChannelId
+		c.Sequence = p.Sequence
+		c.Status = types.PacketStatus_PACKET_STATUS_PENDING
+		c.Attempts++
+		if err := k.SetIcaCommand(ctx, c); err != nil {
+			return err
+		}
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventIcaBatchSent{
+		ChannelId:  p.ChannelId,
+		Sequence:   p.Sequence,
+		Kind:       p.Kind,
+		CommandIds: p.CommandIds,
+		Target:     p.Target,
+	})
+}
+
+// resolveCommands records the outcome of packet p on every command it carried.
+// The message responses of a successful acknowledgement are split between the
+// commands in the order their messages were batched. Commands of a failed or
+// timed out packet are queued again after a backoff until they run out of
+// attempts, at which point they are moved to the dead-letter list.
+func (k Keeper) resolveCommands(ctx sdk.Context, p types.IcaPacket) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	var responses []*codectypes.Any
+	if p.Status == types.PacketStatus_PACKET_STATUS_ACKNOWLEDGED {
+		var data sdk.TxMsgData
+		if err := gogoproto.Unmarshal(p.Result, &data); err != nil {
+			k.Logger(ctx).Error("cannot decode acknowledgement result", "channel", p.ChannelId, "sequence", p.Sequence, "error", err)
+		} else {
+			responses = data.MsgResponses
+		}
+	}
+
+	for _, id := range p.CommandIds {
+		c, found := k.GetIcaCommand(ctx, id)
+		if !found {
+			continue
+		}
+
+		var tx icatypes.CosmosTx
+		if err := gogoproto.Unmarshal(c.Payload, &tx); err != nil {
+			return fmt.Errorf("command %d: %w", c.Id, err)
+		}
+
+		c.Status = p.Status
+		c.Error = p.Error
+		if n := len(tx.Messages); len(responses) >= n {
+			result, err := gogoproto.Marshal(&sdk.TxMsgData{MsgResponses: responses[:n]})
+			if err != nil {
+				return err
+			}
+			c.Result = result
+			responses = responses[n:]
+		}
+
+		if c.Status != types.PacketStatus_PACKET_STATUS_ACKNOWLEDGED {
+			// A command paid for is refunded once SourceHub rejected it, which
+			// it cannot have done when the packet timed out.
+			paid, err := k.StreamPayments.Has(ctx, c.Id)
+			if err != nil {
+				return err
+			}
+			retry := c.Attempts < params.AttemptLimit()
+			if paid {
+				retry = c.Status == types.PacketStatus_PACKET_STATUS_TIMED_OUT
+			}
+			if retry {
+				if err := k.retryCommand(ctx, c); err != nil {
+					return err
+				}
+				continue
+			}
+			if err := k.deadLetterCommand(ctx, c); err != nil {
+				return err
+			}
+		}
+
+		if err := k.finishCommand(ctx, c, tx.Messages); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// finishCommand stores c, which reached its final status, and applies its
+// outcome to the state it changed.
+func (k Keeper) finishCommand(ctx sdk.Context, c types.IcaCommand, msgs []*codectypes.Any) error {
+	if err := k.SetIcaCommand(ctx, c); err != nil {
+		return err
+	}
+
+	if c.Kind == types.PacketKind_PACKET_KIND_REGISTER_ENTITY || c.Kind == types.PacketKind_PACKET_KIND_ROTATE_ENTITY_KEYS {
+		if err := k.resolveEntityCommand(ctx, c); err != nil {
+			return err
+		}
+	}
+
+	if c.Kind == types.PacketKind_PACKET_KIND_REGISTER_POLICY && c.Status == types.PacketStatus_PACKET_STATUS_ACKNOWLEDGED {
+		if err := k.capturePolicyId(ctx, c); err != nil {
+			k.Logger(ctx).Error("failed to capture policy ID", "command", c.Id, "target", c.Target, "error", err)
+		}
+	}
+
+	if c.Kind == types.PacketKind_PACKET_KIND_UPDATE_POLICY && c.Status == types.PacketStatus_PACKET_STATUS_ACKNOWLEDGED {
+		if err := k.applyPolicyEdit(ctx, c); err != nil {
+			k.Logger(ctx).Error("failed to apply policy edit", "command", c.Id, "target", c.Target, "error", err)
+		}
+	}
+
+	if err := k.resolveRelationships(ctx, c, msgs); err != nil {
+		return err
+	}
+
+	if err := k.resolvePayment(ctx, c); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventIcaCommandResolved{
+		CommandId: c.Id,
+		Kind:      c.Kind,
+		TxHash:    c.TxHash,
+		Status:    c.Status,
+	})
+}
+
+// cancelCommand fails c, still waiting in the outbox, with reason without
+// sending it.
+func (k Keeper) cancelCommand(ctx sdk.Context, c types.IcaCommand, reason string) error {
+	var tx icatypes.CosmosTx
+	if err := gogoproto.Unmarshal(c.Payload, &tx); err != nil {
+		return fmt.Errorf("command %d: %w", c.Id, err)
+	}
+
+	c.Status = types.PacketStatus_PACKET_STATUS_FAILED
+	c.Error = reason
+	return k.finishCommand(ctx, c, tx.Messages)
+}
+
+// retryCommand queues c again once its backoff has elapsed.
+func (k Keeper) retryCommand(ctx sdk.Context, c types.IcaCommand) error {
+	c.Status = types.PacketStatus_PACKET_STATUS_QUEUED
+	c.NextAttemptHeight = ctx.BlockHeight() + retryDelay(c.Attempts)
+	if err := k.SetIcaCommand(ctx, c); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventIcaCommandRetry{
+		CommandId:   c.Id,
+		Kind:        c.Kind,
+		Attempts:    c.Attempts,
+		RetryHeight: c.NextAttemptHeight,
+		Error:       c.Error,
+	})
+}
+
+// deadLetterCommand moves c, which exhausted its attempts, to the dead-letter
+// list.
+func (k Keeper) deadLetterCommand(ctx sdk.Context, c types.IcaCommand) error {
+	if err := k.DeadLetters.Set(ctx, c.Id); err != nil {
+		return err
+	}
+
+	k.Logger(ctx).Error("ICA command exhausted its attempts", "id", c.Id, "kind", c.Kind.String(), "attempts", c.Attempts, "error", c.Error)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventIcaCommandDeadLettered{
+		CommandId: c.Id,
+		Kind:      c.Kind,
+		Attempts:  c.Attempts,
+		TxHash:    c.TxHash,
+		Error:     c.Error,
+	})
+}
+
+// PurgeDeadLetters deletes the given dead-lettered commands, or every
+// dead-lettered command if ids is empty, and returns the deleted IDs.
+func (k Keeper) PurgeDeadLetters(ctx sdk.Context, ids []uint64) ([]uint64, error) {
+	if len(ids) == 0 {
+		err := k.DeadLetters.Walk(ctx, nil, func(id uint64) (bool, error) {
+			ids = append(ids, id)
+			return false, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, id := range ids {
+		has, err := k.DeadLetters.Has(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if !has {
+			return nil, fmt.Errorf("command %d is not in the dead-letter list", id)
+		}
+		if err := k.DeadLetters.Remove(ctx, id); err != nil {
+			return nil, err
+		}
+		if err := k.IcaCommands.Remove(ctx, id); err != nil {
+			return nil, err
+		}
+	}
+
+	return ids, nil
+}
@@ -0,0 +1,265 @@
+package keeper
+
+import (
+	"errors"
+	"testing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	acptypes "github.com/sourcenetwork/sourcehub/x/acp/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/shinzonetwork/shinzohub/x/sourcehub/types"
+)
+
+// queueStreamAccess grants stream access to did without flushing the outbox.
+func queueStreamAccess(t *testing.T, k Keeper, ctx sdk.Context, did string) {
+	t.Helper()
+
+	_, err := NewMsgServerImpl(k).RequestStreamAccess(ctx, &types.MsgRequestStreamAccess{
+		Signer:   k.GetAuthority(),
+		Resource: types.Resource_RESOURCE_VIEW,
+		StreamId: "view-1",
+		Did:      did,
+	})
+	require.NoError(t, err)
+}
+
+// msgResponsesAck returns a successful acknowledgement carrying n policy
+// command responses.
+func msgResponsesAck(t *testing.T, n int) []byte {
+	t.Helper()
+
+	var data sdk.TxMsgData
+	for range n {
+		resp, err := codectypes.NewAnyWithValue(&acptypes.MsgDirectPolicyCmdResponse{})
+		require.NoError(t, err)
+		data.MsgResponses = append(data.MsgResponses, resp)
+	}
+	bz, err := gogoproto.Marshal(&data)
+	require.NoError(t, err)
+
+	return channeltypes.NewResultAcknowledgement(bz).Acknowledgement()
+}
+
+func TestFlushOutboxBatchesCommands(t *testing.T) {
+	k, ctx, ica := setupKeeperWithICA(t)
+
+	queueStreamAccess(t, k, ctx, "did:key:alice")
+	queueStreamAccess(t, k, ctx, "did:key:bob")
+	require.Empty(t, ica.sent)
+
+	require.NoError(t, k.FlushOutbox(ctx))
+	require.Len(t, ica.sent, 1)
+
+	p, found := k.GetIcaPacket(ctx, testChannelID, ica.sequence)
+	require.True(t, found)
+	require.Equal(t, []uint64{0, 1}, p.CommandIds)
+	require.Equal(t, types.PacketKind_PACKET_KIND_REQUEST_STREAM_ACCESS, p.Kind)
+
+	for _, id := range p.CommandIds {
+		c, found := k.GetIcaCommand(ctx, id)
+		require.True(t, found)
+		require.Equal(t, types.PacketStatus_PACKET_STATUS_PENDING, c.Status)
+		require.Equal(t, p.Sequence, c.Sequence)
+		queued, err := k.Outbox.Has(ctx, id)
+		require.NoError(t, err)
+		require.False(t, queued)
+	}
+
+	require.NoError(t, k.FlushOutbox(ctx))
+	require.Len(t, ica.sent, 1, "an empty outbox must not send a packet")
+}
+
+func TestFlushOutboxMixedKinds(t *testing.T) {
+	k, ctx, ica := setupKeeperWithICA(t)
+
+	queueStreamAccess(t, k, ctx, "did:key:alice")
+	registerPrimitives(t, k, ctx, "Log")
+	require.NoError(t, k.RegisterObject(ctx, "", "view-2", []byte("did:key:alice"), "Log {address}"))
+
+	require.NoError(t, k.FlushOutbox(ctx))
+	require.Len(t, ica.sent, 1)
+
+	p, _ := k.GetIcaPacket(ctx, testChannelID, ica.sequence)
+	require.Equal(t, types.PacketKind_PACKET_KIND_BATCH, p.Kind)
+	require.Equal(t, types.ModuleAddress.String(), p.Sender)
+}
+
+func TestFlushOutboxRespectsMaxBatchSize(t *testing.T) {
+	k, ctx, ica := setupKeeperWithICA(t)
+
+	params, err := k.GetParams(ctx)
+	require.NoError(t, err)
+	params.MaxBatchSize = 2
+	k.SetParams(ctx, params)
+
+	for _, did := range []string{"did:key:alice", "did:key:bob", "did:key:carol"} {
+		queueStreamAccess(t, k, ctx, did)
+	}
+
+	require.NoError(t, k.FlushOutbox(ctx))
+	require.Len(t, ica.sent, 2)
+
+	p, _ := k.GetIcaPacket(ctx, testChannelID, 2)
+	require.Equal(t, []uint64{2}, p.CommandIds)
+}
+
+func TestFlushOutboxKeepsCommandsOnSendFailure(t *testing.T) {
+	k, ctx, ica := setupKeeperWithICA(t)
+	ica.sendErr = errors.New("channel closed")
+
+	queueStreamAccess(t, k, ctx, "did:key:alice")
+	require.NoError(t, k.FlushOutbox(ctx))
+
+	c, _ := k.GetIcaCommand(ctx, 0)
+	require.Equal(t, types.PacketStatus_PACKET_STATUS_QUEUED, c.Status)
+	has, err := k.Outbox.Has(ctx, 0)
+	require.NoError(t, err)
+	require.True(t, has)
+
+	ica.sendErr = nil
+	require.NoError(t, k.FlushOutbox(ctx))
+	require.Len(t, ica.sent, 1)
+}
+
+func TestAcknowledgementSplitsResultsPerCommand(t *testing.T) {
+	k, ctx, ica := setupKeeperWithICA(t)
+
+	queueStreamAccess(t, k, ctx, "did:key:alice")
+	queueStreamAccess(t, k, ctx, "did:key:bob")
+	require.NoError(t, k.FlushOutbox(ctx))
+
+	packet := channeltypes.Packet{SourceChannel: testChannelID, Sequence: ica.sequence}
+	require.NoError(t, k.OnAcknowledgementPacket(ctx, packet, msgResponsesAck(t, 2)))
+
+	for _, id := range []uint64{0, 1} {
+		c, _ := k.GetIcaCommand(ctx, id)
+		require.Equal(t, types.PacketStatus_PACKET_STATUS_ACKNOWLEDGED, c.Status)
+
+		var data sdk.TxMsgData
+		require.NoError(t, gogoproto.Unmarshal(c.Result, &data))
+		require.Len(t, data.MsgResponses, 1)
+	}
+}
+
+func TestQueryIcaCommandsByTxHash(t *testing.T) {
+	k, ctx, _ := setupKeeperWithICA(t)
+
+	queueStreamAccess(t, k, ctx.WithTxBytes([]byte("tx-1")), "did:key:alice")
+	queueStreamAccess(t, k, ctx.WithTxBytes([]byte("tx-2")), "did:key:bob")
+
+	c, _ := k.GetIcaCommand(ctx, 0)
+	require.Len(t, c.TxHash, 64)
+
+	res, err := NewQueryServerImpl(k).IcaCommands(ctx, &types.QueryIcaCommandsRequest{TxHash: "0x" + c.TxHash})
+	require.NoError(t, err)
+	require.Len(t, res.Commands, 1)
+	require.Equal(t, uint64(0), res.Commands[0].Id)
+
+	res, err = NewQueryServerImpl(k).IcaCommands(ctx, &types.QueryIcaCommandsRequest{
+		Status: types.PacketStatus_PACKET_STATUS_QUEUED,
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Commands, 2)
+
+	_, err = NewQueryServerImpl(k).IcaCommand(ctx, &types.QueryIcaCommandRequest{Id: 7})
+	require.Error(t, err)
+}
+
+func TestRetryDelay(t *testing.T) {
+	require.Equal(t, int64(10), retryDelay(1))
+	require.Equal(t, int64(20), retryDelay(2))
+	require.Equal(t, int64(80), retryDelay(4))
+	require.Equal(t, maxRetryDelay, retryDelay(20))
+}
+
+func TestTimedOutCommandIsRetriedWithBackoff(t *testing.T) {
+	k, ctx, ica := setupKeeperWithICA(t)
+	ctx = ctx.WithBlockHeight(100)
+
+	queueStreamAccess(t, k, ctx, "did:key:alice")
+	require.NoError(t, k.FlushOutbox(ctx))
+	require.NoError(t, k.OnTimeoutPacket(ctx, channeltypes.Packet{SourceChannel: testChannelID, Sequence: 1}))
+
+	c, _ := k.GetIcaCommand(ctx, 0)
+	require.Equal(t, types.PacketStatus_PACKET_STATUS_QUEUED, c.Status)
+	require.Equal(t, uint32(1), c.Attempts)
+	require.Equal(t, int64(110), c.NextAttemptHeight)
+
+	require.NoError(t, k.FlushOutbox(ctx.WithBlockHeight(109)))
+	require.Len(t, ica.sent, 1, "the command must wait for its backoff")
+
+	require.NoError(t, k.FlushOutbox(ctx.WithBlockHeight(110)))
+	require.Len(t, ica.sent, 2)
+
+	c, _ = k.GetIcaCommand(ctx, 0)
+	require.Equal(t, types.PacketStatus_PACKET_STATUS_PENDING, c.Status)
+	require.Equal(t, uint32(2), c.Attempts)
+	require.Equal(t, uint64(2), c.Sequence)
+}
+
+func TestRetriedCommandIsSentAlone(t *testing.T) {
+	k, ctx, ica := setupKeeperWithICA(t)
+
+	queueStreamAccess(t, k, ctx, "did:key:alice")
+	require.NoError(t, k.FlushOutbox(ctx))
+
+	ack := channeltypes.NewErrorAcknowledgement(errors.New("relation not found"))
+	packet := channeltypes.Packet{SourceChannel: testChannelID, Sequence: 1}
+	require.NoError(t, k.OnAcknowledgementPacket(ctx, packet, ack.Acknowledgement()))
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + retryBaseDelay)
+	queueStreamAccess(t, k, ctx, "did:key:bob")
+	require.NoError(t, k.FlushOutbox(ctx))
+	require.Len(t, ica.sent, 3)
+
+	p, _ := k.GetIcaPacket(ctx, testChannelID, 2)
+	require.Equal(t, []uint64{0}, p.CommandIds)
+	p, _ = k.GetIcaPacket(ctx, testChannelID, 3)
+	require.Equal(t, []uint64{1}, p.CommandIds)
+}
+
+func TestDeadLetters(t *testing.T) {
+	k, ctx, _ := setupKeeperWithICA(t)
+	ms := NewMsgServerImpl(k)
+
+	params, err := k.GetParams(ctx)
+	require.NoError(t, err)
+	params.MaxAttempts = 1
+	k.SetParams(ctx, params)
+
+	queueStreamAccess(t, k, ctx, "did:key:alice")
+	require.NoError(t, k.FlushOutbox(ctx))
+	require.NoError(t, k.OnTimeoutPacket(ctx, channeltypes.Packet{SourceChannel: testChannelID, Sequence: 1}))
+
+	c, _ := k.GetIcaCommand(ctx, 0)
+	require.Equal(t, types.PacketStatus_PACKET_STATUS_TIMED_OUT, c.Status)
+
+	res, err := NewQueryServerImpl(k).DeadLetters(ctx, &types.QueryDeadLettersRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Commands, 1)
+	require.Equal(t, uint64(0), res.Commands[0].Id)
+
+	_, err = ms.PurgeDeadLetters(ctx, &types.MsgPurgeDeadLetters{Signer: testOutsider, Ids: []uint64{0}})
+	require.ErrorContains(t, err, "admin required")
+
+	_, err = ms.PurgeDeadLetters(ctx, &types.MsgPurgeDeadLetters{Signer: k.GetAuthority(), Ids: []uint64{7}})
+	require.ErrorContains(t, err, "not in the dead-letter list")
+
+	purged, err := ms.PurgeDeadLetters(ctx, &types.MsgPurgeDeadLetters{Signer: k.GetAuthority()})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), purged.Purged)
+
+	var ev types.EventDeadLettersPurged
+	require.True(t, lastTypedEvent(t, ctx, &ev))
+	require.Equal(t, []uint64{0}, ev.CommandIds)
+
+	_, found := k.GetIcaCommand(ctx, 0)
+	require.False(t, found)
+	res, err = NewQueryServerImpl(k).DeadLetters(ctx, &types.QueryDeadLettersRequest{})
+	require.NoError(t, err)
+	require.Empty(t, res.Commands)
+}
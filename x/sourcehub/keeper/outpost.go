@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/shinzonetwork/shinzohub/x/sourcehub/types"
+)
+
+// validateOutpostExpiry checks the expiry of access paid on an Outpost chain
+// at now. It must lie in the future, and, unless access bought at the price
+// never expires, neither be 0 nor lie beyond limit. It must also extend the
+// current expiration of an existing grant.
+func validateOutpostExpiry(now, current, limit, expiry uint64, renewal bool) error {
+	if expiry == 0 {
+		if limit != 0 {
+			return fmt.Errorf("expiry cannot be 0, access expires at %d at the latest", limit)
+		}
+		return nil
+	}
+	if expiry <= now {
+		return fmt.Errorf("expiry %d is not after the block time %d", expiry, now)
+	}
+	if limit != 0 && expiry > limit {
+		return fmt.Errorf("expiry %d is after %d, the latest the price allows", expiry, limit)
+	}
+	if renewal && expiry <= current {
+		return fmt.Errorf("expiry %d does not extend the access expiring at %d", expiry, current)
+	}
+	return nil
+}
+
+// OnOutpostPayment grants data.Did access to a stream once the Outpost
+// contract at the other end of channelId reported a payment for it. The
+// payment must cover the price of the stream, and the access expires at
+// data.Expiry. A DID whose access SourceHub confirmed only gets its grant
+// extended, a payment for access still pending is rejected.
+func (k Keeper) OnOutpostPayment(ctx sdk.Context, channelId string, data types.OutpostPaymentPacketData) (types.OutpostPaymentAcknowledgement, error) {
+	if err := data.ValidateBasic(); err != nil {
+		return types.OutpostPaymentAcknowledgement{}, err
+	}
+	resource, err := data.StreamResource()
+	if err != nil {
+		return types.OutpostPaymentAcknowledgement{}, err
+	}
+
+	price, found := k.GetStreamPrice(ctx, resource, data.StreamId)
+	if !found {
+		return types.OutpostPaymentAcknowledgement{}, fmt.Errorf("stream %s is not for sale", data.StreamId)
+	}
+	if !data.Amount.IsAllGTE(price.Amount) {
+		return types.OutpostPaymentAcknowledgement{}, sdkerrors.ErrInsufficientFunds.Wrapf("paid %s, stream %s costs %s", data.Amount, data.StreamId, price.Amount)
+	}
+
+	// Only a grant whose relationship SourceHub confirmed is extended, one
+	// whose relationship failed is granted again.
+	now := uint64(ctx.BlockTime().Unix())
+	grant, found := k.GetGrant(ctx, data.Target, resource, data.StreamId, data.Did)
+	renewal := false
+	if found {
+		if renewal, err = k.grantConfirmed(ctx, grant); err != nil {
+			return types.OutpostPaymentAcknowledgement{}, err
+		}
+	}
+	if renewal && grant.Expiration == 0 {
+		return types.OutpostPaymentAcknowledgement{}, fmt.Errorf("%s already has access to stream %s that never expires", data.Did, data.StreamId)
+	}
+	// Access paid for again keeps the time left on the failed grant.
+	limit := accessExpiration(now, grant.Expiration, price.Duration)
+	if err := validateOutpostExpiry(now, grant.Expiration, limit, data.Expiry, renewal); err != nil {
+		return types.OutpostPaymentAcknowledgement{}, err
+	}
+
+	// The module grants the access on behalf of the Outpost.
+	signer := types.ModuleAddress.String()
+	var command types.IcaCommand
+	if renewal {
+		grant, err = k.extendStreamAccess(ctx, grant, data.Expiry, signer)
+	} else {
+		command, grant, err = k.grantStreamAccess(ctx, data.Target, resource, data.StreamId, data.Did, data.Expiry, signer)
+	}
+	if err != nil {
+		return types.OutpostPaymentAcknowledgement{}, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventOutpostPaymentReceived{
+		ChannelId:  channelId,
+		Target:     grant.Target,
+		Resource:   grant.Resource,
+		StreamId:   grant.StreamId,
+		Did:        grant.Did,
+		Payer:      data.Payer,
+		Amount:     data.Amount,
+		Expiration: grant.Expiration,
+		Extended:   renewal,
+		CommandId:  command.Id,
+	}); err != nil {
+		return types.OutpostPaymentAcknowledgement{}, err
+	}
+
+	return types.OutpostPaymentAcknowledgement{
+		Expiration: grant.Expiration,
+		Extended:   renewal,
+		CommandId:  command.Id,
+	}, nil
+}
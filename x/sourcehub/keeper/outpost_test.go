@@ -0,0 +1,143 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/shinzonetwork/shinzohub/x/sourcehub/types"
+)
+
+func outpostPayment(did string, expiry uint64) types.OutpostPaymentPacketData {
+	return types.OutpostPaymentPacketData{
+		Did:      did,
+		Resource: types.ViewResourceName,
+		StreamId: "view-1",
+		Amount:   testPrice,
+		Expiry:   expiry,
+		Payer:    "0xpayer",
+	}
+}
+
+func TestOnOutpostPayment(t *testing.T) {
+	k, ctx, ica := setupKeeperWithICA(t)
+	ctx = ctx.WithBlockTime(time.Unix(1000, 0))
+	priceStream(t, k, ctx, 3600, nil)
+
+	res, err := k.OnOutpostPayment(ctx, "channel-7", outpostPayment("did:key:alice", 4000))
+	require.NoError(t, err)
+	require.Equal(t, types.OutpostPaymentAcknowledgement{Expiration: 4000}, res)
+
+	g, found := k.GetGrant(ctx, "", types.Resource_RESOURCE_VIEW, "view-1", "did:key:alice")
+	require.True(t, found)
+	require.Equal(t, uint64(4000), g.Expiration)
+	require.Equal(t, types.ModuleAddress.String(), g.Signer)
+	require.Equal(t, types.RelationshipStatus_RELATIONSHIP_STATUS_PENDING, subscriberStatus(t, k, ctx, "did:key:alice"))
+
+	var received types.EventOutpostPaymentReceived
+	require.True(t, lastTypedEvent(t, ctx, &received))
+	require.Equal(t, "channel-7", received.ChannelId)
+	require.Equal(t, "0xpayer", received.Payer)
+	require.Equal(t, testPrice, received.Amount)
+
+	// A payment for access SourceHub has not confirmed yet is refused.
+	ctx = ctx.WithBlockTime(time.Unix(2000, 0))
+	_, err = k.OnOutpostPayment(ctx, "channel-7", outpostPayment("did:key:alice", 7600))
+	require.ErrorContains(t, err, "not confirmed by SourceHub yet")
+
+	// Once it is confirmed, a second payment extends the grant up to one
+	// period after its expiration, without another command.
+	require.NoError(t, k.FlushOutbox(ctx))
+	packet := channeltypes.Packet{SourceChannel: testChannelID, Sequence: ica.sequence}
+	require.NoError(t, k.OnAcknowledgementPacket(ctx, packet, msgResponsesAck(t, 1)))
+	res, err = k.OnOutpostPayment(ctx, "channel-7", outpostPayment("did:key:alice", 7600))
+	require.NoError(t, err)
+	require.Equal(t, types.OutpostPaymentAcknowledgement{Expiration: 7600, Extended: true}, res)
+	next, err := k.NextCommandId.Peek(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), next)
+}
+
+func TestOnOutpostPaymentRejected(t *testing.T) {
+	k, ctx, ica := setupKeeperWithICA(t)
+	ctx = ctx.WithBlockTime(time.Unix(1000, 0))
+
+	_, err := k.OnOutpostPayment(ctx, "channel-7", outpostPayment("did:key:alice", 4600))
+	require.ErrorContains(t, err, "not for sale")
+
+	priceStream(t, k, ctx, 3600, nil)
+	_, err = k.OnOutpostPayment(ctx, "channel-7", outpostPayment("did:key:alice", 4600))
+	require.NoError(t, err)
+	queueStreamAccess(t, k, ctx, "did:key:bob")
+	require.NoError(t, k.FlushOutbox(ctx))
+	packet := channeltypes.Packet{SourceChannel: testChannelID, Sequence: ica.sequence}
+	require.NoError(t, k.OnAcknowledgementPacket(ctx, packet, msgResponsesAck(t, 2)))
+	queueStreamAccess(t, k, ctx, "did:key:dave")
+
+	for name, tc := range map[string]struct {
+		mutate func(*types.OutpostPaymentPacketData)
+		err    string
+	}{
+		"no DID": {
+			mutate: func(d *types.OutpostPaymentPacketData) { d.Did = "" },
+			err:    "did cannot be empty",
+		},
+		"unknown resource": {
+			mutate: func(d *types.OutpostPaymentPacketData) { d.Resource = "stream" },
+			err:    `invalid resource "stream"`,
+		},
+		"no amount": {
+			mutate: func(d *types.OutpostPaymentPacketData) { d.Amount = nil },
+			err:    "invalid amount",
+		},
+		"underpaid": {
+			mutate: func(d *types.OutpostPaymentPacketData) { d.Amount = sdk.NewCoins(sdk.NewInt64Coin("ushinzo", 99)) },
+			err:    "paid 99ushinzo, stream view-1 costs 100ushinzo",
+		},
+		"wrong denom": {
+			mutate: func(d *types.OutpostPaymentPacketData) { d.Amount = sdk.NewCoins(sdk.NewInt64Coin("uatom", 100)) },
+			err:    "costs 100ushinzo",
+		},
+		"no expiry": {
+			mutate: func(d *types.OutpostPaymentPacketData) { d.Expiry = 0 },
+			err:    "expiry cannot be 0",
+		},
+		"expired": {
+			mutate: func(d *types.OutpostPaymentPacketData) { d.Expiry = 1000 },
+			err:    "not after the block time",
+		},
+		"more than one period": {
+			mutate: func(d *types.OutpostPaymentPacketData) { d.Expiry = 4601 },
+			err:    "after 4600, the latest the price allows",
+		},
+		"renewal not extending": {
+			mutate: func(d *types.OutpostPaymentPacketData) { d.Did, d.Expiry = "did:key:alice", 4600 },
+			err:    "does not extend the access expiring at 4600",
+		},
+		"access pending": {
+			mutate: func(d *types.OutpostPaymentPacketData) { d.Did = "did:key:dave" },
+			err:    "not confirmed by SourceHub yet",
+		},
+		"access never expires": {
+			mutate: func(d *types.OutpostPaymentPacketData) { d.Did = "did:key:bob" },
+			err:    "never expires",
+		},
+		"unknown target": {
+			mutate: func(d *types.OutpostPaymentPacketData) { d.Target = "other" },
+			err:    "unknown SourceHub target other",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			data := outpostPayment("did:key:carol", 4600)
+			tc.mutate(&data)
+			_, err := k.OnOutpostPayment(ctx, "channel-7", data)
+			require.ErrorContains(t, err, tc.err)
+		})
+	}
+
+	_, found := k.GetGrant(ctx, "", types.Resource_RESOURCE_VIEW, "view-1", "did:key:carol")
+	require.False(t, found)
+}
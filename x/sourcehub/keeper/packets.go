@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+
+	"github.com/shinzonetwork/shinzohub/x/sourcehub/types"
+)
+
+// GetIcaPacket returns the tracked packet sent on channelID with the given sequence.
+func (k Keeper) GetIcaPacket(ctx sdk.Context, channelID string, sequence uint64) (types.IcaPacket, bool) {
+	p, err := k.IcaPackets.Get(ctx, collections.Join(channelID, sequence))
+	if err != nil {
+		return types.IcaPacket{}, false
+	}
+	return p, true
+}
+
+// OnAcknowledgementPacket records the SourceHub result for a tracked packet.
+func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte) error {
+	var ack channeltypes.Acknowledgement
+	if err := icatypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return fmt.Errorf("cannot unmarshal ICA acknowledgement: %w", err)
+	}
+
+	p, found := k.GetIcaPacket(ctx, packet.SourceChannel, packet.Sequence)
+	if !found {
+		k.Logger(ctx).Info("acknowledgement for untracked packet", "channel", packet.SourceChannel, "sequence", packet.Sequence)
+		return nil
+	}
+	if p.Status != types.PacketStatus_PACKET_STATUS_PENDING {
+		k.Logger(ctx).Info("acknowledgement for resolved packet", "channel", packet.SourceChannel, "sequence", packet.Sequence, "status", p.Status.String())
+		return nil
+	}
+
+	switch resp := ack.Response.(type) {
+	case *channeltypes.Acknowledgement_Result:
+		p.Status = types.PacketStatus_PACKET_STATUS_ACKNOWLEDGED
+		p.Result = resp.Result
+	case *channeltypes.Acknowledgement_Error:
+		p.Status = types.PacketStatus_PACKET_STATUS_FAILED
+		p.Error = resp.Error
+	default:
+		return errors.New("unknown ICA acknowledgement response")
+	}
+	p.ResolvedHeight = ctx.BlockHeight()
+
+	if err := k.IcaPackets.Set(ctx, collections.Join(p.ChannelId, p.Sequence), p); err != nil {
+		return err
+	}
+
+	if err := k.resolveCommands(ctx, p); err != nil {
+		return err
+	}
+
+	k.callHookSafely(ctx, "AfterIcaAck", func(ctx context.Context) error {
+		return k.Hooks().AfterIcaAck(ctx, p)
+	})
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventIcaAck{
+		ChannelId: p.ChannelId,
+		Sequence:  p.Sequence,
+		Kind:      p.Kind,
+		Sender:    p.Sender,
+		Success:   ack.Success(),
+		Error:     p.Error,
+		Target:    p.Target,
+	})
+}
+
+// OnTimeoutPacket marks a tracked packet as timed out. A timeout closes the
+// ORDERED ICA channel, so the channel is recorded as closed as well.
+func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet) error {
+	p, found := k.GetIcaPacket(ctx, packet.SourceChannel, packet.Sequence)
+	if !found {
+		k.Logger(ctx).Info("timeout for untracked packet", "channel", packet.SourceChannel, "sequence", packet.Sequence)
+		return k.OnIcaChannelClosed(ctx, packet.SourcePort, packet.SourceChannel)
+	}
+	if p.Status != types.PacketStatus_PACKET_STATUS_PENDING {
+		// Already resolved when the channel was closed by an earlier timeout.
+		return nil
+	}
+
+	p.Status = types.PacketStatus_PACKET_STATUS_TIMED_OUT
+	p.ResolvedHeight = ctx.BlockHeight()
+
+	if err := k.IcaPackets.Set(ctx, collections.Join(p.ChannelId, p.Sequence), p); err != nil {
+		return err
+	}
+
+	if err := k.resolveCommands(ctx, p); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventIcaTimeout{
+		ChannelId: p.ChannelId,
+		Sequence:  p.Sequence,
+		Kind:      p.Kind,
+		Sender:    p.Sender,
+		Target:    p.Target,
+	}); err != nil {
+		return err
+	}
+
+	return k.OnIcaChannelClosed(ctx, packet.SourcePort, packet.SourceChannel)
+}
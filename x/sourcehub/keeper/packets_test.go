@@ -0,0 +1,118 @@
+package keeper
+
+import (
+	"errors"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/shinzonetwork/shinzohub/x/sourcehub/types"
+)
+
+// requestStreamAccess grants stream access and sends the resulting command to
+// SourceHub.
+func requestStreamAccess(t *testing.T, k Keeper, ctx sdk.Context) {
+	t.Helper()
+
+	_, err := NewMsgServerImpl(k).RequestStreamAccess(ctx, &types.MsgRequestStreamAccess{
+		Signer:   k.GetAuthority(),
+		Resource: types.Resource_RESOURCE_VIEW,
+		StreamId: "view-1",
+		Did:      "did:key:alice",
+	})
+	require.NoError(t, err)
+	require.NoError(t, k.FlushOutbox(ctx))
+}
+
+func TestSendIcaTxTracksPacket(t *testing.T) {
+	k, ctx, ica := setupKeeperWithICA(t)
+	ctx = ctx.WithBlockHeight(10)
+
+	requestStreamAccess(t, k, ctx)
+	require.Len(t, ica.sent, 1)
+
+	p, found := k.GetIcaPacket(ctx, testChannelID, ica.sequence)
+	require.True(t, found)
+	require.Equal(t, types.PacketKind_PACKET_KIND_REQUEST_STREAM_ACCESS, p.Kind)
+	require.Equal(t, types.PacketStatus_PACKET_STATUS_PENDING, p.Status)
+	require.Equal(t, k.GetAuthority(), p.Sender)
+	require.Equal(t, int64(10), p.Height)
+	require.Equal(t, ica.sent[0].Data, p.Payload)
+}
+
+func TestSendFailureIsNotTracked(t *testing.T) {
+	k, ctx, ica := setupKeeperWithICA(t)
+	ica.sendErr = errors.New("channel closed")
+
+	// The policy stays in the outbox until it can be sent.
+	_, err := NewMsgServerImpl(k).RegisterShinzoPolicy(ctx, &types.MsgRegisterShinzoPolicy{Signer: k.GetAuthority()})
+	require.NoError(t, err)
+	require.NoError(t, k.FlushOutbox(ctx))
+
+	_, found := k.GetIcaPacket(ctx, testChannelID, 1)
+	require.False(t, found)
+	c, found := k.GetIcaCommand(ctx, 0)
+	require.True(t, found)
+	require.Equal(t, types.PacketKind_PACKET_KIND_REGISTER_POLICY, c.Kind)
+	require.Equal(t, types.PacketStatus_PACKET_STATUS_QUEUED, c.Status)
+}
+
+func TestOnAcknowledgementPacket(t *testing.T) {
+	packet := channeltypes.Packet{SourceChannel: testChannelID, Sequence: 1}
+
+	t.Run("success", func(t *testing.T) {
+		k, ctx, _ := setupKeeperWithICA(t)
+		requestStreamAccess(t, k, ctx)
+
+		ack := channeltypes.NewResultAcknowledgement([]byte("ok"))
+		require.NoError(t, k.OnAcknowledgementPacket(ctx.WithBlockHeight(12), packet, ack.Acknowledgement()))
+
+		p, _ := k.GetIcaPacket(ctx, testChannelID, 1)
+		require.Equal(t, types.PacketStatus_PACKET_STATUS_ACKNOWLEDGED, p.Status)
+		require.Equal(t, []byte("ok"), p.Result)
+		require.Equal(t, int64(12), p.ResolvedHeight)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		k, ctx, _ := setupKeeperWithICA(t)
+		requestStreamAccess(t, k, ctx)
+
+		ack := channeltypes.NewErrorAcknowledgement(errors.New("relation not found"))
+		require.NoError(t, k.OnAcknowledgementPacket(ctx, packet, ack.Acknowledgement()))
+
+		p, _ := k.GetIcaPacket(ctx, testChannelID, 1)
+		require.Equal(t, types.PacketStatus_PACKET_STATUS_FAILED, p.Status)
+		require.NotEmpty(t, p.Error)
+	})
+
+	t.Run("untracked", func(t *testing.T) {
+		k, ctx, _ := setupKeeperWithICA(t)
+
+		ack := channeltypes.NewResultAcknowledgement([]byte("ok"))
+		require.NoError(t, k.OnAcknowledgementPacket(ctx, packet, ack.Acknowledgement()))
+	})
+}
+
+func TestOnTimeoutPacket(t *testing.T) {
+	k, ctx, _ := setupKeeperWithICA(t)
+	requestStreamAccess(t, k, ctx)
+
+	require.NoError(t, k.OnTimeoutPacket(ctx, channeltypes.Packet{SourceChannel: testChannelID, Sequence: 1}))
+
+	p, _ := k.GetIcaPacket(ctx, testChannelID, 1)
+	require.Equal(t, types.PacketStatus_PACKET_STATUS_TIMED_OUT, p.Status)
+
+	res, err := NewQueryServerImpl(k).IcaPackets(ctx, &types.QueryIcaPacketsRequest{
+		Status: types.PacketStatus_PACKET_STATUS_TIMED_OUT,
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Packets, 1)
+
+	res, err = NewQueryServerImpl(k).IcaPackets(ctx, &types.QueryIcaPacketsRequest{
+		Status: types.PacketStatus_PACKET_STATUS_PENDING,
+	})
+	require.NoError(t, err)
+	require.Empty(t, res.Packets)
+}
@@ -0,0 +1,135 @@
+package keeper
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/shinzonetwork/shinzohub/x/sourcehub/types"
+)
+
+func streamPriceKey(resource types.Resource, streamId string) collections.Pair[int32, string] {
+	return collections.Join(int32(resource), streamId)
+}
+
+// GetStreamPrice returns the price of access to a stream.
+func (k Keeper) GetStreamPrice(ctx sdk.Context, resource types.Resource, streamId string) (types.StreamPrice, bool) {
+	p, err := k.StreamPrices.Get(ctx, streamPriceKey(resource, streamId))
+	if err != nil {
+		return types.StreamPrice{}, false
+	}
+	return p, true
+}
+
+// requirePriceSetter returns an unauthorized error unless signer may price
+// the stream: access granters may price any stream, and the creator of a view
+// may price that view.
+func (k Keeper) requirePriceSetter(ctx sdk.Context, signer string, resource types.Resource, streamId string) error {
+	if k.HasRole(ctx, signer, types.ModuleRole_MODULE_ROLE_ACCESS_GRANTER) {
+		return nil
+	}
+	if resource == types.Resource_RESOURCE_VIEW {
+		v, err := k.Views.Get(ctx, streamId)
+		if err == nil && v.Creator == signer {
+			return nil
+		} else if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return err
+		}
+	}
+	return sdkerrors.ErrUnauthorized.Wrapf("%s role or view creator required", types.ModuleRoleName(types.ModuleRole_MODULE_ROLE_ACCESS_GRANTER))
+}
+
+// streamExists reports whether a stream can be priced: a view must be
+// registered, and a primitive must be owned on some target by the interchain
+// account of that target, as mirrored from SourceHub.
+func (k Keeper) streamExists(ctx sdk.Context, resource types.Resource, streamId string) (bool, error) {
+	if resource == types.Resource_RESOURCE_VIEW {
+		return k.Views.Has(ctx, streamId)
+	}
+
+	exists := false
+	err := k.Targets.Walk(ctx, nil, func(chainId string, _ types.SourcehubTarget) (bool, error) {
+		rng := collections.NewSuperPrefixedQuadRange3[string, string, string, string](chainId, objectKey(types.PrimitiveResourceName, streamId), ownerRelation)
+		return exists, k.Relationships.Walk(ctx, rng, func(_ collections.Quad[string, string, string, string], r types.Relationship) (bool, error) {
+			exists = r.Status != types.RelationshipStatus_RELATIONSHIP_STATUS_FAILED
+			return exists, nil
+		})
+	})
+	return exists, err
+}
+
+// accessExpiration returns the expiration of access bought for duration
+// seconds at now, extending current when it lies in the future.
+func accessExpiration(now, current, duration uint64) uint64 {
+	if duration == 0 {
+		return 0
+	}
+	return max(now, current) + duration
+}
+
+// lockPayment moves the price of a stream from payer to the module account.
+func (k Keeper) lockPayment(ctx sdk.Context, payer string, amount sdk.Coins) error {
+	addr, err := sdk.AccAddressFromBech32(payer)
+	if err != nil {
+		return err
+	}
+	return k.bankKeeper.SendCoinsFromAccountToModule(ctx, addr, types.ModuleName, amount)
+}
+
+// resolvePayment settles the payment escrowed for command c once SourceHub
+// acknowledged it, adding it to the revenue of the stream, or refunds it,
+// along with the grant it bought, once SourceHub rejected c or c was
+// cancelled. A refund that cannot be sent is logged and the payment stays in
+// escrow.
+func (k Keeper) resolvePayment(ctx sdk.Context, c types.IcaCommand) error {
+	payment, err := k.StreamPayments.Get(ctx, c.Id)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if c.Status == types.PacketStatus_PACKET_STATUS_ACKNOWLEDGED {
+		if err := k.StreamPayments.Remove(ctx, c.Id); err != nil {
+			return err
+		}
+		if err := k.addStreamRevenue(ctx, payment.Resource, payment.StreamId, payment.Amount); err != nil {
+			return err
+		}
+		return ctx.EventManager().EmitTypedEvent(&types.EventStreamPaymentSettled{Payment: payment})
+	}
+	if c.Status != types.PacketStatus_PACKET_STATUS_FAILED {
+		return nil
+	}
+
+	payer, err := sdk.AccAddressFromBech32(payment.Payer)
+	if err != nil {
+		return err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, payer, payment.Amount); err != nil {
+		k.Logger(ctx).Error("failed to refund stream payment", "command", c.Id, "payer", payment.Payer, "amount", payment.Amount.String(), "error", err)
+		return nil
+	}
+	if err := k.StreamPayments.Remove(ctx, c.Id); err != nil {
+		return err
+	}
+
+	// The grant is left alone if a later command took over its relationship.
+	resource, err := resourceName(payment.Resource)
+	if err != nil {
+		return err
+	}
+	r, found := k.GetRelationship(ctx, c.Target, resource, payment.StreamId, subscriberRelation, payment.Did)
+	if grant, ok := k.GetGrant(ctx, c.Target, payment.Resource, payment.StreamId, payment.Did); ok && found && r.CommandId == c.Id {
+		if err := k.RemoveGrant(ctx, grant); err != nil {
+			return err
+		}
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventStreamPaymentRefunded{
+		Payment: payment,
+		Error:   c.Error,
+	})
+}
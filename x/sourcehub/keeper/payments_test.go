@@ -0,0 +1,288 @@
+package keeper
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/shinzonetwork/shinzohub/x/sourcehub/types"
+)
+
+var (
+	testBuyer = sdk.AccAddress("buyer_______________")
+	testPrice = sdk.NewCoins(sdk.NewInt64Coin("ushinzo", 100))
+)
+
+// priceStream registers view-1 if needed, puts it on sale for testPrice per
+// duration seconds and funds testBuyer with funds.
+func priceStream(t *testing.T, k Keeper, ctx sdk.Context, duration uint64, funds sdk.Coins) {
+	t.Helper()
+
+	if has, _ := k.Views.Has(ctx, "view-1"); !has {
+		require.NoError(t, k.Views.Set(ctx, "view-1", types.View{Id: "view-1", Target: testTarget}))
+	}
+	_, err := NewMsgServerImpl(k).SetStreamPrice(ctx, &types.MsgSetStreamPrice{
+		Signer:   k.GetAuthority(),
+		Resource: types.Resource_RESOURCE_VIEW,
+		StreamId: "view-1",
+		Amount:   testPrice,
+		Duration: duration,
+	})
+	require.NoError(t, err)
+	testBank(k).balances[testBuyer.String()] = funds
+}
+
+func buyStreamAccess(ctx sdk.Context, k Keeper, did string) (*types.MsgBuyStreamAccessResponse, error) {
+	return NewMsgServerImpl(k).BuyStreamAccess(ctx, &types.MsgBuyStreamAccess{
+		Buyer:    testBuyer.String(),
+		Resource: types.Resource_RESOURCE_VIEW,
+		StreamId: "view-1",
+		Did:      did,
+	})
+}
+
+func TestSetStreamPrice(t *testing.T) {
+	k, ctx, _ := setupKeeperWithICA(t)
+	ms := NewMsgServerImpl(k)
+	creator := sdk.AccAddress("creator_____________")
+	registerPrimitives(t, k, ctx, "Log")
+	require.NoError(t, k.RegisterObject(ctx, "", "Logs_0x01", creator, "Log {address}"))
+
+	setPrice := func(signer sdk.AccAddress, resource types.Resource, streamId string, amount sdk.Coins) error {
+		_, err := ms.SetStreamPrice(ctx, &types.MsgSetStreamPrice{
+			Signer:   signer.String(),
+			Resource: resource,
+			StreamId: streamId,
+			Amount:   amount,
+			Duration: 3600,
+		})
+		return err
+	}
+
+	// The creator of a view prices it, but nothing else.
+	require.NoError(t, setPrice(creator, types.Resource_RESOURCE_VIEW, "Logs_0x01", testPrice))
+	require.ErrorIs(t, setPrice(creator, types.Resource_RESOURCE_VIEW, "view-1", testPrice), sdkerrors.ErrUnauthorized)
+	require.ErrorIs(t, setPrice(creator, types.Resource_RESOURCE_PRIMITIVE, "Logs_0x01", testPrice), sdkerrors.ErrUnauthorized)
+
+	// Access granters price any stream, as long as it is registered.
+	granter := sdk.AccAddress("granter_____________")
+	require.NoError(t, k.GrantRole(ctx, types.ModuleRole_MODULE_ROLE_ACCESS_GRANTER, granter.String()))
+	require.NoError(t, setPrice(granter, types.Resource_RESOURCE_PRIMITIVE, "Log", testPrice))
+	require.ErrorIs(t, setPrice(granter, types.Resource_RESOURCE_PRIMITIVE, "Block", testPrice), types.ErrUnknownStream)
+	require.ErrorIs(t, setPrice(granter, types.Resource_RESOURCE_VIEW, "Logs_0x02", testPrice), types.ErrUnknownStream)
+
+	p, found := k.GetStreamPrice(ctx, types.Resource_RESOURCE_VIEW, "Logs_0x01")
+	require.True(t, found)
+	require.Equal(t, types.StreamPrice{
+		Resource: types.Resource_RESOURCE_VIEW,
+		StreamId: "Logs_0x01",
+		Amount:   testPrice,
+		Duration: 3600,
+		Setter:   creator.String(),
+	}, p)
+
+	res, err := NewQueryServerImpl(k).StreamPrices(ctx, &types.QueryStreamPricesRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Prices, 2)
+
+	// An empty amount takes the stream off sale.
+	require.NoError(t, setPrice(creator, types.Resource_RESOURCE_VIEW, "Logs_0x01", nil))
+	_, found = k.GetStreamPrice(ctx, types.Resource_RESOURCE_VIEW, "Logs_0x01")
+	require.False(t, found)
+}
+
+func TestBuyStreamAccessSettledOnAck(t *testing.T) {
+	k, ctx, ica := setupKeeperWithICA(t)
+	ctx = ctx.WithBlockTime(time.Unix(1000, 0))
+	bank := testBank(k)
+	priceStream(t, k, ctx, 3600, sdk.NewCoins(sdk.NewInt64Coin("ushinzo", 250)))
+
+	res, err := buyStreamAccess(ctx, k, "did:key:alice")
+	require.NoError(t, err)
+	require.Equal(t, uint64(4600), res.Expiration)
+
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ushinzo", 150)), bank.balances[testBuyer.String()])
+	require.Equal(t, testPrice, bank.balances[types.ModuleAddress.String()])
+	payment, err := k.StreamPayments.Get(ctx, 0)
+	require.NoError(t, err)
+	require.Equal(t, testBuyer.String(), payment.Payer)
+	require.Equal(t, testPrice, payment.Amount)
+
+	g, found := k.GetGrant(ctx, "", types.Resource_RESOURCE_VIEW, "view-1", "did:key:alice")
+	require.True(t, found)
+	require.Equal(t, uint64(4600), g.Expiration)
+	require.Equal(t, testBuyer.String(), g.Signer)
+	require.Equal(t, types.RelationshipStatus_RELATIONSHIP_STATUS_PENDING, subscriberStatus(t, k, ctx, "did:key:alice"))
+
+	require.NoError(t, k.FlushOutbox(ctx))
+	packet := channeltypes.Packet{SourceChannel: testChannelID, Sequence: ica.sequence}
+	require.NoError(t, k.OnAcknowledgementPacket(ctx, packet, msgResponsesAck(t, 1)))
+
+	has, err := k.StreamPayments.Has(ctx, 0)
+	require.NoError(t, err)
+	require.False(t, has)
+	require.Equal(t, testPrice, bank.balances[types.ModuleAddress.String()])
+	var settled types.EventStreamPaymentSettled
+	require.True(t, lastTypedEvent(t, ctx, &settled))
+	require.Equal(t, payment, settled.Payment)
+	revenue, err := k.StreamRevenues.Get(ctx, streamPriceKey(types.Resource_RESOURCE_VIEW, "view-1"))
+	require.NoError(t, err)
+	require.Equal(t, testPrice, revenue.Amount)
+
+	// Buying again extends the grant without another command.
+	ctx = ctx.WithBlockTime(time.Unix(2000, 0))
+	res, err = buyStreamAccess(ctx, k, "did:key:alice")
+	require.NoError(t, err)
+	require.Equal(t, uint64(8200), res.Expiration)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ushinzo", 50)), bank.balances[testBuyer.String()])
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ushinzo", 200)), bank.balances[types.ModuleAddress.String()])
+	revenue, err = k.StreamRevenues.Get(ctx, streamPriceKey(types.Resource_RESOURCE_VIEW, "view-1"))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ushinzo", 200)), revenue.Amount)
+
+	next, err := k.NextCommandId.Peek(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), next)
+
+	// The buyer cannot afford a third period.
+	_, err = buyStreamAccess(ctx, k, "did:key:alice")
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+}
+
+func TestBuyStreamAccessRefundedOnFailedAck(t *testing.T) {
+	k, ctx, ica := setupKeeperWithICA(t)
+	bank := testBank(k)
+	priceStream(t, k, ctx, 0, testPrice)
+
+	// SourceHub rejecting the relationship refunds the payment at once.
+	res, err := buyStreamAccess(ctx, k, "did:key:alice")
+	require.NoError(t, err)
+	require.Zero(t, res.Expiration)
+	require.True(t, bank.balances[testBuyer.String()].IsZero())
+
+	require.NoError(t, k.FlushOutbox(ctx))
+	packet := channeltypes.Packet{SourceChannel: testChannelID, Sequence: ica.sequence}
+	ack := channeltypes.NewErrorAcknowledgement(errors.New("policy not found"))
+	require.NoError(t, k.OnAcknowledgementPacket(ctx, packet, ack.Acknowledgement()))
+
+	require.Equal(t, testPrice, bank.balances[testBuyer.String()])
+	require.True(t, bank.balances[types.ModuleAddress.String()].IsZero())
+	has, err := k.StreamPayments.Has(ctx, 0)
+	require.NoError(t, err)
+	require.False(t, has)
+	_, found := k.GetGrant(ctx, "", types.Resource_RESOURCE_VIEW, "view-1", "did:key:alice")
+	require.False(t, found)
+	require.Equal(t, types.RelationshipStatus_RELATIONSHIP_STATUS_FAILED, subscriberStatus(t, k, ctx, "did:key:alice"))
+
+	var refunded types.EventStreamPaymentRefunded
+	require.True(t, lastTypedEvent(t, ctx, &refunded))
+	require.Equal(t, uint64(0), refunded.Payment.CommandId)
+	require.NotEmpty(t, refunded.Error)
+}
+
+func TestBuyStreamAccessRetriedOnTimeout(t *testing.T) {
+	k, ctx, ica := setupKeeperWithICA(t)
+	bank := testBank(k)
+	priceStream(t, k, ctx, 0, testPrice)
+
+	params, err := k.GetParams(ctx)
+	require.NoError(t, err)
+	params.MaxAttempts = 1
+	k.SetParams(ctx, params)
+
+	_, err = buyStreamAccess(ctx, k, "did:key:alice")
+	require.NoError(t, err)
+
+	// A timed out relationship may still be set, the payment stays in escrow
+	// and the command is sent again past its attempt limit.
+	for range 2 {
+		require.NoError(t, k.FlushOutbox(ctx))
+		packet := channeltypes.Packet{SourceChannel: testChannelID, Sequence: ica.sequence}
+		require.NoError(t, k.OnTimeoutPacket(ctx, packet))
+
+		c, _ := k.GetIcaCommand(ctx, 0)
+		require.Equal(t, types.PacketStatus_PACKET_STATUS_QUEUED, c.Status)
+		require.Equal(t, testPrice, bank.balances[types.ModuleAddress.String()])
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + maxRetryDelay)
+	}
+
+	require.NoError(t, k.FlushOutbox(ctx))
+	packet := channeltypes.Packet{SourceChannel: testChannelID, Sequence: ica.sequence}
+	require.NoError(t, k.OnAcknowledgementPacket(ctx, packet, msgResponsesAck(t, 1)))
+	has, err := k.StreamPayments.Has(ctx, 0)
+	require.NoError(t, err)
+	require.False(t, has)
+	require.True(t, bank.balances[testBuyer.String()].IsZero())
+}
+
+func TestBuyStreamAccessRejected(t *testing.T) {
+	k, ctx, ica := setupKeeperWithICA(t)
+
+	_, err := buyStreamAccess(ctx, k, "did:key:alice")
+	require.ErrorContains(t, err, "not for sale")
+
+	priceStream(t, k, ctx, 0, sdk.NewCoins(sdk.NewInt64Coin("ushinzo", 99)))
+	_, err = buyStreamAccess(ctx, k, "did:key:alice")
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+
+	// Access SourceHub has not confirmed yet cannot be extended, the command
+	// setting it may still fail.
+	queueStreamAccess(t, k, ctx, "did:key:bob")
+	testBank(k).balances[testBuyer.String()] = testPrice
+	_, err = buyStreamAccess(ctx, k, "did:key:bob")
+	require.ErrorContains(t, err, "not confirmed by SourceHub yet")
+
+	// Nor can access that never expires.
+	require.NoError(t, k.FlushOutbox(ctx))
+	packet := channeltypes.Packet{SourceChannel: testChannelID, Sequence: ica.sequence}
+	require.NoError(t, k.OnAcknowledgementPacket(ctx, packet, msgResponsesAck(t, 1)))
+	_, err = buyStreamAccess(ctx, k, "did:key:bob")
+	require.ErrorContains(t, err, "never expires")
+	require.Equal(t, testPrice, testBank(k).balances[testBuyer.String()])
+
+	next, err := k.NextCommandId.Peek(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), next)
+}
+
+func TestBuyStreamAccessResendsFailedAccess(t *testing.T) {
+	k, ctx, ica := setupKeeperWithICA(t)
+	ctx = ctx.WithBlockTime(time.Unix(1000, 0))
+	priceStream(t, k, ctx, 3600, testPrice)
+
+	params, err := k.GetParams(ctx)
+	require.NoError(t, err)
+	params.MaxAttempts = 1
+	k.SetParams(ctx, params)
+
+	// A granted relationship that failed on SourceHub keeps its grant.
+	_, err = NewMsgServerImpl(k).RequestStreamAccess(ctx, &types.MsgRequestStreamAccess{
+		Signer:     k.GetAuthority(),
+		Resource:   types.Resource_RESOURCE_VIEW,
+		StreamId:   "view-1",
+		Did:        "did:key:bob",
+		Expiration: 2000,
+	})
+	require.NoError(t, err)
+	require.NoError(t, k.FlushOutbox(ctx))
+	packet := channeltypes.Packet{SourceChannel: testChannelID, Sequence: ica.sequence}
+	ack := channeltypes.NewErrorAcknowledgement(errors.New("policy not found"))
+	require.NoError(t, k.OnAcknowledgementPacket(ctx, packet, ack.Acknowledgement()))
+	require.Equal(t, types.RelationshipStatus_RELATIONSHIP_STATUS_FAILED, subscriberStatus(t, k, ctx, "did:key:bob"))
+
+	// Buying it sends the relationship again, with the payment in escrow
+	// until SourceHub sets it, and keeps the time left on the grant.
+	res, err := buyStreamAccess(ctx, k, "did:key:bob")
+	require.NoError(t, err)
+	require.Equal(t, uint64(5600), res.Expiration)
+	require.Equal(t, testPrice, testBank(k).balances[types.ModuleAddress.String()])
+	payment, err := k.StreamPayments.Get(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, testPrice, payment.Amount)
+	require.Equal(t, types.RelationshipStatus_RELATIONSHIP_STATUS_PENDING, subscriberStatus(t, k, ctx, "did:key:bob"))
+}
@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"slices"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/shinzonetwork/shinzohub/x/sourcehub/types"
+)
+
+// HasRole reports whether address holds role, either explicitly or by being
+// the module admin or authority.
+func (k Keeper) HasRole(ctx sdk.Context, address string, role types.ModuleRole) bool {
+	if k.IsAdmin(ctx, address) {
+		return true
+	}
+
+	has, err := k.RoleHolders.Has(ctx, collections.Join(int32(role), address))
+	return err == nil && has
+}
+
+// GetAccountRoles returns the roles explicitly assigned to address.
+func (k Keeper) GetAccountRoles(ctx sdk.Context, address string) []types.ModuleRole {
+	var roles []types.ModuleRole
+	for role := range types.ModuleRole_name {
+		if role == int32(types.ModuleRole_MODULE_ROLE_UNSPECIFIED) {
+			continue
+		}
+		if has, err := k.RoleHolders.Has(ctx, collections.Join(role, address)); err == nil && has {
+			roles = append(roles, types.ModuleRole(role))
+		}
+	}
+
+	// map iteration order is random, keep the output deterministic
+	slices.Sort(roles)
+	return roles
+}
+
+// GrantRole assigns role to address.
+func (k Keeper) GrantRole(ctx sdk.Context, role types.ModuleRole, address string) error {
+	if err := types.ValidateModuleRole(role); err != nil {
+		return err
+	}
+	return k.RoleHolders.Set(ctx, collections.Join(int32(role), address))
+}
+
+// RevokeRole removes role from address.
+func (k Keeper) RevokeRole(ctx sdk.Context, role types.ModuleRole, address string) error {
+	return k.RoleHolders.Remove(ctx, collections.Join(int32(role), address))
+}
+
+// requireRole returns an unauthorized error if signer does not hold role.
+func (k Keeper) requireRole(ctx sdk.Context, signer string, role types.ModuleRole) error {
+	if !k.HasRole(ctx, signer, role) {
+		return sdkerrors.ErrUnauthorized.Wrapf("%s role required", types.ModuleRoleName(role))
+	}
+	return nil
+}
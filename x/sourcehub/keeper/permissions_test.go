@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/shinzonetwork/shinzohub/x/sourcehub/types"
+)
+
+func TestRoleBasedPermissions(t *testing.T) {
+	k, ctx, ica := setupKeeperWithICA(t)
+	ms := NewMsgServerImpl(k)
+
+	registrar := sdk.AccAddress("registrar___________").String()
+
+	_, err := ms.GrantRole(ctx, &types.MsgGrantRole{
+		Signer:  registrar,
+		Role:    types.ModuleRole_MODULE_ROLE_ACCESS_GRANTER,
+		Address: registrar,
+	})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = ms.GrantRole(ctx, &types.MsgGrantRole{
+		Signer:  k.GetAuthority(),
+		Role:    types.ModuleRole_MODULE_ROLE_ACCESS_GRANTER,
+		Address: registrar,
+	})
+	require.NoError(t, err)
+
+	// The access granter can grant stream access ...
+	_, err = ms.RequestStreamAccess(ctx, &types.MsgRequestStreamAccess{
+		Signer:   registrar,
+		Resource: types.Resource_RESOURCE_VIEW,
+		StreamId: "view-1",
+		Did:      "did:key:alice",
+	})
+	require.NoError(t, err)
+	require.NoError(t, k.FlushOutbox(ctx))
+	require.Len(t, ica.sent, 1)
+
+	// ... but cannot re-register the policy or objects.
+	_, err = ms.RegisterShinzoPolicy(ctx, &types.MsgRegisterShinzoPolicy{Signer: registrar})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = ms.RegisterShinzoObjects(ctx, &types.MsgRegisterShinzoObjects{Signer: registrar, Resources: []string{"block"}})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = ms.RevokeRole(ctx, &types.MsgRevokeRole{
+		Signer:  k.GetAuthority(),
+		Role:    types.ModuleRole_MODULE_ROLE_ACCESS_GRANTER,
+		Address: registrar,
+	})
+	require.NoError(t, err)
+
+	_, err = ms.RequestStreamAccess(ctx, &types.MsgRequestStreamAccess{
+		Signer:   registrar,
+		Resource: types.Resource_RESOURCE_VIEW,
+		StreamId: "view-2",
+		Did:      "did:key:alice",
+	})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+}
+
+func TestQueryRoles(t *testing.T) {
+	k, ctx, _ := setupKeeperWithICA(t)
+	q := NewQueryServerImpl(k)
+
+	holder := sdk.AccAddress("holder______________").String()
+	require.NoError(t, k.GrantRole(ctx, types.ModuleRole_MODULE_ROLE_OBJECT_REGISTRAR, holder))
+	require.NoError(t, k.GrantRole(ctx, types.ModuleRole_MODULE_ROLE_ACCESS_GRANTER, holder))
+	require.Error(t, k.GrantRole(ctx, types.ModuleRole_MODULE_ROLE_UNSPECIFIED, holder))
+
+	res, err := q.AccountRoles(ctx, &types.QueryAccountRolesRequest{Address: holder})
+	require.NoError(t, err)
+	require.False(t, res.IsAdmin)
+	require.Equal(t, []types.ModuleRole{
+		types.ModuleRole_MODULE_ROLE_OBJECT_REGISTRAR,
+		types.ModuleRole_MODULE_ROLE_ACCESS_GRANTER,
+	}, res.Roles)
+
+	holders, err := q.RoleHolders(ctx, &types.QueryRoleHoldersRequest{Role: types.ModuleRole_MODULE_ROLE_ACCESS_GRANTER})
+	require.NoError(t, err)
+	require.Equal(t, []types.RoleHolder{{Role: types.ModuleRole_MODULE_ROLE_ACCESS_GRANTER, Address: holder}}, holders.Holders)
+
+	gs := k.ExportGenesis(ctx)
+	require.Len(t, gs.RoleHolders, 2)
+	require.NoError(t, gs.Validate())
+}
@@ -1,25 +1,283 @@
 package keeper
 
 import (
+	"context"
+	"crypto/sha256"
 	_ "embed"
+	"encoding/hex"
+	"errors"
+	"fmt"
 
-	"github.com/cosmos/cosmos-sdk/runtime"
+	"cosmossdk.io/collections"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+	acptypes "github.com/sourcenetwork/sourcehub/x/acp/types"
+
 	"github.com/shinzonetwork/shinzohub/x/sourcehub/types"
 )
 
-func (k Keeper) SetPolicyId(ctx sdk.Context, txType string) {
-	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	store.Set([]byte(types.PolicyId), []byte(txType))
+// policyHash returns the hex-encoded SHA-256 of a policy document.
+func policyHash(doc string) string {
+	h := sha256.Sum256([]byte(doc))
+	return hex.EncodeToString(h[:])
+}
+
+// embeddedPolicyVersion returns the policy embedded in the binary as version 0.
+func embeddedPolicyVersion() types.PolicyVersion {
+	return types.PolicyVersion{
+		Version: 0,
+		Hash:    policyHash(policy),
+		Policy:  policy,
+	}
+}
+
+// ActivePolicy returns the active version of the shinzohub policy: the last
+// version adopted through governance, or the embedded policy if there is none.
+func (k Keeper) ActivePolicy(ctx context.Context) (types.PolicyVersion, error) {
+	iter, err := k.PolicyVersions.Iterate(ctx, new(collections.Range[uint64]).Descending())
+	if err != nil {
+		return types.PolicyVersion{}, err
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return embeddedPolicyVersion(), nil
+	}
+	return iter.Value()
+}
+
+// GetPolicyVersion returns a version of the shinzohub policy. Version 0 is
+// the embedded policy.
+func (k Keeper) GetPolicyVersion(ctx context.Context, version uint64) (types.PolicyVersion, error) {
+	if version == 0 {
+		return embeddedPolicyVersion(), nil
+	}
+	return k.PolicyVersions.Get(ctx, version)
+}
+
+// policyVersionByHash returns the last version of the policy whose document
+// has the given hash.
+func (k Keeper) policyVersionByHash(ctx context.Context, hash string) (uint64, error) {
+	iter, err := k.PolicyVersions.Iterate(ctx, new(collections.Range[uint64]).Descending())
+	if err != nil {
+		return 0, err
+	}
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		v, err := iter.Value()
+		if err != nil {
+			return 0, err
+		}
+		if v.Hash == hash {
+			return v.Version, nil
+		}
+	}
+	if hash == policyHash(policy) {
+		return 0, nil
+	}
+	return 0, fmt.Errorf("no policy version with hash %s", hash)
+}
+
+// AdoptPolicy stores doc as the next version of the shinzohub policy. The
+// document must be a valid policy and differ from the active version.
+func (k Keeper) AdoptPolicy(ctx sdk.Context, authority, doc string) (types.PolicyVersion, error) {
+	if _, err := parsePolicy(doc); err != nil {
+		return types.PolicyVersion{}, types.ErrInvalidPolicy.Wrap(err.Error())
+	}
+
+	active, err := k.ActivePolicy(ctx)
+	if err != nil {
+		return types.PolicyVersion{}, err
+	}
+
+	v := types.PolicyVersion{
+		Version:   active.Version + 1,
+		Hash:      policyHash(doc),
+		Policy:    doc,
+		Authority: authority,
+		Height:    ctx.BlockHeight(),
+	}
+	if v.Hash == active.Hash {
+		return types.PolicyVersion{}, types.ErrInvalidPolicy.Wrapf("policy is already active as version %d", active.Version)
+	}
+
+	if err := k.PolicyVersions.Set(ctx, v.Version, v); err != nil {
+		return types.PolicyVersion{}, err
+	}
+	return v, nil
+}
+
+// msgResponse decodes the response of type resp from the result of an ICA
+// acknowledgement.
+func msgResponse(result []byte, resp gogoproto.Message) error {
+	var txMsgData sdk.TxMsgData
+	if err := gogoproto.Unmarshal(result, &txMsgData); err != nil {
+		return fmt.Errorf("cannot unmarshal ICA tx result: %w", err)
+	}
+
+	for _, anyResp := range txMsgData.MsgResponses {
+		if anyResp.TypeUrl != sdk.MsgTypeURL(resp) {
+			continue
+		}
+		if err := gogoproto.Unmarshal(anyResp.Value, resp); err != nil {
+			return fmt.Errorf("cannot unmarshal %s: %w", gogoproto.MessageName(resp), err)
+		}
+		return nil
+	}
+	return fmt.Errorf("no %s in ICA tx result", gogoproto.MessageName(resp))
+}
+
+// sentPolicyVersion returns the version of the policy document carried by the
+// payload of a policy creation packet or edit command.
+func (k Keeper) sentPolicyVersion(ctx sdk.Context, payload []byte) (uint64, error) {
+	var tx icatypes.CosmosTx
+	if err := gogoproto.Unmarshal(payload, &tx); err != nil {
+		return 0, fmt.Errorf("decode ICA payload: %w", err)
+	}
+
+	for _, m := range tx.Messages {
+		var doc string
+		switch m.TypeUrl {
+		case sdk.MsgTypeURL(&acptypes.MsgCreatePolicy{}):
+			var msg acptypes.MsgCreatePolicy
+			if err := gogoproto.Unmarshal(m.Value, &msg); err != nil {
+				return 0, err
+			}
+			doc = msg.Policy
+		case sdk.MsgTypeURL(&acptypes.MsgEditPolicy{}):
+			var msg acptypes.MsgEditPolicy
+			if err := gogoproto.Unmarshal(m.Value, &msg); err != nil {
+				return 0, err
+			}
+			doc = msg.Policy
+		default:
+			continue
+		}
+		return k.policyVersionByHash(ctx, policyHash(doc))
+	}
+	return 0, errors.New("no policy in ICA packet")
 }
 
-func (k Keeper) GetPolicyId(ctx sdk.Context) string {
-	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	bz := store.Get([]byte(types.PolicyId))
-	if bz == nil {
-		return ""
+// capturePolicyId decodes the MsgCreatePolicyResponse carried by the result of
+// an acknowledged RegisterShinzoPolicy command and stores the created policy
+// ID and the version of the policy sent on the target the command was sent to.
+func (k Keeper) capturePolicyId(ctx sdk.Context, c types.IcaCommand) error {
+	t, err := k.resolveTarget(ctx, c.Target)
+	if err != nil {
+		return err
+	}
+
+	var resp acptypes.MsgCreatePolicyResponse
+	if err := msgResponse(c.Result, &resp); err != nil {
+		return err
+	}
+
+	if resp.Record == nil || resp.Record.Policy == nil || resp.Record.Policy.Id == "" {
+		return fmt.Errorf("MsgCreatePolicyResponse carries no policy ID")
+	}
+
+	version, err := k.sentPolicyVersion(ctx, c.Payload)
+	if err != nil {
+		return err
+	}
+
+	policyId := resp.Record.Policy.Id
+	if previous := t.PolicyId; previous != "" && previous != policyId {
+		k.Logger(ctx).Info("replacing policy ID", "target", t.ChainId, "previous", previous, "policy_id", policyId)
+	}
+	t.PolicyId = policyId
+	t.PolicyVersion = version
+	if err := k.SetTarget(ctx, t); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventPolicyRegistered{
+		Target:    t.ChainId,
+		PolicyId:  policyId,
+		Sequence:  c.Sequence,
+		CommandId: c.Id,
+	})
+}
+
+// applyPolicyEdit records the policy version of an acknowledged policy edit
+// command on its target. SourceHub deletes the relationships whose relation
+// the new version removed, and so does the mirror.
+func (k Keeper) applyPolicyEdit(ctx sdk.Context, c types.IcaCommand) error {
+	t, err := k.resolveTarget(ctx, c.Target)
+	if err != nil {
+		return err
+	}
+
+	var resp acptypes.MsgEditPolicyResponse
+	if err := msgResponse(c.Result, &resp); err != nil {
+		return err
+	}
+
+	version, err := k.sentPolicyVersion(ctx, c.Payload)
+	if err != nil {
+		return err
+	}
+	pol, err := k.parsedPolicy(ctx, version)
+	if err != nil {
+		return err
+	}
+
+	t.PolicyVersion = version
+	if err := k.SetTarget(ctx, t); err != nil {
+		return err
+	}
+
+	var removed []types.Relationship
+	rng := collections.NewPrefixedQuadRange[string, string, string, string](t.ChainId)
+	err = k.Relationships.Walk(ctx, rng, func(_ collections.Quad[string, string, string, string], r types.Relationship) (bool, error) {
+		if r.Relation == ownerRelation {
+			return false, nil
+		}
+		if res := pol.GetResourceByName(r.Resource); res == nil || res.GetRelationByName(r.Relation) == nil {
+			removed = append(removed, r)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, r := range removed {
+		if err := k.RemoveRelationship(ctx, r); err != nil {
+			return err
+		}
+		if err := k.dropSubscription(ctx, t.ChainId, r, c.Id); err != nil {
+			return err
+		}
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventPolicyEdited{
+		Target:               t.ChainId,
+		PolicyId:             t.PolicyId,
+		Version:              version,
+		Sequence:             c.Sequence,
+		RelationshipsRemoved: resp.RelationshipsRemoved,
+		CommandId:            c.Id,
+	})
+}
+
+// dropSubscription removes the grant of the subscriber relationship r, which
+// SourceHub deleted when the edit command with the given ID removed its
+// relation. Other relationships back no grant.
+func (k Keeper) dropSubscription(ctx sdk.Context, target string, r types.Relationship, commandId uint64) error {
+	if r.Relation != subscriberRelation {
+		return nil
+	}
+	for _, resource := range []types.Resource{types.Resource_RESOURCE_PRIMITIVE, types.Resource_RESOURCE_VIEW} {
+		if name, err := resourceName(resource); err != nil || name != r.Resource {
+			continue
+		}
+		if g, found := k.GetGrant(ctx, target, resource, r.ObjectId, r.Actor); found {
+			return k.dropGrant(ctx, target, g, commandId)
+		}
 	}
-	return string(bz)
+	return nil
 }
 
 //go:embed policy.yaml
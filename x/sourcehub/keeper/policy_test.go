@@ -0,0 +1,278 @@
+package keeper
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	coretypes "github.com/sourcenetwork/acp_core/pkg/types"
+	acptypes "github.com/sourcenetwork/sourcehub/x/acp/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/shinzonetwork/shinzohub/x/sourcehub/types"
+)
+
+func createPolicyResult(t *testing.T, policyId string) []byte {
+	t.Helper()
+
+	resp, err := codectypes.NewAnyWithValue(&acptypes.MsgCreatePolicyResponse{
+		Record: &acptypes.PolicyRecord{Policy: &coretypes.Policy{Id: policyId}},
+	})
+	require.NoError(t, err)
+
+	bz, err := gogoproto.Marshal(&sdk.TxMsgData{MsgResponses: []*codectypes.Any{resp}})
+	require.NoError(t, err)
+	return bz
+}
+
+// targetPolicyId returns the policy ID of the default target.
+func targetPolicyId(t *testing.T, k Keeper, ctx sdk.Context) string {
+	t.Helper()
+
+	target, err := k.resolveTarget(ctx, "")
+	require.NoError(t, err)
+	return target.PolicyId
+}
+
+func TestPolicyAckCapturesPolicyId(t *testing.T) {
+	k, ctx, ica := setupKeeperWithICA(t)
+
+	_, err := NewMsgServerImpl(k).RegisterShinzoPolicy(ctx, &types.MsgRegisterShinzoPolicy{Signer: k.GetAuthority()})
+	require.NoError(t, err)
+	require.NoError(t, k.FlushOutbox(ctx))
+
+	packet := channeltypes.Packet{SourceChannel: testChannelID, Sequence: ica.sequence}
+	ack := channeltypes.NewResultAcknowledgement(createPolicyResult(t, "policy-2"))
+	require.NoError(t, k.OnAcknowledgementPacket(ctx, packet, ack.Acknowledgement()))
+
+	require.Equal(t, "policy-2", targetPolicyId(t, k, ctx))
+
+	var ev types.EventPolicyRegistered
+	require.True(t, lastTypedEvent(t, ctx, &ev))
+	require.Equal(t, types.EventPolicyRegistered{Target: testTarget, PolicyId: "policy-2", Sequence: ica.sequence}, ev)
+}
+
+func TestPolicyAckWithoutResponseKeepsPolicyId(t *testing.T) {
+	k, ctx, ica := setupKeeperWithICA(t)
+
+	_, err := NewMsgServerImpl(k).RegisterShinzoPolicy(ctx, &types.MsgRegisterShinzoPolicy{Signer: k.GetAuthority()})
+	require.NoError(t, err)
+	require.NoError(t, k.FlushOutbox(ctx))
+
+	packet := channeltypes.Packet{SourceChannel: testChannelID, Sequence: ica.sequence}
+	ack := channeltypes.NewResultAcknowledgement([]byte("garbage"))
+	require.NoError(t, k.OnAcknowledgementPacket(ctx, packet, ack.Acknowledgement()))
+
+	require.Equal(t, "policy-1", targetPolicyId(t, k, ctx))
+}
+
+func TestStreamAccessAckDoesNotTouchPolicyId(t *testing.T) {
+	k, ctx, ica := setupKeeperWithICA(t)
+	requestStreamAccess(t, k, ctx)
+
+	packet := channeltypes.Packet{SourceChannel: testChannelID, Sequence: ica.sequence}
+	ack := channeltypes.NewResultAcknowledgement(createPolicyResult(t, "policy-2"))
+	require.NoError(t, k.OnAcknowledgementPacket(ctx, packet, ack.Acknowledgement()))
+
+	require.Equal(t, "policy-1", targetPolicyId(t, k, ctx))
+}
+
+// policyWithoutViewSubscribers returns the embedded policy with the
+// subscriber relation of views renamed to reader.
+func policyWithoutViewSubscribers(t *testing.T) string {
+	t.Helper()
+
+	start := strings.Index(policy, "- name: view\n")
+	end := strings.Index(policy, "- name: group\n")
+	require.True(t, start > 0 && end > start)
+	return policy[:start] + strings.ReplaceAll(policy[start:end], "subscriber", "reader") + policy[end:]
+}
+
+// sentPolicy decodes the policy document of the last packet sent.
+func sentPolicy(t *testing.T, ica *mockICAControllerKeeper) (string, string) {
+	t.Helper()
+
+	var tx icatypes.CosmosTx
+	require.NoError(t, gogoproto.Unmarshal(ica.sent[len(ica.sent)-1].Data, &tx))
+	require.Len(t, tx.Messages, 1)
+
+	switch tx.Messages[0].TypeUrl {
+	case sdk.MsgTypeURL(&acptypes.MsgEditPolicy{}):
+		var msg acptypes.MsgEditPolicy
+		require.NoError(t, gogoproto.Unmarshal(tx.Messages[0].Value, &msg))
+		return msg.PolicyId, msg.Policy
+	default:
+		var msg acptypes.MsgCreatePolicy
+		require.NoError(t, gogoproto.Unmarshal(tx.Messages[0].Value, &msg))
+		return "", msg.Policy
+	}
+}
+
+func TestUpdateShinzoPolicy(t *testing.T) {
+	k, ctx, ica := setupKeeperWithICA(t)
+	ctx = ctx.WithBlockHeight(7)
+	ms := NewMsgServerImpl(k)
+	qs := NewQueryServerImpl(k)
+	updated := policyWithoutViewSubscribers(t)
+
+	_, err := ms.UpdateShinzoPolicy(ctx, &types.MsgUpdateShinzoPolicy{Authority: testOutsider, Policy: updated})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = ms.UpdateShinzoPolicy(ctx, &types.MsgUpdateShinzoPolicy{Authority: k.GetAuthority(), Policy: "name: [shinzo"})
+	require.ErrorIs(t, err, types.ErrInvalidPolicy)
+	_, err = ms.UpdateShinzoPolicy(ctx, &types.MsgUpdateShinzoPolicy{Authority: k.GetAuthority(), Policy: policy})
+	require.ErrorContains(t, err, "already active as version 0")
+
+	// A confirmed subscriber whose relation the update removes.
+	queueStreamAccess(t, k, ctx, "did:key:alice")
+	require.NoError(t, k.FlushOutbox(ctx))
+	packet := channeltypes.Packet{SourceChannel: testChannelID, Sequence: ica.sequence}
+	require.NoError(t, k.OnAcknowledgementPacket(ctx, packet, msgResponsesAck(t, 1)))
+
+	res, err := ms.UpdateShinzoPolicy(ctx, &types.MsgUpdateShinzoPolicy{Authority: k.GetAuthority(), Policy: updated})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.Version)
+	require.Equal(t, policyHash(updated), res.Hash)
+
+	var updatedEv types.EventPolicyUpdated
+	require.True(t, lastTypedEvent(t, ctx, &updatedEv))
+	require.Equal(t, []string{testTarget}, updatedEv.Targets)
+
+	var submittedEv types.EventPolicySubmitted
+	require.True(t, lastTypedEvent(t, ctx, &submittedEv))
+	require.Equal(t, types.EventPolicySubmitted{Target: testTarget, Sender: k.GetAuthority(), CommandId: 1}, submittedEv)
+
+	require.NoError(t, k.FlushOutbox(ctx))
+	policyId, doc := sentPolicy(t, ica)
+	require.Equal(t, "policy-1", policyId)
+	require.Equal(t, updated, doc)
+
+	// Commands are checked against the version the target holds, which
+	// changes once SourceHub acknowledges the edit.
+	requestAccess := func(did string) error {
+		_, err := ms.RequestStreamAccess(ctx, &types.MsgRequestStreamAccess{
+			Signer:   k.GetAuthority(),
+			Resource: types.Resource_RESOURCE_VIEW,
+			StreamId: "view-1",
+			Did:      did,
+		})
+		return err
+	}
+	require.NoError(t, requestAccess("did:key:bob"))
+
+	resp, err := codectypes.NewAnyWithValue(&acptypes.MsgEditPolicyResponse{RelationshipsRemoved: 1})
+	require.NoError(t, err)
+	result, err := gogoproto.Marshal(&sdk.TxMsgData{MsgResponses: []*codectypes.Any{resp}})
+	require.NoError(t, err)
+	packet.Sequence = ica.sequence
+	require.NoError(t, k.OnAcknowledgementPacket(ctx, packet, channeltypes.NewResultAcknowledgement(result).Acknowledgement()))
+
+	target, err := k.resolveTarget(ctx, "")
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), target.PolicyVersion)
+	require.ErrorIs(t, requestAccess("did:key:carol"), types.ErrInvalidPolicyCmd)
+	_, found := k.GetRelationship(ctx, "", types.ViewResourceName, "view-1", "subscriber", "did:key:alice")
+	require.False(t, found, "the mirror drops the relationships of removed relations")
+	_, found = k.GetGrant(ctx, "", types.Resource_RESOURCE_VIEW, "view-1", "did:key:alice")
+	require.False(t, found, "the grants of removed subscriber relations are dropped")
+
+	var revokedEv types.EventStreamAccessRevoked
+	require.True(t, lastTypedEvent(t, ctx, &revokedEv))
+	require.Equal(t, testTarget, revokedEv.Target)
+	require.Equal(t, uint64(1), revokedEv.CommandId)
+
+	var editedEv types.EventPolicyEdited
+	require.True(t, lastTypedEvent(t, ctx, &editedEv))
+	require.Equal(t, types.EventPolicyEdited{Target: testTarget, PolicyId: "policy-1", Version: 1, Sequence: packet.Sequence, RelationshipsRemoved: 1, CommandId: 1}, editedEv)
+
+	active, err := qs.ShinzoPolicy(ctx, &types.QueryShinzoPolicyRequest{})
+	require.NoError(t, err)
+	require.Equal(t, types.PolicyVersion{Version: 1, Hash: policyHash(updated), Policy: updated, Authority: k.GetAuthority(), Height: 7}, active.Policy)
+
+	embedded, err := qs.ShinzoPolicyVersion(ctx, &types.QueryShinzoPolicyVersionRequest{Version: 0})
+	require.NoError(t, err)
+	require.Equal(t, policy, embedded.Policy.Policy)
+	_, err = qs.ShinzoPolicyVersion(ctx, &types.QueryShinzoPolicyVersionRequest{Version: 2})
+	require.ErrorContains(t, err, "policy version 2 not found")
+
+	history, err := qs.ShinzoPolicyHistory(ctx, &types.QueryShinzoPolicyHistoryRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.PolicyVersion{active.Policy}, history.Policies)
+
+	// Registering the policy again creates it from the active version.
+	require.NoError(t, k.FlushOutbox(ctx))
+	_, err = ms.RegisterShinzoPolicy(ctx, &types.MsgRegisterShinzoPolicy{Signer: k.GetAuthority()})
+	require.NoError(t, err)
+	require.NoError(t, k.FlushOutbox(ctx))
+	_, doc = sentPolicy(t, ica)
+	require.Equal(t, updated, doc)
+}
+
+func TestUpdateShinzoPolicyGoesThroughOutbox(t *testing.T) {
+	k, ctx, ica := setupKeeperWithICA(t)
+	addTarget(t, k, ctx, ica, "sourcehub-test", "connection-1")
+	ica.channels["connection-1"] = "channel-1"
+	require.NoError(t, k.OnIcaChannelClosed(ctx, k.GetICAPortID(), "channel-1"))
+
+	// A closed channel does not fail the proposal, its target gets the edit
+	// once the channel is reopened.
+	_, err := NewMsgServerImpl(k).UpdateShinzoPolicy(ctx, &types.MsgUpdateShinzoPolicy{Authority: k.GetAuthority(), Policy: policyWithoutViewSubscribers(t)})
+	require.NoError(t, err)
+	require.NoError(t, k.FlushOutbox(ctx))
+	require.Len(t, ica.sent, 1)
+
+	for id, target := range []string{testTarget, "sourcehub-test"} {
+		c, found := k.GetIcaCommand(ctx, uint64(id))
+		require.True(t, found)
+		require.Equal(t, target, c.Target)
+		require.Equal(t, types.PacketKind_PACKET_KIND_UPDATE_POLICY, c.Kind)
+	}
+	c, _ := k.GetIcaCommand(ctx, 1)
+	require.Equal(t, types.PacketStatus_PACKET_STATUS_QUEUED, c.Status)
+
+	// A rejected edit is retried like any other command and leaves the
+	// version of the target alone.
+	packet := channeltypes.Packet{SourceChannel: testChannelID, Sequence: ica.sequence}
+	ack := channeltypes.NewErrorAcknowledgement(errors.New("rejected"))
+	require.NoError(t, k.OnAcknowledgementPacket(ctx, packet, ack.Acknowledgement()))
+	c, _ = k.GetIcaCommand(ctx, 0)
+	require.Equal(t, types.PacketStatus_PACKET_STATUS_QUEUED, c.Status)
+	require.Equal(t, uint32(1), c.Attempts)
+	target, err := k.resolveTarget(ctx, "")
+	require.NoError(t, err)
+	require.Zero(t, target.PolicyVersion)
+}
+
+func TestPolicyAckRecordsPolicyVersion(t *testing.T) {
+	k, ctx, ica := setupKeeperWithICA(t)
+	updated := policyWithoutViewSubscribers(t)
+
+	// Adopted while the target has no policy yet, so nothing is edited.
+	target, err := k.resolveTarget(ctx, "")
+	require.NoError(t, err)
+	target.PolicyId = ""
+	require.NoError(t, k.SetTarget(ctx, target))
+
+	sent := len(ica.sent)
+	_, err = NewMsgServerImpl(k).UpdateShinzoPolicy(ctx, &types.MsgUpdateShinzoPolicy{Authority: k.GetAuthority(), Policy: updated})
+	require.NoError(t, err)
+	require.Len(t, ica.sent, sent)
+
+	_, err = NewMsgServerImpl(k).RegisterShinzoPolicy(ctx, &types.MsgRegisterShinzoPolicy{Signer: k.GetAuthority()})
+	require.NoError(t, err)
+	require.NoError(t, k.FlushOutbox(ctx))
+
+	packet := channeltypes.Packet{SourceChannel: testChannelID, Sequence: ica.sequence}
+	ack := channeltypes.NewResultAcknowledgement(createPolicyResult(t, "policy-2"))
+	require.NoError(t, k.OnAcknowledgementPacket(ctx, packet, ack.Acknowledgement()))
+
+	target, err = k.resolveTarget(ctx, "")
+	require.NoError(t, err)
+	require.Equal(t, "policy-2", target.PolicyId)
+	require.Equal(t, uint64(1), target.PolicyVersion)
+}
@@ -0,0 +1,170 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"github.com/sourcenetwork/acp_core/pkg/runtime"
+	"github.com/sourcenetwork/acp_core/pkg/services"
+	coretypes "github.com/sourcenetwork/acp_core/pkg/types"
+	acptypes "github.com/sourcenetwork/sourcehub/x/acp/types"
+
+	"github.com/shinzonetwork/shinzohub/x/sourcehub/types"
+)
+
+// ownerRelation is the relation acp_core gives the registrant of an object.
+// It is not declared in the policy and cannot be set or deleted directly.
+const ownerRelation = "owner"
+
+// policyCacheSize bounds the number of parsed policy versions a keeper keeps.
+const policyCacheSize = 8
+
+// parsedPolicy returns the parsed policy of the given version. Versions never
+// change once stored, so the recently used ones are kept in the LRU cache
+// of the keeper.
+func (k Keeper) parsedPolicy(ctx sdk.Context, version uint64) (*coretypes.Policy, error) {
+	if pol, ok := k.policies.Get(version); ok {
+		return pol, nil
+	}
+
+	v, err := k.GetPolicyVersion(ctx, version)
+	if err != nil {
+		return nil, err
+	}
+	pol, err := parsePolicy(v.Policy)
+	if err != nil {
+		return nil, err
+	}
+
+	k.policies.Add(version, pol)
+	return pol, nil
+}
+
+// parsePolicy parses a YAML policy with an in-memory acp_core engine.
+func parsePolicy(yaml string) (*coretypes.Policy, error) {
+	manager, err := runtime.NewRuntimeManager(runtime.WithMemKV())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := services.NewACPEngine(manager).ValidatePolicy(context.Background(), &coretypes.ValidatePolicyRequest{
+		Policy:      yaml,
+		MarshalType: coretypes.PolicyMarshalingType_YAML,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("validate policy: %w", err)
+	}
+	if !resp.Valid {
+		return nil, fmt.Errorf("invalid policy: %s", resp.ErrorMsg)
+	}
+
+	return resp.Policy, nil
+}
+
+// validatePolicyCmds checks the policy commands among msgs against the
+// version of the policy SourceHub holds on target, so that a command SourceHub
+// would reject fails the tx queueing it instead of its ICA packet. Other
+// messages are ignored.
+func (k Keeper) validatePolicyCmds(ctx sdk.Context, target string, msgs ...gogoproto.Message) error {
+	var pol *coretypes.Policy
+	for _, m := range msgs {
+		msg, ok := m.(*acptypes.MsgDirectPolicyCmd)
+		if !ok {
+			continue
+		}
+		if pol == nil {
+			t, err := k.resolveTarget(ctx, target)
+			if err != nil {
+				return err
+			}
+			if pol, err = k.parsedPolicy(ctx, t.PolicyVersion); err != nil {
+				return err
+			}
+		}
+		if err := validatePolicyCmd(pol, msg.GetCmd()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validatePolicyCmd checks that the object of cmd is a resource of pol and,
+// for relationship commands, that the relation exists on that resource and
+// accepts the subject.
+func validatePolicyCmd(pol *coretypes.Policy, cmd *acptypes.PolicyCmd) error {
+	switch c := cmd.GetCmd().(type) {
+	case *acptypes.PolicyCmd_RegisterObjectCmd:
+		o := c.RegisterObjectCmd.GetObject()
+		if pol.GetResourceByName(o.GetResource()) == nil {
+			return types.ErrInvalidPolicyCmd.Wrapf("unknown resource %q", o.GetResource())
+		}
+		if o.GetId() == "" {
+			return types.ErrInvalidPolicyCmd.Wrapf("%s object has no ID", o.GetResource())
+		}
+		return nil
+	case *acptypes.PolicyCmd_SetRelationshipCmd:
+		return validateRelationship(pol, c.SetRelationshipCmd.GetRelationship())
+	case *acptypes.PolicyCmd_DeleteRelationshipCmd:
+		return validateRelationship(pol, c.DeleteRelationshipCmd.GetRelationship())
+	default:
+		return nil
+	}
+}
+
+// validateRelationship checks rel against the resources and relations of pol.
+func validateRelationship(pol *coretypes.Policy, rel *coretypes.Relationship) error {
+	o := rel.GetObject()
+	res := pol.GetResourceByName(o.GetResource())
+	if res == nil {
+		return types.ErrInvalidPolicyCmd.Wrapf("unknown resource %q", o.GetResource())
+	}
+	if o.GetId() == "" {
+		return types.ErrInvalidPolicyCmd.Wrapf("%s object has no ID", o.GetResource())
+	}
+
+	if rel.GetRelation() == ownerRelation {
+		return types.ErrInvalidPolicyCmd.Wrapf("%s relation of %s is managed by SourceHub", ownerRelation, o.GetResource())
+	}
+	relation := res.GetRelationByName(rel.GetRelation())
+	if relation == nil {
+		return types.ErrInvalidPolicyCmd.Wrapf("resource %s has no relation %q", o.GetResource(), rel.GetRelation())
+	}
+
+	resource, subjectRelation, err := subjectType(pol, rel.GetSubject())
+	if err != nil {
+		return err
+	}
+	for _, t := range relation.GetVrTypes() {
+		if t.GetResourceName() == resource && t.GetRelationName() == subjectRelation {
+			return nil
+		}
+	}
+
+	subject := resource
+	if subjectRelation != "" {
+		subject += "->" + subjectRelation
+	}
+	return types.ErrInvalidPolicyCmd.Wrapf("relation %s of %s does not accept %s subjects", rel.GetRelation(), o.GetResource(), subject)
+}
+
+// subjectType returns the resource and relation a relationship subject is
+// restricted by, in the form of the relation types of a policy.
+func subjectType(pol *coretypes.Policy, s *coretypes.Subject) (string, string, error) {
+	switch subject := s.GetSubject().(type) {
+	case *coretypes.Subject_Actor:
+		if subject.Actor.GetId() == "" {
+			return "", "", types.ErrInvalidPolicyCmd.Wrap("actor has no ID")
+		}
+		return pol.GetActorResource().GetName(), "", nil
+	case *coretypes.Subject_AllActors:
+		return pol.GetActorResource().GetName(), "", nil
+	case *coretypes.Subject_ActorSet:
+		return subject.ActorSet.GetObject().GetResource(), subject.ActorSet.GetRelation(), nil
+	case *coretypes.Subject_Object:
+		return subject.Object.GetResource(), "", nil
+	default:
+		return "", "", types.ErrInvalidPolicyCmd.Wrap("relationship has no subject")
+	}
+}
@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	"testing"
+
+	coretypes "github.com/sourcenetwork/acp_core/pkg/types"
+	acptypes "github.com/sourcenetwork/sourcehub/x/acp/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/shinzonetwork/shinzohub/x/sourcehub/types"
+)
+
+func TestValidatePolicyCmd(t *testing.T) {
+	pol, err := parsePolicy(policy)
+	require.NoError(t, err)
+
+	for name, tc := range map[string]struct {
+		cmd *acptypes.PolicyCmd
+		err string
+	}{
+		"register view": {
+			cmd: acptypes.NewRegisterObjectCmd(coretypes.NewObject(types.ViewResourceName, "Logs_0x01")),
+		},
+		"register unknown resource": {
+			cmd: acptypes.NewRegisterObjectCmd(coretypes.NewObject("vew", "Logs_0x01")),
+			err: `unknown resource "vew"`,
+		},
+		"register without ID": {
+			cmd: acptypes.NewRegisterObjectCmd(coretypes.NewObject(types.PrimitiveResourceName, "")),
+			err: "primitive object has no ID",
+		},
+		"subscriber actor": {
+			cmd: acptypes.NewSetRelationshipCmd(coretypes.NewActorRelationship(types.ViewResourceName, "view-1", "subscriber", "did:key:alice")),
+		},
+		"group guest": {
+			cmd: acptypes.NewDeleteRelationshipCmd(coretypes.NewActorRelationship(types.GroupObjectName, types.GroupIndexerName, "guest", "did:key:alice")),
+		},
+		"writer group member": {
+			cmd: acptypes.NewSetRelationshipCmd(coretypes.NewActorSetRelationship(types.ViewResourceName, "view-1", "writer", types.GroupObjectName, types.GroupIndexerName, "member")),
+		},
+		"view parent": {
+			cmd: acptypes.NewSetRelationshipCmd(coretypes.NewRelationship(types.ViewResourceName, "view-1", "parent", types.PrimitiveResourceName, "Logs")),
+		},
+		"unknown relation": {
+			cmd: acptypes.NewSetRelationshipCmd(coretypes.NewActorRelationship(types.ViewResourceName, "view-1", "subscribr", "did:key:alice")),
+			err: `resource view has no relation "subscribr"`,
+		},
+		"unknown relationship resource": {
+			cmd: acptypes.NewDeleteRelationshipCmd(coretypes.NewActorRelationship("stream", "view-1", "subscriber", "did:key:alice")),
+			err: `unknown resource "stream"`,
+		},
+		"owner relation": {
+			cmd: acptypes.NewSetRelationshipCmd(coretypes.NewActorRelationship(types.ViewResourceName, "view-1", "owner", "did:key:alice")),
+			err: "owner relation of view is managed by SourceHub",
+		},
+		"actor not allowed": {
+			cmd: acptypes.NewSetRelationshipCmd(coretypes.NewActorRelationship(types.ViewResourceName, "view-1", "parent", "did:key:alice")),
+			err: "relation parent of view does not accept actor subjects",
+		},
+		"actor set not allowed": {
+			cmd: acptypes.NewSetRelationshipCmd(coretypes.NewActorSetRelationship(types.ViewResourceName, "view-1", "subscriber", types.GroupObjectName, types.GroupIndexerName, "member")),
+			err: "relation subscriber of view does not accept group->member subjects",
+		},
+		"actor without ID": {
+			cmd: acptypes.NewSetRelationshipCmd(coretypes.NewActorRelationship(types.ViewResourceName, "view-1", "subscriber", "")),
+			err: "actor has no ID",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			err := validatePolicyCmd(pol, tc.cmd)
+			if tc.err == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, types.ErrInvalidPolicyCmd)
+			require.ErrorContains(t, err, tc.err)
+		})
+	}
+}
+
+func TestInvalidPolicyCmdIsNotQueued(t *testing.T) {
+	k, ctx, _ := setupKeeperWithICA(t)
+	_, addr, policyId, err := k.targetAccount(ctx, "")
+	require.NoError(t, err)
+
+	_, err = k.queueCommand(ctx, testTarget, types.PacketKind_PACKET_KIND_REQUEST_STREAM_ACCESS, k.GetAuthority(),
+		acptypes.NewMsgDirectPolicyCmd(addr, policyId, acptypes.NewSetRelationshipCmd(coretypes.NewActorRelationship(types.ViewResourceName, "view-1", "subscriber", "did:key:alice"))),
+		acptypes.NewMsgDirectPolicyCmd(addr, policyId, acptypes.NewSetRelationshipCmd(coretypes.NewActorRelationship(types.ViewResourceName, "view-1", "reader", "did:key:alice"))),
+	)
+	require.ErrorIs(t, err, types.ErrInvalidPolicyCmd)
+
+	next, err := k.NextCommandId.Peek(ctx)
+	require.NoError(t, err)
+	require.Zero(t, next)
+	_, found := k.GetRelationship(ctx, "", types.ViewResourceName, "view-1", "subscriber", "did:key:alice")
+	require.False(t, found)
+}
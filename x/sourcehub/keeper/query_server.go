@@ -0,0 +1,707 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/shinzonetwork/shinzohub/x/sourcehub/types"
+)
+
+// queryServer is the concrete implementation of the QueryServer interface
+type queryServer struct {
+	Keeper
+}
+
+// NewQueryServerImpl returns an implementation of the QueryServer interface
+// for the provided Keeper.
+func NewQueryServerImpl(k Keeper) types.QueryServer {
+	return &queryServer{Keeper: k}
+}
+
+var _ types.QueryServer = queryServer{}
+
+func (q queryServer) Params(goCtx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	p, err := q.Keeper.GetParams(ctx)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryParamsResponse{Params: p}, nil
+}
+
+func (q queryServer) IcaAddress(goCtx context.Context, req *types.QueryIcaAddressRequest) (*types.QueryIcaAddressResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	t, err := q.Keeper.resolveTarget(ctx, req.Target)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	connectionID := t.ControllerConnectionId
+	portID := q.Keeper.GetICAPortID()
+
+	addr, found := q.Keeper.GetICAAddress(ctx, t)
+	if !found || addr == "" {
+		return nil, status.Errorf(codes.NotFound, "ICA address not found for portID %s on connection %s", portID, connectionID)
+	}
+
+	return &types.QueryIcaAddressResponse{
+		IcaAddress:             addr,
+		ControllerConnectionId: connectionID,
+		PortId:                 portID,
+	}, nil
+}
+
+func (q queryServer) IcaMetadata(goCtx context.Context, req *types.QueryIcaMetadataRequest) (*types.QueryIcaMetadataResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	t, err := q.Keeper.resolveTarget(ctx, req.Target)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryIcaMetadataResponse{
+		ControllerConnectionId: t.ControllerConnectionId,
+		HostConnectionId:       t.HostConnectionId,
+		Version:                t.Version,
+		Encoding:               t.Encoding,
+		TxType:                 t.TxType,
+	}, nil
+}
+
+func (q queryServer) PolicyId(goCtx context.Context, req *types.QueryPolicyIdRequest) (*types.QueryPolicyIdResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	t, err := q.Keeper.resolveTarget(ctx, req.Target)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	policyId, err := requirePolicyId(t)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryPolicyIdResponse{PolicyId: policyId}, nil
+}
+
+func (q queryServer) IcaPacket(goCtx context.Context, req *types.QueryIcaPacketRequest) (*types.QueryIcaPacketResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	p, found := q.Keeper.GetIcaPacket(ctx, req.ChannelId, req.Sequence)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "packet %d not found on channel %s", req.Sequence, req.ChannelId)
+	}
+
+	return &types.QueryIcaPacketResponse{Packet: p}, nil
+}
+
+func (q queryServer) IcaPackets(goCtx context.Context, req *types.QueryIcaPacketsRequest) (*types.QueryIcaPacketsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	packets, pageRes, err := query.CollectionFilteredPaginate(
+		ctx,
+		q.Keeper.IcaPackets,
+		req.Pagination,
+		func(_ collections.Pair[string, uint64], p types.IcaPacket) (bool, error) {
+			return req.Status == types.PacketStatus_PACKET_STATUS_UNSPECIFIED || p.Status == req.Status, nil
+		},
+		func(_ collections.Pair[string, uint64], p types.IcaPacket) (types.IcaPacket, error) {
+			return p, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryIcaPacketsResponse{Packets: packets, Pagination: pageRes}, nil
+}
+
+func (q queryServer) IcaCommand(goCtx context.Context, req *types.QueryIcaCommandRequest) (*types.QueryIcaCommandResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	c, found := q.Keeper.GetIcaCommand(ctx, req.Id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "command %d not found", req.Id)
+	}
+
+	return &types.QueryIcaCommandResponse{Command: c}, nil
+}
+
+func (q queryServer) IcaCommands(goCtx context.Context, req *types.QueryIcaCommandsRequest) (*types.QueryIcaCommandsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	txHash := strings.ToUpper(strings.TrimPrefix(req.TxHash, "0x"))
+
+	commands, pageRes, err := query.CollectionFilteredPaginate(
+		ctx,
+		q.Keeper.IcaCommands,
+		req.Pagination,
+		func(_ uint64, c types.IcaCommand) (bool, error) {
+			return (txHash == "" || c.TxHash == txHash) &&
+				(req.Status == types.PacketStatus_PACKET_STATUS_UNSPECIFIED || c.Status == req.Status), nil
+		},
+		func(_ uint64, c types.IcaCommand) (types.IcaCommand, error) {
+			return c, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryIcaCommandsResponse{Commands: commands, Pagination: pageRes}, nil
+}
+
+func (q queryServer) IcaChannel(goCtx context.Context, req *types.QueryIcaChannelRequest) (*types.QueryIcaChannelResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	t, err := q.Keeper.resolveTarget(ctx, req.Target)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryIcaChannelResponse{Channel: t.Channel}, nil
+}
+
+func (q queryServer) Targets(goCtx context.Context, req *types.QueryTargetsRequest) (*types.QueryTargetsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	targets, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.Keeper.Targets,
+		req.Pagination,
+		func(_ string, t types.SourcehubTarget) (types.SourcehubTarget, error) {
+			return t, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTargetsResponse{
+		Targets:       targets,
+		DefaultTarget: q.Keeper.GetDefaultTarget(ctx),
+		Pagination:    pageRes,
+	}, nil
+}
+
+func (q queryServer) Target(goCtx context.Context, req *types.QueryTargetRequest) (*types.QueryTargetResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	t, found := q.Keeper.GetTarget(ctx, req.ChainId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "SourceHub target %s not found", req.ChainId)
+	}
+
+	return &types.QueryTargetResponse{Target: t}, nil
+}
+
+func (q queryServer) DeadLetters(goCtx context.Context, req *types.QueryDeadLettersRequest) (*types.QueryDeadLettersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	commands, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.Keeper.DeadLetters,
+		req.Pagination,
+		func(id uint64, _ collections.NoValue) (types.IcaCommand, error) {
+			return q.Keeper.IcaCommands.Get(ctx, id)
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDeadLettersResponse{Commands: commands, Pagination: pageRes}, nil
+}
+
+func (q queryServer) StreamGrants(goCtx context.Context, req *types.QueryStreamGrantsRequest) (*types.QueryStreamGrantsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	grants, pageRes, err := query.CollectionFilteredPaginate(
+		ctx,
+		q.Keeper.Grants,
+		req.Pagination,
+		func(_ collections.Quad[string, int32, string, string], g types.StreamGrant) (bool, error) {
+			return (req.StreamId == "" || g.StreamId == req.StreamId) && (req.Did == "" || g.Did == req.Did), nil
+		},
+		func(_ collections.Quad[string, int32, string, string], g types.StreamGrant) (types.StreamGrant, error) {
+			return g, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryStreamGrantsResponse{Grants: grants, Pagination: pageRes}, nil
+}
+
+func (q queryServer) RoleHolders(goCtx context.Context, req *types.QueryRoleHoldersRequest) (*types.QueryRoleHoldersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	holders, pageRes, err := query.CollectionFilteredPaginate(
+		ctx,
+		q.Keeper.RoleHolders,
+		req.Pagination,
+		func(key collections.Pair[int32, string], _ collections.NoValue) (bool, error) {
+			return req.Role == types.ModuleRole_MODULE_ROLE_UNSPECIFIED || key.K1() == int32(req.Role), nil
+		},
+		func(key collections.Pair[int32, string], _ collections.NoValue) (types.RoleHolder, error) {
+			return types.RoleHolder{Role: types.ModuleRole(key.K1()), Address: key.K2()}, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRoleHoldersResponse{Holders: holders, Pagination: pageRes}, nil
+}
+
+func (q queryServer) AccountRoles(goCtx context.Context, req *types.QueryAccountRolesRequest) (*types.QueryAccountRolesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := sdk.AccAddressFromBech32(req.Address); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err)
+	}
+
+	return &types.QueryAccountRolesResponse{
+		Roles:   q.Keeper.GetAccountRoles(ctx, req.Address),
+		IsAdmin: q.Keeper.IsAdmin(ctx, req.Address),
+	}, nil
+}
+
+func (q queryServer) Entities(goCtx context.Context, req *types.QueryEntitiesRequest) (*types.QueryEntitiesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	entities, pageRes, err := query.CollectionFilteredPaginate(
+		ctx,
+		q.Keeper.Entities,
+		req.Pagination,
+		func(_ collections.Pair[sdk.AccAddress, int32], e types.Entity) (bool, error) {
+			return !req.FilterRole || e.Role == req.Role, nil
+		},
+		func(_ collections.Pair[sdk.AccAddress, int32], e types.Entity) (types.Entity, error) {
+			return e, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryEntitiesResponse{Entities: entities, Pagination: pageRes}, nil
+}
+
+func (q queryServer) EntitiesByAddress(goCtx context.Context, req *types.QueryEntitiesByAddressRequest) (*types.QueryEntitiesByAddressResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err)
+	}
+
+	entities, err := q.Keeper.GetEntitiesByAddress(ctx, owner)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if len(entities) == 0 {
+		return nil, status.Errorf(codes.NotFound, "no entity registered for address %s", req.Address)
+	}
+
+	return &types.QueryEntitiesByAddressResponse{Entities: entities}, nil
+}
+
+func (q queryServer) EntitiesByDid(goCtx context.Context, req *types.QueryEntitiesByDidRequest) (*types.QueryEntitiesByDidResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	entities, err := q.Keeper.GetEntitiesByDid(ctx, req.Did)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if len(entities) == 0 {
+		return nil, status.Errorf(codes.NotFound, "no entity registered with DID %s", req.Did)
+	}
+
+	return &types.QueryEntitiesByDidResponse{Entities: entities}, nil
+}
+
+func (q queryServer) EntitiesByPid(goCtx context.Context, req *types.QueryEntitiesByPidRequest) (*types.QueryEntitiesByPidResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	entities, err := q.Keeper.GetEntitiesByPid(ctx, req.Pid)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if len(entities) == 0 {
+		return nil, status.Errorf(codes.NotFound, "no entity registered with PID %s", req.Pid)
+	}
+
+	return &types.QueryEntitiesByPidResponse{Entities: entities}, nil
+}
+
+func (q queryServer) DidByAddress(goCtx context.Context, req *types.QueryDidByAddressRequest) (*types.QueryDidByAddressResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err)
+	}
+
+	did, err := q.Keeper.GetDidByAddress(ctx, owner)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryDidByAddressResponse{Did: string(did)}, nil
+}
+
+func (q queryServer) PidByAddress(goCtx context.Context, req *types.QueryPidByAddressRequest) (*types.QueryPidByAddressResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err)
+	}
+
+	pid, err := q.Keeper.GetPidByAddress(ctx, owner)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryPidByAddressResponse{Pid: string(pid)}, nil
+}
+
+func (q queryServer) Relationship(goCtx context.Context, req *types.QueryRelationshipRequest) (*types.QueryRelationshipResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	r, found := q.Keeper.GetRelationship(ctx, req.Target, req.Resource, req.ObjectId, req.Relation, req.Actor)
+	if !found {
+		return nil, status.Error(codes.NotFound, "relationship not found")
+	}
+
+	return &types.QueryRelationshipResponse{Relationship: r}, nil
+}
+
+// withQuadPrefix restricts a paginated quad-keyed collection to the keys
+// starting with prefix, built with collections.QuadSuperPrefix or
+// collections.QuadSuperPrefix3.
+func withQuadPrefix[K1, K2, K3, K4 any](prefix collections.Quad[K1, K2, K3, K4]) func(o *query.CollectionsPaginateOptions[collections.Quad[K1, K2, K3, K4]]) {
+	return func(o *query.CollectionsPaginateOptions[collections.Quad[K1, K2, K3, K4]]) {
+		o.Prefix = &prefix
+	}
+}
+
+func (q queryServer) RelationshipsByObject(goCtx context.Context, req *types.QueryRelationshipsByObjectRequest) (*types.QueryRelationshipsByObjectResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Resource == "" || req.ObjectId == "" {
+		return nil, status.Error(codes.InvalidArgument, "resource and object ID are required")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	target := q.Keeper.targetName(ctx, req.Target)
+	prefix := collections.QuadSuperPrefix[string, string, string, string](target, objectKey(req.Resource, req.ObjectId))
+	if req.Relation != "" {
+		prefix = collections.QuadSuperPrefix3[string, string, string, string](target, objectKey(req.Resource, req.ObjectId), req.Relation)
+	}
+
+	relationships, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.Keeper.Relationships,
+		req.Pagination,
+		func(_ collections.Quad[string, string, string, string], r types.Relationship) (types.Relationship, error) {
+			return r, nil
+		},
+		withQuadPrefix(prefix),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRelationshipsByObjectResponse{Relationships: relationships, Pagination: pageRes}, nil
+}
+
+func (q queryServer) RelationshipsByActor(goCtx context.Context, req *types.QueryRelationshipsByActorRequest) (*types.QueryRelationshipsByActorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Actor == "" {
+		return nil, status.Error(codes.InvalidArgument, "actor is required")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	relationships, pageRes, err := query.CollectionFilteredPaginate(
+		ctx,
+		q.Keeper.RelationshipsByActor,
+		req.Pagination,
+		func(key collections.Quad[string, string, string, string], _ collections.NoValue) (bool, error) {
+			return req.Relation == "" || key.K4() == req.Relation, nil
+		},
+		func(key collections.Quad[string, string, string, string], _ collections.NoValue) (types.Relationship, error) {
+			return q.Keeper.Relationships.Get(ctx, collections.Join4(key.K1(), key.K3(), key.K4(), key.K2()))
+		},
+		withQuadPrefix(collections.QuadSuperPrefix[string, string, string, string](q.Keeper.targetName(ctx, req.Target), req.Actor)),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRelationshipsByActorResponse{Relationships: relationships, Pagination: pageRes}, nil
+}
+
+func (q queryServer) ShinzoPolicy(goCtx context.Context, req *types.QueryShinzoPolicyRequest) (*types.QueryShinzoPolicyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	v, err := q.Keeper.ActivePolicy(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryShinzoPolicyResponse{Policy: v}, nil
+}
+
+func (q queryServer) ShinzoPolicyVersion(goCtx context.Context, req *types.QueryShinzoPolicyVersionRequest) (*types.QueryShinzoPolicyVersionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	v, err := q.Keeper.GetPolicyVersion(ctx, req.Version)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "policy version %d not found", req.Version)
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryShinzoPolicyVersionResponse{Policy: v}, nil
+}
+
+func (q queryServer) ShinzoPolicyHistory(goCtx context.Context, req *types.QueryShinzoPolicyHistoryRequest) (*types.QueryShinzoPolicyHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	policies, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.Keeper.PolicyVersions,
+		req.Pagination,
+		func(_ uint64, v types.PolicyVersion) (types.PolicyVersion, error) {
+			return v, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryShinzoPolicyHistoryResponse{Policies: policies, Pagination: pageRes}, nil
+}
+
+func (q queryServer) StreamPrice(goCtx context.Context, req *types.QueryStreamPriceRequest) (*types.QueryStreamPriceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	price, found := q.Keeper.GetStreamPrice(ctx, req.Resource, req.StreamId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "stream %s has no price", req.StreamId)
+	}
+
+	return &types.QueryStreamPriceResponse{Price: price}, nil
+}
+
+func (q queryServer) StreamPrices(goCtx context.Context, req *types.QueryStreamPricesRequest) (*types.QueryStreamPricesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	prices, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.Keeper.StreamPrices,
+		req.Pagination,
+		func(_ collections.Pair[int32, string], p types.StreamPrice) (types.StreamPrice, error) {
+			return p, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryStreamPricesResponse{Prices: prices, Pagination: pageRes}, nil
+}
+
+func (q queryServer) StreamPayments(goCtx context.Context, req *types.QueryStreamPaymentsRequest) (*types.QueryStreamPaymentsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	payments, pageRes, err := query.CollectionFilteredPaginate(
+		ctx,
+		q.Keeper.StreamPayments,
+		req.Pagination,
+		func(_ uint64, p types.StreamPayment) (bool, error) {
+			return req.Payer == "" || p.Payer == req.Payer, nil
+		},
+		func(_ uint64, p types.StreamPayment) (types.StreamPayment, error) {
+			return p, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryStreamPaymentsResponse{Payments: payments, Pagination: pageRes}, nil
+}
+
+func (q queryServer) PendingRewards(goCtx context.Context, req *types.QueryPendingRewardsRequest) (*types.QueryPendingRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	amount, err := q.Keeper.GetPendingRewards(ctx, addr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPendingRewardsResponse{Amount: amount}, nil
+}
+
+func (q queryServer) StreamRevenues(goCtx context.Context, req *types.QueryStreamRevenuesRequest) (*types.QueryStreamRevenuesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	revenues, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.Keeper.StreamRevenues,
+		req.Pagination,
+		func(_ collections.Pair[int32, string], r types.StreamRevenue) (types.StreamRevenue, error) {
+			return r, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryStreamRevenuesResponse{Revenues: revenues, Pagination: pageRes}, nil
+}
+
+func (q queryServer) EntityUnbondings(goCtx context.Context, req *types.QueryEntityUnbondingsRequest) (*types.QueryEntityUnbondingsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var owner sdk.AccAddress
+	if req.Owner != "" {
+		addr, err := sdk.AccAddressFromBech32(req.Owner)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		owner = addr
+	}
+
+	unbondings, pageRes, err := query.CollectionFilteredPaginate(
+		ctx,
+		q.Keeper.EntityUnbondings,
+		req.Pagination,
+		func(key collections.Triple[uint64, sdk.AccAddress, int32], _ types.EntityUnbonding) (bool, error) {
+			return owner.Empty() || owner.Equals(key.K2()), nil
+		},
+		func(_ collections.Triple[uint64, sdk.AccAddress, int32], u types.EntityUnbonding) (types.EntityUnbonding, error) {
+			return u, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryEntityUnbondingsResponse{Unbondings: unbondings, Pagination: pageRes}, nil
+}
@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/shinzonetwork/shinzohub/x/sourcehub/types"
+)
+
+func TestQueryIcaAddress(t *testing.T) {
+	k, ctx, ica := setupKeeper(t)
+	k.InitGenesis(ctx, *types.DefaultGenesis())
+	q := NewQueryServerImpl(k)
+
+	_, err := q.IcaAddress(ctx, &types.QueryIcaAddressRequest{})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	require.NoError(t, k.SetTarget(ctx, types.NewSourcehubTarget(testTarget, "connection-0", "connection-0")))
+	require.NoError(t, k.SetDefaultTarget(ctx, testTarget))
+
+	_, err = q.IcaAddress(ctx, &types.QueryIcaAddressRequest{})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	ica.addresses["connection-0/"+k.GetICAPortID()] = testIcaAddress
+
+	res, err := q.IcaAddress(ctx, &types.QueryIcaAddressRequest{})
+	require.NoError(t, err)
+	require.Equal(t, testIcaAddress, res.IcaAddress)
+	require.Equal(t, "connection-0", res.ControllerConnectionId)
+	require.Equal(t, k.GetICAPortID(), res.PortId)
+
+	_, err = q.IcaAddress(ctx, &types.QueryIcaAddressRequest{Target: "other"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestQueryIcaMetadata(t *testing.T) {
+	k, ctx, _ := setupKeeper(t)
+	gs := types.DefaultGenesis()
+	gs.Targets = []types.SourcehubTarget{types.NewSourcehubTarget(testTarget, "connection-0", "connection-7")}
+	gs.DefaultTarget = testTarget
+	k.InitGenesis(ctx, *gs)
+
+	res, err := NewQueryServerImpl(k).IcaMetadata(ctx, &types.QueryIcaMetadataRequest{Target: testTarget})
+	require.NoError(t, err)
+	require.Equal(t, "connection-0", res.ControllerConnectionId)
+	require.Equal(t, "connection-7", res.HostConnectionId)
+	require.Equal(t, "ics27-1", res.Version)
+	require.Equal(t, "proto3", res.Encoding)
+	require.Equal(t, "sdk_multi_msg", res.TxType)
+}
+
+func TestQueryPolicyId(t *testing.T) {
+	k, ctx, _ := setupKeeper(t)
+	k.InitGenesis(ctx, *types.DefaultGenesis())
+	q := NewQueryServerImpl(k)
+
+	target := types.NewSourcehubTarget(testTarget, "connection-0", "connection-0")
+	require.NoError(t, k.SetTarget(ctx, target))
+	require.NoError(t, k.SetDefaultTarget(ctx, testTarget))
+
+	_, err := q.PolicyId(ctx, &types.QueryPolicyIdRequest{})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	target.PolicyId = "policy-1"
+	require.NoError(t, k.SetTarget(ctx, target))
+
+	res, err := q.PolicyId(ctx, &types.QueryPolicyIdRequest{})
+	require.NoError(t, err)
+	require.Equal(t, "policy-1", res.PolicyId)
+}
+
+func TestQueryTargets(t *testing.T) {
+	k, ctx, ica := setupKeeperWithICA(t)
+	addTarget(t, k, ctx, ica, "sourcehub-test", "connection-1")
+	q := NewQueryServerImpl(k)
+
+	res, err := q.Targets(ctx, &types.QueryTargetsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Targets, 2)
+	require.Equal(t, testTarget, res.DefaultTarget)
+
+	target, err := q.Target(ctx, &types.QueryTargetRequest{ChainId: "sourcehub-test"})
+	require.NoError(t, err)
+	require.Equal(t, "connection-1", target.Target.ControllerConnectionId)
+
+	_, err = q.Target(ctx, &types.QueryTargetRequest{ChainId: "other"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestQueryParams(t *testing.T) {
+	k, ctx, _ := setupKeeper(t)
+	gs := types.DefaultGenesis()
+	gs.Params = types.DefaultParams()
+	k.InitGenesis(ctx, *gs)
+
+	res, err := NewQueryServerImpl(k).Params(ctx, &types.QueryParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultParams(), res.Params)
+}
@@ -0,0 +1,254 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	coretypes "github.com/sourcenetwork/acp_core/pkg/types"
+	acptypes "github.com/sourcenetwork/sourcehub/x/acp/types"
+
+	"github.com/shinzonetwork/shinzohub/x/sourcehub/types"
+)
+
+// objectKey identifies an ACP object in the relationship mirror.
+func objectKey(resource, id string) string {
+	return resource + ":" + id
+}
+
+var relationshipKeyCodec = collections.QuadKeyCodec(collections.StringKey, collections.StringKey, collections.StringKey, collections.StringKey)
+
+// relationshipKey returns the key of r in the Relationships map.
+func relationshipKey(r types.Relationship) collections.Quad[string, string, string, string] {
+	return collections.Join4(r.Target, objectKey(r.Resource, r.ObjectId), r.Relation, r.Actor)
+}
+
+// actorKey returns the key of r in the RelationshipsByActor index.
+func actorKey(r types.Relationship) collections.Quad[string, string, string, string] {
+	return collections.Join4(r.Target, r.Actor, objectKey(r.Resource, r.ObjectId), r.Relation)
+}
+
+// subjectActor renders the subject of an ACP relationship as the actor of a
+// mirrored relationship.
+func subjectActor(s *coretypes.Subject) string {
+	switch subject := s.GetSubject().(type) {
+	case *coretypes.Subject_Actor:
+		return subject.Actor.GetId()
+	case *coretypes.Subject_ActorSet:
+		o := subject.ActorSet.GetObject()
+		return objectKey(o.GetResource(), o.GetId()) + "#" + subject.ActorSet.GetRelation()
+	case *coretypes.Subject_Object:
+		return objectKey(subject.Object.GetResource(), subject.Object.GetId())
+	case *coretypes.Subject_AllActors:
+		return "*"
+	default:
+		return ""
+	}
+}
+
+// relationshipChange is the effect of a policy command on the mirror.
+type relationshipChange struct {
+	relationship types.Relationship
+	remove       bool
+}
+
+// relationshipChanges returns the relationships created or deleted by the
+// policy commands among msgs. Other messages are ignored.
+func relationshipChanges(msgs []*codectypes.Any) ([]relationshipChange, error) {
+	var changes []relationshipChange
+	for _, m := range msgs {
+		if m.TypeUrl != "/"+gogoproto.MessageName(&acptypes.MsgDirectPolicyCmd{}) {
+			continue
+		}
+		var msg acptypes.MsgDirectPolicyCmd
+		if err := gogoproto.Unmarshal(m.Value, &msg); err != nil {
+			return nil, fmt.Errorf("decode policy command: %w", err)
+		}
+
+		var (
+			rel    *coretypes.Relationship
+			remove bool
+		)
+		switch cmd := msg.GetCmd().GetCmd().(type) {
+		case *acptypes.PolicyCmd_RegisterObjectCmd:
+			o := cmd.RegisterObjectCmd.GetObject()
+			rel = coretypes.NewActorRelationship(o.GetResource(), o.GetId(), ownerRelation, msg.Creator)
+		case *acptypes.PolicyCmd_SetRelationshipCmd:
+			rel = cmd.SetRelationshipCmd.GetRelationship()
+		case *acptypes.PolicyCmd_DeleteRelationshipCmd:
+			rel = cmd.DeleteRelationshipCmd.GetRelationship()
+			remove = true
+		default:
+			continue
+		}
+
+		changes = append(changes, relationshipChange{
+			relationship: types.Relationship{
+				Resource: rel.GetObject().GetResource(),
+				ObjectId: rel.GetObject().GetId(),
+				Relation: rel.GetRelation(),
+				Actor:    subjectActor(rel.GetSubject()),
+			},
+			remove: remove,
+		})
+	}
+	return changes, nil
+}
+
+// GetRelationship returns the mirrored relationship of actor to an object on
+// target, the default target if empty.
+func (k Keeper) GetRelationship(ctx sdk.Context, target, resource, objectId, relation, actor string) (types.Relationship, bool) {
+	r, err := k.Relationships.Get(ctx, collections.Join4(k.targetName(ctx, target), objectKey(resource, objectId), relation, actor))
+	if err != nil {
+		return types.Relationship{}, false
+	}
+	return r, true
+}
+
+// HasRelationship reports whether SourceHub target confirmed the relationship
+// of actor to an object and no deletion of it is pending.
+func (k Keeper) HasRelationship(ctx sdk.Context, target, resource, objectId, relation, actor string) bool {
+	r, found := k.GetRelationship(ctx, target, resource, objectId, relation, actor)
+	return found && r.Status == types.RelationshipStatus_RELATIONSHIP_STATUS_CONFIRMED
+}
+
+// SetRelationship stores a mirrored relationship and indexes it by actor. A
+// relationship without a target is stored on the default target.
+func (k Keeper) SetRelationship(ctx sdk.Context, r types.Relationship) error {
+	r.Target = k.targetName(ctx, r.Target)
+	if err := k.Relationships.Set(ctx, relationshipKey(r), r); err != nil {
+		return err
+	}
+	return k.RelationshipsByActor.Set(ctx, actorKey(r))
+}
+
+// RemoveRelationship deletes a mirrored relationship and its index entry.
+func (k Keeper) RemoveRelationship(ctx sdk.Context, r types.Relationship) error {
+	r.Target = k.targetName(ctx, r.Target)
+	if err := k.Relationships.Remove(ctx, relationshipKey(r)); err != nil {
+		return err
+	}
+	return k.RelationshipsByActor.Remove(ctx, actorKey(r))
+}
+
+// BackfillObjects records the objects of resource with the given IDs as owned
+// by the interchain account of the SourceHub target, the default target if
+// empty, and confirmed by SourceHub. It is meant for objects registered before
+// shinzohub mirrored relationships, nothing is sent to SourceHub. Objects
+// whose owner relationship is mirrored and did not fail are left alone. It
+// returns the target and the IDs recorded.
+func (k Keeper) BackfillObjects(ctx sdk.Context, target string, resource types.Resource, ids []string) (types.SourcehubTarget, []string, error) {
+	name, err := resourceName(resource)
+	if err != nil {
+		return types.SourcehubTarget{}, nil, err
+	}
+	t, addr, _, err := k.targetAccount(ctx, target)
+	if err != nil {
+		return types.SourcehubTarget{}, nil, err
+	}
+
+	var backfilled []string
+	for _, id := range ids {
+		existing, found := k.GetRelationship(ctx, t.ChainId, name, id, ownerRelation, addr)
+		if found && existing.Status != types.RelationshipStatus_RELATIONSHIP_STATUS_FAILED {
+			continue
+		}
+		if err := k.SetRelationship(ctx, types.Relationship{
+			Resource: name,
+			ObjectId: id,
+			Relation: ownerRelation,
+			Actor:    addr,
+			Status:   types.RelationshipStatus_RELATIONSHIP_STATUS_CONFIRMED,
+			Height:   ctx.BlockHeight(),
+			Target:   t.ChainId,
+		}); err != nil {
+			return types.SourcehubTarget{}, nil, err
+		}
+		backfilled = append(backfilled, id)
+	}
+	return t, backfilled, nil
+}
+
+// mirrorCommand records the relationships created or deleted by command c on
+// its target as pending. A deletion of a relationship missing from the mirror
+// is not recorded, and a relationship SourceHub already confirmed stays
+// confirmed when it is set again.
+func (k Keeper) mirrorCommand(ctx sdk.Context, c types.IcaCommand, msgs []*codectypes.Any) error {
+	changes, err := relationshipChanges(msgs)
+	if err != nil {
+		return err
+	}
+
+	target := k.targetName(ctx, c.Target)
+	for _, change := range changes {
+		r := change.relationship
+		existing, found := k.GetRelationship(ctx, target, r.Resource, r.ObjectId, r.Relation, r.Actor)
+
+		status := types.RelationshipStatus_RELATIONSHIP_STATUS_PENDING
+		if !change.remove && found && existing.Status == types.RelationshipStatus_RELATIONSHIP_STATUS_CONFIRMED {
+			continue
+		}
+		if change.remove {
+			if !found {
+				continue
+			}
+			r = existing
+			status = types.RelationshipStatus_RELATIONSHIP_STATUS_DELETING
+		}
+
+		r.Status = status
+		r.CommandId = c.Id
+		r.Height = ctx.BlockHeight()
+		r.Target = target
+		if err := k.SetRelationship(ctx, r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resolveRelationships applies the outcome of command c, made of msgs, to the
+// relationships it changed. Relationships changed again by a later command
+// are left to that command. A failed deletion leaves the relationship
+// confirmed.
+func (k Keeper) resolveRelationships(ctx sdk.Context, c types.IcaCommand, msgs []*codectypes.Any) error {
+	changes, err := relationshipChanges(msgs)
+	if err != nil {
+		return err
+	}
+
+	acknowledged := c.Status == types.PacketStatus_PACKET_STATUS_ACKNOWLEDGED
+	target := k.targetName(ctx, c.Target)
+	for _, change := range changes {
+		change.relationship.Target = target
+		r, err := k.Relationships.Get(ctx, relationshipKey(change.relationship))
+		if errors.Is(err, collections.ErrNotFound) {
+			continue
+		} else if err != nil {
+			return err
+		}
+		if r.CommandId != c.Id {
+			continue
+		}
+
+		switch {
+		case change.remove && acknowledged:
+			if err := k.RemoveRelationship(ctx, r); err != nil {
+				return err
+			}
+			continue
+		case change.remove || acknowledged:
+			r.Status = types.RelationshipStatus_RELATIONSHIP_STATUS_CONFIRMED
+		default:
+			r.Status = types.RelationshipStatus_RELATIONSHIP_STATUS_FAILED
+		}
+		r.Height = ctx.BlockHeight()
+		if err := k.SetRelationship(ctx, r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
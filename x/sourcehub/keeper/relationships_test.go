@@ -0,0 +1,219 @@
+package keeper
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/shinzonetwork/shinzohub/x/sourcehub/types"
+)
+
+func subscriberStatus(t *testing.T, k Keeper, ctx sdk.Context, did string) types.RelationshipStatus {
+	t.Helper()
+
+	r, found := k.GetRelationship(ctx, "", types.ViewResourceName, "view-1", "subscriber", did)
+	require.True(t, found)
+	return r.Status
+}
+
+func TestRelationshipConfirmedOnAck(t *testing.T) {
+	k, ctx, ica := setupKeeperWithICA(t)
+	ctx = ctx.WithBlockHeight(5)
+
+	queueStreamAccess(t, k, ctx, "did:key:alice")
+	r, found := k.GetRelationship(ctx, "", types.ViewResourceName, "view-1", "subscriber", "did:key:alice")
+	require.True(t, found)
+	require.Equal(t, types.Relationship{
+		Resource:  types.ViewResourceName,
+		ObjectId:  "view-1",
+		Relation:  "subscriber",
+		Actor:     "did:key:alice",
+		Status:    types.RelationshipStatus_RELATIONSHIP_STATUS_PENDING,
+		CommandId: 0,
+		Height:    5,
+		Target:    testTarget,
+	}, r)
+	require.False(t, k.HasRelationship(ctx, "", types.ViewResourceName, "view-1", "subscriber", "did:key:alice"))
+
+	require.NoError(t, k.FlushOutbox(ctx))
+	packet := channeltypes.Packet{SourceChannel: testChannelID, Sequence: ica.sequence}
+	require.NoError(t, k.OnAcknowledgementPacket(ctx.WithBlockHeight(6), packet, msgResponsesAck(t, 1)))
+
+	require.Equal(t, types.RelationshipStatus_RELATIONSHIP_STATUS_CONFIRMED, subscriberStatus(t, k, ctx, "did:key:alice"))
+	require.True(t, k.HasRelationship(ctx, "", types.ViewResourceName, "view-1", "subscriber", "did:key:alice"))
+}
+
+func TestRelationshipDeletion(t *testing.T) {
+	k, ctx, ica := setupKeeperWithICA(t)
+	ctx = ctx.WithBlockTime(time.Unix(1000, 0))
+
+	require.NoError(t, requestTimedStreamAccess(ctx, k, 2000))
+	require.NoError(t, k.FlushOutbox(ctx))
+	packet := channeltypes.Packet{SourceChannel: testChannelID, Sequence: ica.sequence}
+	require.NoError(t, k.OnAcknowledgementPacket(ctx, packet, msgResponsesAck(t, 1)))
+
+	// A failed deletion leaves the relationship confirmed.
+	ctx = ctx.WithBlockTime(time.Unix(2000, 0))
+	require.NoError(t, k.RevokeExpiredGrants(ctx))
+	require.Equal(t, types.RelationshipStatus_RELATIONSHIP_STATUS_DELETING, subscriberStatus(t, k, ctx, "did:key:alice"))
+	require.False(t, k.HasRelationship(ctx, "", types.ViewResourceName, "view-1", "subscriber", "did:key:alice"))
+
+	params, err := k.GetParams(ctx)
+	require.NoError(t, err)
+	params.MaxAttempts = 1
+	k.SetParams(ctx, params)
+
+	require.NoError(t, k.FlushOutbox(ctx))
+	packet.Sequence = ica.sequence
+	ack := channeltypes.NewErrorAcknowledgement(errors.New("relationship not found"))
+	require.NoError(t, k.OnAcknowledgementPacket(ctx, packet, ack.Acknowledgement()))
+	require.Equal(t, types.RelationshipStatus_RELATIONSHIP_STATUS_CONFIRMED, subscriberStatus(t, k, ctx, "did:key:alice"))
+
+	// An acknowledged deletion removes it.
+	require.NoError(t, requestTimedStreamAccess(ctx, k, 3000))
+	require.NoError(t, k.FlushOutbox(ctx))
+	packet.Sequence = ica.sequence
+	require.NoError(t, k.OnAcknowledgementPacket(ctx, packet, msgResponsesAck(t, 1)))
+
+	ctx = ctx.WithBlockTime(time.Unix(3000, 0))
+	require.NoError(t, k.RevokeExpiredGrants(ctx))
+	require.NoError(t, k.FlushOutbox(ctx))
+	packet.Sequence = ica.sequence
+	require.NoError(t, k.OnAcknowledgementPacket(ctx, packet, msgResponsesAck(t, 1)))
+
+	_, found := k.GetRelationship(ctx, "", types.ViewResourceName, "view-1", "subscriber", "did:key:alice")
+	require.False(t, found)
+	has, err := k.RelationshipsByActor.Has(ctx, collections.Join4(testTarget, "did:key:alice", objectKey(types.ViewResourceName, "view-1"), "subscriber"))
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestRelationshipFailed(t *testing.T) {
+	k, ctx, _ := setupKeeperWithICA(t)
+
+	params, err := k.GetParams(ctx)
+	require.NoError(t, err)
+	params.MaxAttempts = 1
+	k.SetParams(ctx, params)
+
+	queueStreamAccess(t, k, ctx, "did:key:alice")
+	require.NoError(t, k.FlushOutbox(ctx))
+	require.NoError(t, k.OnTimeoutPacket(ctx, channeltypes.Packet{SourceChannel: testChannelID, Sequence: 1}))
+
+	require.Equal(t, types.RelationshipStatus_RELATIONSHIP_STATUS_FAILED, subscriberStatus(t, k, ctx, "did:key:alice"))
+	require.False(t, k.HasRelationship(ctx, "", types.ViewResourceName, "view-1", "subscriber", "did:key:alice"))
+}
+
+func TestConfirmedRelationshipSetAgain(t *testing.T) {
+	k, ctx, ica := setupKeeperWithICA(t)
+	ms := NewMsgServerImpl(k)
+	msg := &types.MsgRegisterShinzoObjects{Signer: k.GetAuthority(), Resources: []string{"Log"}}
+
+	_, err := ms.RegisterShinzoObjects(ctx, msg)
+	require.NoError(t, err)
+	require.NoError(t, k.FlushOutbox(ctx))
+	packet := channeltypes.Packet{SourceChannel: testChannelID, Sequence: ica.sequence}
+	require.NoError(t, k.OnAcknowledgementPacket(ctx, packet, msgResponsesAck(t, 3)))
+	require.True(t, k.HasRelationship(ctx, "", types.PrimitiveResourceName, "Log", ownerRelation, testIcaAddress))
+
+	params, err := k.GetParams(ctx)
+	require.NoError(t, err)
+	params.MaxAttempts = 1
+	k.SetParams(ctx, params)
+
+	// Registering the objects again keeps them confirmed, even though
+	// SourceHub rejects objects that already exist.
+	_, err = ms.RegisterShinzoObjects(ctx, msg)
+	require.NoError(t, err)
+	require.True(t, k.HasRelationship(ctx, "", types.PrimitiveResourceName, "Log", ownerRelation, testIcaAddress))
+
+	require.NoError(t, k.FlushOutbox(ctx))
+	packet.Sequence = ica.sequence
+	ack := channeltypes.NewErrorAcknowledgement(errors.New("object already registered"))
+	require.NoError(t, k.OnAcknowledgementPacket(ctx, packet, ack.Acknowledgement()))
+	for _, object := range []struct{ resource, id string }{
+		{types.PrimitiveResourceName, "Log"},
+		{types.GroupObjectName, types.GroupIndexerName},
+		{types.GroupObjectName, types.GroupHostName},
+	} {
+		require.True(t, k.HasRelationship(ctx, "", object.resource, object.id, ownerRelation, testIcaAddress), object.id)
+	}
+}
+
+func TestRelationshipQueries(t *testing.T) {
+	k, ctx, _ := setupKeeperWithICA(t)
+	qs := NewQueryServerImpl(k)
+
+	queueStreamAccess(t, k, ctx, "did:key:alice")
+	queueStreamAccess(t, k, ctx, "did:key:bob")
+	registerPrimitives(t, k, ctx, "Log")
+	require.NoError(t, k.RegisterObject(ctx, "", "view-1", sdk.AccAddress("creator_____________"), "Log {address}"))
+	require.NoError(t, k.RegisterObject(ctx, "", "view-2", sdk.AccAddress("creator_____________"), "Log {topics}"))
+
+	r, err := qs.Relationship(ctx, &types.QueryRelationshipRequest{
+		Resource: types.ViewResourceName,
+		ObjectId: "view-1",
+		Relation: "owner",
+		Actor:    testIcaAddress,
+	})
+	require.NoError(t, err)
+	require.Equal(t, types.RelationshipStatus_RELATIONSHIP_STATUS_PENDING, r.Relationship.Status)
+
+	_, err = qs.Relationship(ctx, &types.QueryRelationshipRequest{
+		Resource: types.ViewResourceName,
+		ObjectId: "view-2",
+		Relation: "subscriber",
+		Actor:    "did:key:alice",
+	})
+	require.ErrorContains(t, err, "relationship not found")
+
+	byObject, err := qs.RelationshipsByObject(ctx, &types.QueryRelationshipsByObjectRequest{
+		Resource: types.ViewResourceName,
+		ObjectId: "view-1",
+	})
+	require.NoError(t, err)
+	require.Len(t, byObject.Relationships, 4)
+
+	byObject, err = qs.RelationshipsByObject(ctx, &types.QueryRelationshipsByObjectRequest{
+		Resource:   types.ViewResourceName,
+		ObjectId:   "view-1",
+		Relation:   "subscriber",
+		Pagination: &query.PageRequest{Limit: 1},
+	})
+	require.NoError(t, err)
+	require.Len(t, byObject.Relationships, 1)
+	require.Equal(t, "did:key:alice", byObject.Relationships[0].Actor)
+	require.NotNil(t, byObject.Pagination.NextKey)
+
+	byObject, err = qs.RelationshipsByObject(ctx, &types.QueryRelationshipsByObjectRequest{
+		Resource:   types.ViewResourceName,
+		ObjectId:   "view-1",
+		Relation:   "subscriber",
+		Pagination: &query.PageRequest{Key: byObject.Pagination.NextKey},
+	})
+	require.NoError(t, err)
+	require.Len(t, byObject.Relationships, 1)
+	require.Equal(t, "did:key:bob", byObject.Relationships[0].Actor)
+
+	byActor, err := qs.RelationshipsByActor(ctx, &types.QueryRelationshipsByActorRequest{Actor: testIcaAddress})
+	require.NoError(t, err)
+	require.Len(t, byActor.Relationships, 3)
+	require.Equal(t, "Log", byActor.Relationships[0].ObjectId)
+	require.Equal(t, "view-1", byActor.Relationships[1].ObjectId)
+	require.Equal(t, "view-2", byActor.Relationships[2].ObjectId)
+
+	byActor, err = qs.RelationshipsByActor(ctx, &types.QueryRelationshipsByActorRequest{Actor: "did:key:bob", Relation: "owner"})
+	require.NoError(t, err)
+	require.Empty(t, byActor.Relationships)
+
+	_, err = qs.RelationshipsByObject(ctx, &types.QueryRelationshipsByObjectRequest{Resource: types.ViewResourceName})
+	require.ErrorContains(t, err, "object ID")
+	_, err = qs.RelationshipsByActor(ctx, &types.QueryRelationshipsByActorRequest{})
+	require.ErrorContains(t, err, "actor is required")
+}
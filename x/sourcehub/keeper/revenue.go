@@ -0,0 +1,266 @@
+package keeper
+
+import (
+	"errors"
+	"slices"
+	"strings"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/shinzonetwork/shinzohub/x/sourcehub/types"
+)
+
+const (
+	writerRelation = "writer"
+	syncerRelation = "syncer"
+)
+
+// maxRevenuesPerBlock bounds the number of streams whose revenue is shared in
+// a single EndBlocker, the rest are shared in the following blocks.
+const maxRevenuesPerBlock = 100
+
+// revenueKey is the key of a stream in StreamRevenues.
+type revenueKey = collections.Pair[int32, string]
+
+// addStreamRevenue adds a subscription fee paid for a stream to the revenue
+// collected in the current epoch.
+func (k Keeper) addStreamRevenue(ctx sdk.Context, resource types.Resource, streamId string, amount sdk.Coins) error {
+	key := streamPriceKey(resource, streamId)
+	r, err := k.StreamRevenues.Get(ctx, key)
+	if errors.Is(err, collections.ErrNotFound) {
+		r = types.StreamRevenue{Resource: resource, StreamId: streamId}
+	} else if err != nil {
+		return err
+	}
+	r.Amount = r.Amount.Add(amount...)
+	return k.StreamRevenues.Set(ctx, key, r)
+}
+
+// GetPendingRewards returns the rewards credited to owner and not withdrawn
+// yet.
+func (k Keeper) GetPendingRewards(ctx sdk.Context, owner sdk.AccAddress) (sdk.Coins, error) {
+	r, err := k.PendingRewards.Get(ctx, owner)
+	if errors.Is(err, collections.ErrNotFound) {
+		return sdk.NewCoins(), nil
+	} else if err != nil {
+		return nil, err
+	}
+	return r.Amount, nil
+}
+
+func (k Keeper) creditReward(ctx sdk.Context, owner sdk.AccAddress, amount sdk.Coins) error {
+	pending, err := k.GetPendingRewards(ctx, owner)
+	if err != nil {
+		return err
+	}
+	return k.PendingRewards.Set(ctx, owner, types.PendingReward{
+		Address: owner.String(),
+		Amount:  pending.Add(amount...),
+	})
+}
+
+// revenueRecipients are the accounts sharing the revenue of a stream, per
+// revenue share.
+type revenueRecipients struct {
+	creators []sdk.AccAddress
+	hosts    []sdk.AccAddress
+	indexers []sdk.AccAddress
+}
+
+// entityOwners returns the owners of the active entities of the given role
+// registered with the actors holding relation on an object on target, in the
+// order of the actors, after owners. Actors that are not the DID of such an
+// entity are skipped.
+func (k Keeper) entityOwners(ctx sdk.Context, target, resource, objectId, relation string, role types.EntityRole, owners []sdk.AccAddress) ([]sdk.AccAddress, error) {
+	rng := collections.NewSuperPrefixedQuadRange3[string, string, string, string](target, objectKey(resource, objectId), relation)
+	err := k.Relationships.Walk(ctx, rng, func(_ collections.Quad[string, string, string, string], r types.Relationship) (bool, error) {
+		if r.Status != types.RelationshipStatus_RELATIONSHIP_STATUS_CONFIRMED {
+			return false, nil
+		}
+		owner, err := k.EntitiesByDid.Get(ctx, collections.Join(r.Actor, int32(role)))
+		if errors.Is(err, collections.ErrNotFound) {
+			return false, nil
+		} else if err != nil {
+			return true, err
+		}
+		if e, found := k.GetEntity(ctx, owner, role); !found || e.Status != types.EntityStatus_ENTITY_STATUS_ACTIVE {
+			return false, nil
+		}
+		if !slices.ContainsFunc(owners, func(o sdk.AccAddress) bool { return o.Equals(owner) }) {
+			owners = append(owners, owner)
+		}
+		return false, nil
+	})
+	return owners, err
+}
+
+// revenueRecipients returns the accounts sharing the revenue of a stream. The
+// creator of a view and the hosts syncing it share the revenue of the view,
+// and the indexers writing the primitives it derives from, or the primitive
+// itself, share the revenue of both. Relationships are read on the target of
+// the view, and on every target for a primitive.
+func (k Keeper) revenueRecipients(ctx sdk.Context, resource types.Resource, streamId string) (revenueRecipients, error) {
+	var recipients revenueRecipients
+	if resource == types.Resource_RESOURCE_PRIMITIVE {
+		err := k.Targets.Walk(ctx, nil, func(chainId string, _ types.SourcehubTarget) (bool, error) {
+			var err error
+			recipients.indexers, err = k.entityOwners(ctx, chainId, types.PrimitiveResourceName, streamId, writerRelation, types.EntityRole_ENTITY_ROLE_INDEXER, recipients.indexers)
+			return err != nil, err
+		})
+		return recipients, err
+	}
+
+	var target string
+	v, err := k.Views.Get(ctx, streamId)
+	if err == nil {
+		creator, err := sdk.AccAddressFromBech32(v.Creator)
+		if err != nil {
+			return recipients, err
+		}
+		recipients.creators = []sdk.AccAddress{creator}
+		target = v.Target
+	} else if !errors.Is(err, collections.ErrNotFound) {
+		return recipients, err
+	}
+	target = k.targetName(ctx, target)
+
+	recipients.hosts, err = k.entityOwners(ctx, target, types.ViewResourceName, streamId, syncerRelation, types.EntityRole_ENTITY_ROLE_HOST, nil)
+	if err != nil {
+		return recipients, err
+	}
+
+	var primitives []string
+	rng := collections.NewSuperPrefixedQuadRange3[string, string, string, string](target, objectKey(types.ViewResourceName, streamId), parentRelation)
+	err = k.Relationships.Walk(ctx, rng, func(_ collections.Quad[string, string, string, string], r types.Relationship) (bool, error) {
+		if id, ok := strings.CutPrefix(r.Actor, objectKey(types.PrimitiveResourceName, "")); ok && r.Status == types.RelationshipStatus_RELATIONSHIP_STATUS_CONFIRMED {
+			primitives = append(primitives, id)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return recipients, err
+	}
+	for _, id := range primitives {
+		recipients.indexers, err = k.entityOwners(ctx, target, types.PrimitiveResourceName, id, writerRelation, types.EntityRole_ENTITY_ROLE_INDEXER, recipients.indexers)
+		if err != nil {
+			return recipients, err
+		}
+	}
+	return recipients, nil
+}
+
+// shareOf returns the part of amount each of n recipients of a share of bps
+// basis points gets, rounded down.
+func shareOf(amount sdk.Coins, bps uint32, n int) sdk.Coins {
+	var part sdk.Coins
+	for _, c := range amount {
+		part = part.Add(sdk.NewCoin(c.Denom, c.Amount.MulRaw(int64(bps)).QuoRaw(int64(types.RevenueShareTotal)*int64(n))))
+	}
+	return part
+}
+
+// distributeRevenue credits the shares of amount to the recipients, split
+// evenly among the recipients of each share, and returns the rest, which goes
+// to the community pool.
+func (k Keeper) distributeRevenue(ctx sdk.Context, shares types.RevenueShares, amount sdk.Coins, recipients revenueRecipients) (sdk.Coins, error) {
+	rest := amount
+	for _, share := range []struct {
+		bps        uint32
+		recipients []sdk.AccAddress
+	}{
+		{shares.Creator, recipients.creators},
+		{shares.Hosts, recipients.hosts},
+		{shares.Indexers, recipients.indexers},
+	} {
+		if share.bps == 0 || len(share.recipients) == 0 {
+			continue
+		}
+		part := shareOf(amount, share.bps, len(share.recipients))
+		if part.IsZero() {
+			continue
+		}
+		for _, owner := range share.recipients {
+			if err := k.creditReward(ctx, owner, part); err != nil {
+				return nil, err
+			}
+			rest = rest.Sub(part...)
+		}
+	}
+	return rest, nil
+}
+
+// DistributeRewards shares the subscription fees collected during an epoch
+// between the creators, hosts and indexers of the streams and the community
+// pool once the epoch ends. At most maxRevenuesPerBlock streams are shared per
+// block, the following blocks pick up after the last one until every stream
+// was shared, each emitting the amount it distributed.
+func (k Keeper) DistributeRewards(ctx sdk.Context) error {
+	p, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	length := p.BlocksPerEpoch()
+	if ctx.BlockHeight() <= 0 {
+		return nil
+	}
+
+	var rng collections.Ranger[revenueKey]
+	cursor, err := k.RevenueCursor.Get(ctx)
+	switch {
+	case err == nil:
+		rng = new(collections.Range[revenueKey]).StartExclusive(cursor)
+	case !errors.Is(err, collections.ErrNotFound):
+		return err
+	case uint64(ctx.BlockHeight())%length != 0:
+		return nil
+	}
+
+	var revenues []types.StreamRevenue
+	if err := k.StreamRevenues.Walk(ctx, rng, func(_ revenueKey, r types.StreamRevenue) (bool, error) {
+		revenues = append(revenues, r)
+		return len(revenues) == maxRevenuesPerBlock, nil
+	}); err != nil {
+		return err
+	}
+	if len(revenues) == maxRevenuesPerBlock {
+		last := revenues[len(revenues)-1]
+		if err := k.RevenueCursor.Set(ctx, streamPriceKey(last.Resource, last.StreamId)); err != nil {
+			return err
+		}
+	} else if err := k.RevenueCursor.Remove(ctx); err != nil {
+		return err
+	}
+	if len(revenues) == 0 {
+		return nil
+	}
+
+	var total, pool sdk.Coins
+	for _, r := range revenues {
+		recipients, err := k.revenueRecipients(ctx, r.Resource, r.StreamId)
+		if err != nil {
+			return err
+		}
+		rest, err := k.distributeRevenue(ctx, p.RevenueShares, r.Amount, recipients)
+		if err != nil {
+			return err
+		}
+		total = total.Add(r.Amount...)
+		pool = pool.Add(rest...)
+		if err := k.StreamRevenues.Remove(ctx, streamPriceKey(r.Resource, r.StreamId)); err != nil {
+			return err
+		}
+	}
+
+	if !pool.IsZero() {
+		if err := k.distrKeeper.FundCommunityPool(ctx, pool, types.ModuleAddress); err != nil {
+			return err
+		}
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventEpochRewardsDistributed{
+		Epoch:         uint64(ctx.BlockHeight()) / length,
+		Amount:        total,
+		CommunityPool: pool,
+	})
+}
@@ -0,0 +1,194 @@
+package keeper
+
+import (
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/shinzonetwork/shinzohub/x/sourcehub/types"
+)
+
+func ushinzo(amount int64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin("ushinzo", amount))
+}
+
+// setRevenueEntity stores an entity of owner with did in status, holding
+// relation on an object.
+func setRevenueEntity(t *testing.T, k Keeper, ctx sdk.Context, owner sdk.AccAddress, role types.EntityRole, did string, status types.EntityStatus, resource, objectId, relation string) {
+	t.Helper()
+
+	require.NoError(t, k.SetEntity(ctx, types.Entity{Owner: owner.String(), Role: role, Did: did, Status: status}))
+	require.NoError(t, k.SetRelationship(ctx, types.Relationship{
+		Resource: resource,
+		ObjectId: objectId,
+		Relation: relation,
+		Actor:    did,
+		Status:   types.RelationshipStatus_RELATIONSHIP_STATUS_CONFIRMED,
+	}))
+}
+
+func TestDistributeRewards(t *testing.T) {
+	k, ctx, _ := setupKeeperWithICA(t)
+	bank := testBank(k)
+
+	p, err := k.GetParams(ctx)
+	require.NoError(t, err)
+	p.RevenueShares = types.RevenueShares{Creator: 4000, Hosts: 3000, Indexers: 2000, CommunityPool: 1000}
+	p.EpochLength = 10
+	k.SetParams(ctx, p)
+
+	creator := sdk.AccAddress("creator_____________")
+	host1 := sdk.AccAddress("host1_______________")
+	host2 := sdk.AccAddress("host2_______________")
+	indexer := sdk.AccAddress("indexer_____________")
+	require.NoError(t, k.Views.Set(ctx, "view-1", types.View{Id: "view-1", Creator: creator.String()}))
+	require.NoError(t, k.SetRelationship(ctx, types.Relationship{
+		Resource: types.ViewResourceName,
+		ObjectId: "view-1",
+		Relation: parentRelation,
+		Actor:    objectKey(types.PrimitiveResourceName, "Log"),
+		Status:   types.RelationshipStatus_RELATIONSHIP_STATUS_CONFIRMED,
+	}))
+	setRevenueEntity(t, k, ctx, host1, types.EntityRole_ENTITY_ROLE_HOST, "did:key:host1", types.EntityStatus_ENTITY_STATUS_ACTIVE, types.ViewResourceName, "view-1", syncerRelation)
+	setRevenueEntity(t, k, ctx, host2, types.EntityRole_ENTITY_ROLE_HOST, "did:key:host2", types.EntityStatus_ENTITY_STATUS_ACTIVE, types.ViewResourceName, "view-1", syncerRelation)
+	setRevenueEntity(t, k, ctx, indexer, types.EntityRole_ENTITY_ROLE_INDEXER, "did:key:indexer", types.EntityStatus_ENTITY_STATUS_ACTIVE, types.PrimitiveResourceName, "Log", writerRelation)
+	// Neither a pending host nor a DID without entity earns anything.
+	setRevenueEntity(t, k, ctx, sdk.AccAddress("host3_______________"), types.EntityRole_ENTITY_ROLE_HOST, "did:key:host3", types.EntityStatus_ENTITY_STATUS_PENDING, types.ViewResourceName, "view-1", syncerRelation)
+	require.NoError(t, k.SetRelationship(ctx, types.Relationship{
+		Resource: types.ViewResourceName,
+		ObjectId: "view-1",
+		Relation: syncerRelation,
+		Actor:    "did:key:stranger",
+		Status:   types.RelationshipStatus_RELATIONSHIP_STATUS_CONFIRMED,
+	}))
+	// Relationships mirrored from another target do not count for view-1.
+	require.NoError(t, k.SetRelationship(ctx, types.Relationship{
+		Target:   "other-chain",
+		Resource: types.PrimitiveResourceName,
+		ObjectId: "Log",
+		Relation: writerRelation,
+		Actor:    "did:key:host1",
+		Status:   types.RelationshipStatus_RELATIONSHIP_STATUS_CONFIRMED,
+	}))
+
+	// Nobody writes the Other primitive, its revenue goes to the community
+	// pool.
+	require.NoError(t, k.addStreamRevenue(ctx, types.Resource_RESOURCE_VIEW, "view-1", ushinzo(1000)))
+	require.NoError(t, k.addStreamRevenue(ctx, types.Resource_RESOURCE_VIEW, "view-1", ushinzo(1)))
+	require.NoError(t, k.addStreamRevenue(ctx, types.Resource_RESOURCE_PRIMITIVE, "Other", ushinzo(100)))
+	bank.balances[types.ModuleAddress.String()] = ushinzo(1101)
+
+	// Nothing happens before the end of the epoch.
+	require.NoError(t, k.DistributeRewards(ctx.WithBlockHeight(9)))
+	res, err := NewQueryServerImpl(k).StreamRevenues(ctx, &types.QueryStreamRevenuesRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Revenues, 2)
+
+	ctx = ctx.WithBlockHeight(10)
+	require.NoError(t, k.DistributeRewards(ctx))
+
+	for owner, amount := range map[string]int64{
+		creator.String(): 400,
+		host1.String():   150,
+		host2.String():   150,
+		indexer.String(): 200,
+	} {
+		res, err := NewQueryServerImpl(k).PendingRewards(ctx, &types.QueryPendingRewardsRequest{Address: owner})
+		require.NoError(t, err)
+		require.Equal(t, ushinzo(amount), res.Amount, owner)
+	}
+	require.Equal(t, ushinzo(201), bank.balances[communityPoolAddress.String()])
+	require.Equal(t, ushinzo(900), bank.balances[types.ModuleAddress.String()])
+
+	var distributed types.EventEpochRewardsDistributed
+	require.True(t, lastTypedEvent(t, ctx, &distributed))
+	require.Equal(t, uint64(1), distributed.Epoch)
+	require.Equal(t, ushinzo(1101), distributed.Amount)
+	require.Equal(t, ushinzo(201), distributed.CommunityPool)
+
+	res, err = NewQueryServerImpl(k).StreamRevenues(ctx, &types.QueryStreamRevenuesRequest{})
+	require.NoError(t, err)
+	require.Empty(t, res.Revenues)
+}
+
+func TestDistributeRewardsWithoutShares(t *testing.T) {
+	k, ctx, _ := setupKeeperWithICA(t)
+	bank := testBank(k)
+
+	creator := sdk.AccAddress("creator_____________")
+	require.NoError(t, k.Views.Set(ctx, "view-1", types.View{Id: "view-1", Creator: creator.String()}))
+	require.NoError(t, k.addStreamRevenue(ctx, types.Resource_RESOURCE_VIEW, "view-1", ushinzo(100)))
+	bank.balances[types.ModuleAddress.String()] = ushinzo(100)
+
+	require.NoError(t, k.DistributeRewards(ctx.WithBlockHeight(int64(types.DefaultEpochLength))))
+	require.Equal(t, ushinzo(100), bank.balances[communityPoolAddress.String()])
+	rewards, err := k.GetPendingRewards(ctx, creator)
+	require.NoError(t, err)
+	require.True(t, rewards.IsZero())
+}
+
+func TestDistributeRewardsAcrossBlocks(t *testing.T) {
+	k, ctx, _ := setupKeeperWithICA(t)
+	bank := testBank(k)
+
+	for i := range maxRevenuesPerBlock + 1 {
+		require.NoError(t, k.addStreamRevenue(ctx, types.Resource_RESOURCE_PRIMITIVE, fmt.Sprintf("Primitive%03d", i), ushinzo(1)))
+	}
+	bank.balances[types.ModuleAddress.String()] = ushinzo(maxRevenuesPerBlock + 1)
+
+	// The end of the epoch shares the revenue of at most maxRevenuesPerBlock
+	// streams, the next block shares the rest.
+	length := int64(types.DefaultEpochLength)
+	ctx = ctx.WithBlockHeight(length)
+	require.NoError(t, k.DistributeRewards(ctx))
+	require.Equal(t, ushinzo(maxRevenuesPerBlock), bank.balances[communityPoolAddress.String()])
+	var distributed types.EventEpochRewardsDistributed
+	require.True(t, lastTypedEvent(t, ctx, &distributed))
+	require.Equal(t, ushinzo(maxRevenuesPerBlock), distributed.Amount)
+	_, err := k.RevenueCursor.Get(ctx)
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(length + 1).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.DistributeRewards(ctx))
+	require.Equal(t, ushinzo(maxRevenuesPerBlock+1), bank.balances[communityPoolAddress.String()])
+	require.True(t, lastTypedEvent(t, ctx, &distributed))
+	require.Equal(t, uint64(1), distributed.Epoch)
+	require.Equal(t, ushinzo(1), distributed.Amount)
+	has, err := k.RevenueCursor.Has(ctx)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	res, err := NewQueryServerImpl(k).StreamRevenues(ctx, &types.QueryStreamRevenuesRequest{})
+	require.NoError(t, err)
+	require.Empty(t, res.Revenues)
+}
+
+func TestWithdrawRewards(t *testing.T) {
+	k, ctx, _ := setupKeeperWithICA(t)
+	ms := NewMsgServerImpl(k)
+	bank := testBank(k)
+	creator := sdk.AccAddress("creator_____________")
+
+	_, err := ms.WithdrawRewards(ctx, &types.MsgWithdrawRewards{Owner: creator.String()})
+	require.ErrorIs(t, err, types.ErrNoRewards)
+
+	require.NoError(t, k.creditReward(ctx, creator, ushinzo(40)))
+	require.NoError(t, k.creditReward(ctx, creator, ushinzo(2)))
+	bank.balances[types.ModuleAddress.String()] = ushinzo(50)
+
+	res, err := ms.WithdrawRewards(ctx, &types.MsgWithdrawRewards{Owner: creator.String()})
+	require.NoError(t, err)
+	require.Equal(t, ushinzo(42), res.Amount)
+	require.Equal(t, ushinzo(42), bank.balances[creator.String()])
+	require.Equal(t, ushinzo(8), bank.balances[types.ModuleAddress.String()])
+
+	var withdrawn types.EventRewardsWithdrawn
+	require.True(t, lastTypedEvent(t, ctx, &withdrawn))
+	require.Equal(t, creator.String(), withdrawn.Owner)
+	require.Equal(t, ushinzo(42), withdrawn.Amount)
+
+	_, err = ms.WithdrawRewards(ctx, &types.MsgWithdrawRewards{Owner: creator.String()})
+	require.ErrorIs(t, err, types.ErrNoRewards)
+}
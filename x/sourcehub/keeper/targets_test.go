@@ -0,0 +1,280 @@
+package keeper
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/shinzonetwork/shinzohub/x/sourcehub/types"
+)
+
+func TestRegisterSourcehubICAAddsTargets(t *testing.T) {
+	k, ctx, _ := setupKeeper(t)
+	k.InitGenesis(ctx, *types.DefaultGenesis())
+	ms := NewMsgServerImpl(k)
+
+	register := func(chainId, connectionID string) error {
+		_, err := ms.RegisterSourcehubICA(ctx, &types.MsgRegisterSourcehubICA{
+			Signer:                 k.GetAuthority(),
+			ControllerConnectionId: connectionID,
+			HostConnectionId:       connectionID,
+			ChainId:                chainId,
+		})
+		return err
+	}
+
+	require.NoError(t, register(testTarget, "connection-0"))
+	require.NoError(t, register("sourcehub-test", "connection-1"))
+	require.Equal(t, testTarget, k.GetDefaultTarget(ctx), "the first target is the default")
+
+	require.ErrorContains(t, register("sourcehub-other", "connection-1"), "used by target sourcehub-test")
+
+	// Registering a target again moves it to another connection.
+	require.NoError(t, register("sourcehub-test", "connection-2"))
+	target, found := k.GetTarget(ctx, "sourcehub-test")
+	require.True(t, found)
+	require.Equal(t, "connection-2", target.ControllerConnectionId)
+	require.Equal(t, types.IcaChannelState_ICA_CHANNEL_STATE_OPENING, target.Channel.State)
+}
+
+func TestCommandsAreSentToTheirTarget(t *testing.T) {
+	k, ctx, ica := setupKeeperWithICA(t)
+	addTarget(t, k, ctx, ica, "sourcehub-test", "connection-1")
+	ica.channels["connection-1"] = "channel-1"
+	ms := NewMsgServerImpl(k)
+
+	queueStreamAccess(t, k, ctx, "did:key:alice")
+	_, err := ms.RequestStreamAccess(ctx, &types.MsgRequestStreamAccess{
+		Signer:   k.GetAuthority(),
+		Resource: types.Resource_RESOURCE_VIEW,
+		StreamId: "view-1",
+		Did:      "did:key:bob",
+		Target:   "sourcehub-test",
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, k.FlushOutbox(ctx))
+	require.Len(t, ica.sent, 2, "commands for different targets are not batched together")
+
+	p, found := k.GetIcaPacket(ctx, testChannelID, 1)
+	require.True(t, found)
+	require.Equal(t, testTarget, p.Target)
+	require.Equal(t, []uint64{0}, p.CommandIds)
+
+	p, found = k.GetIcaPacket(ctx, "channel-1", 2)
+	require.True(t, found)
+	require.Equal(t, "sourcehub-test", p.Target)
+	require.Equal(t, []uint64{1}, p.CommandIds)
+
+	g, found := k.GetGrant(ctx, "sourcehub-test", types.Resource_RESOURCE_VIEW, "view-1", "did:key:bob")
+	require.True(t, found)
+	require.Equal(t, "sourcehub-test", g.Target)
+
+	_, err = ms.RequestStreamAccess(ctx, &types.MsgRequestStreamAccess{
+		Signer:   k.GetAuthority(),
+		Resource: types.Resource_RESOURCE_VIEW,
+		StreamId: "view-1",
+		Did:      "did:key:carol",
+		Target:   "other",
+	})
+	require.ErrorContains(t, err, "unknown SourceHub target other")
+}
+
+func TestClosedChannelOnlyHoldsItsTarget(t *testing.T) {
+	k, ctx, ica := setupKeeperWithICA(t)
+	addTarget(t, k, ctx, ica, "sourcehub-test", "connection-1")
+	ica.channels["connection-1"] = "channel-1"
+
+	require.NoError(t, k.OnIcaChannelClosed(ctx, k.GetICAPortID(), "channel-1"))
+	target, _ := k.GetTarget(ctx, "sourcehub-test")
+	require.Equal(t, types.IcaChannelState_ICA_CHANNEL_STATE_CLOSED, target.Channel.State)
+	require.Equal(t, types.IcaChannelState_ICA_CHANNEL_STATE_UNSPECIFIED, defaultChannel(t, k, ctx).State)
+
+	queueStreamAccess(t, k, ctx, "did:key:alice")
+	_, err := NewMsgServerImpl(k).RequestStreamAccess(ctx, &types.MsgRequestStreamAccess{
+		Signer:   k.GetAuthority(),
+		Resource: types.Resource_RESOURCE_VIEW,
+		StreamId: "view-1",
+		Did:      "did:key:bob",
+		Target:   "sourcehub-test",
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, k.FlushOutbox(ctx))
+	require.Len(t, ica.sent, 1)
+
+	c, _ := k.GetIcaCommand(ctx, 1)
+	require.Equal(t, types.PacketStatus_PACKET_STATUS_QUEUED, c.Status)
+}
+
+func TestSetDefaultTarget(t *testing.T) {
+	k, ctx, ica := setupKeeperWithICA(t)
+	addTarget(t, k, ctx, ica, "sourcehub-test", "connection-1")
+	ms := NewMsgServerImpl(k)
+
+	_, err := ms.SetDefaultTarget(ctx, &types.MsgSetDefaultTarget{Signer: testOutsider, ChainId: "sourcehub-test"})
+	require.ErrorContains(t, err, "policy-admin role required")
+
+	_, err = ms.SetDefaultTarget(ctx, &types.MsgSetDefaultTarget{Signer: k.GetAuthority(), ChainId: "other"})
+	require.ErrorContains(t, err, "unknown SourceHub target")
+
+	_, err = ms.SetDefaultTarget(ctx, &types.MsgSetDefaultTarget{Signer: k.GetAuthority(), ChainId: "sourcehub-test"})
+	require.NoError(t, err)
+	require.Equal(t, "sourcehub-test", k.GetDefaultTarget(ctx))
+
+	queueStreamAccess(t, k, ctx, "did:key:alice")
+	c, _ := k.GetIcaCommand(ctx, 0)
+	require.Equal(t, "sourcehub-test", c.Target)
+}
+
+func TestObjectsBelongToOneTarget(t *testing.T) {
+	k, ctx, ica := setupKeeperWithICA(t)
+	addTarget(t, k, ctx, ica, "sourcehub-test", "connection-1")
+	ica.addresses["connection-1/"+k.GetICAPortID()] = "source1otherica"
+	ms := NewMsgServerImpl(k)
+	creator := sdk.AccAddress("creator_____________")
+	registerPrimitives(t, k, ctx, "Log")
+
+	// The parents of a view must be registered on the view's target.
+	err := k.RegisterObject(ctx, "sourcehub-test", "Logs_0x01", creator, "Log {address}")
+	require.ErrorIs(t, err, types.ErrInvalidView)
+
+	require.NoError(t, k.RegisterObject(ctx, "", "Logs_0x01", creator, "Log {address}"))
+	v, err := k.Views.Get(ctx, "Logs_0x01")
+	require.NoError(t, err)
+	require.Equal(t, testTarget, v.Target)
+
+	require.NoError(t, k.SetRelationship(ctx, types.Relationship{
+		Resource: types.PrimitiveResourceName,
+		ObjectId: "Log",
+		Relation: ownerRelation,
+		Actor:    "source1otherica",
+		Status:   types.RelationshipStatus_RELATIONSHIP_STATUS_CONFIRMED,
+		Target:   "sourcehub-test",
+	}))
+	err = k.RegisterObject(ctx, "sourcehub-test", "Logs_0x01", creator, "Log {address}")
+	require.ErrorContains(t, err, "view Logs_0x01 is registered on target "+testTarget)
+
+	// Access bought on a target is granted there, independently of the access
+	// held on another target.
+	priceStream(t, k, ctx, 0, testPrice)
+	_, err = ms.BuyStreamAccess(ctx, &types.MsgBuyStreamAccess{
+		Buyer:    testBuyer.String(),
+		Resource: types.Resource_RESOURCE_VIEW,
+		StreamId: "view-1",
+		Did:      "did:key:alice",
+		Target:   "sourcehub-test",
+	})
+	require.NoError(t, err)
+	g, found := k.GetGrant(ctx, "sourcehub-test", types.Resource_RESOURCE_VIEW, "view-1", "did:key:alice")
+	require.True(t, found)
+	require.Equal(t, "sourcehub-test", g.Target)
+	_, found = k.GetGrant(ctx, "", types.Resource_RESOURCE_VIEW, "view-1", "did:key:alice")
+	require.False(t, found)
+
+	_, err = ms.RequestStreamAccess(ctx, &types.MsgRequestStreamAccess{
+		Signer:   k.GetAuthority(),
+		Resource: types.Resource_RESOURCE_VIEW,
+		StreamId: "view-1",
+		Did:      "did:key:alice",
+	})
+	require.NoError(t, err)
+	for _, target := range []string{testTarget, "sourcehub-test"} {
+		g, found = k.GetGrant(ctx, target, types.Resource_RESOURCE_VIEW, "view-1", "did:key:alice")
+		require.True(t, found, target)
+		require.Equal(t, target, g.Target)
+		r, found := k.GetRelationship(ctx, target, types.ViewResourceName, "view-1", subscriberRelation, "did:key:alice")
+		require.True(t, found, target)
+		require.Equal(t, target, r.Target)
+	}
+}
+
+func TestMigrate2to3(t *testing.T) {
+	k, ctx, _ := setupKeeper(t)
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Set([]byte(types.KeyConnectionID), []byte("connection-0"))
+	store.Set([]byte(types.KeyHostConnectionID), []byte("connection-1"))
+	store.Set([]byte(types.KeyVersion), []byte(types.DefaultIcaVersion))
+	store.Set([]byte(types.KeyEncoding), []byte(types.DefaultIcaEncoding))
+	store.Set([]byte(types.KeyTxType), []byte(types.DefaultIcaTxType))
+	store.Set([]byte(types.PolicyId), []byte("policy-1"))
+
+	channel := types.IcaChannel{ChannelId: testChannelID, State: types.IcaChannelState_ICA_CHANNEL_STATE_OPEN, UpdatedHeight: 4}
+	bz, err := k.cdc.Marshal(&channel)
+	require.NoError(t, err)
+	store.Set(types.KeyPrefixIcaChannel, bz)
+
+	require.NoError(t, NewMigrator(k).Migrate2to3(ctx))
+
+	require.Equal(t, types.LegacyTargetName, k.GetDefaultTarget(ctx))
+	target, found := k.GetTarget(ctx, types.LegacyTargetName)
+	require.True(t, found)
+	want := types.NewSourcehubTarget(types.LegacyTargetName, "connection-0", "connection-1")
+	want.PolicyId = "policy-1"
+	want.Channel = channel
+	require.Equal(t, want, target)
+
+	require.False(t, store.Has([]byte(types.KeyConnectionID)))
+	require.False(t, store.Has([]byte(types.PolicyId)))
+	require.False(t, store.Has(types.KeyPrefixIcaChannel))
+}
+
+func TestMigrate3to4(t *testing.T) {
+	k, ctx, ica := setupKeeperWithICA(t)
+	addTarget(t, k, ctx, ica, "sourcehub-test", "connection-1")
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+
+	set := func(prefix collections.Prefix, key []byte, value []byte) {
+		store.Set(append(prefix.Bytes(), key...), value)
+	}
+	grant := types.StreamGrant{Resource: types.Resource_RESOURCE_VIEW, StreamId: "view-1", Did: "did:key:alice", Expiration: 2000}
+	bz, err := k.cdc.Marshal(&grant)
+	require.NoError(t, err)
+	set(types.KeyPrefixGrantsV3, []byte("view-1/did:key:alice"), bz)
+	set(types.KeyPrefixGrantsByExpirationV3, []byte("2000/view-1/did:key:alice"), []byte{})
+
+	relationship := types.Relationship{
+		Resource: types.ViewResourceName,
+		ObjectId: "view-1",
+		Relation: subscriberRelation,
+		Actor:    "did:key:alice",
+		Status:   types.RelationshipStatus_RELATIONSHIP_STATUS_CONFIRMED,
+		Target:   "sourcehub-test",
+	}
+	bz, err = k.cdc.Marshal(&relationship)
+	require.NoError(t, err)
+	set(types.KeyPrefixRelationshipsV3, []byte("view-1/subscriber/did:key:alice"), bz)
+	set(types.KeyPrefixRelationshipsByActorV3, []byte("did:key:alice/view-1/subscriber"), []byte{})
+
+	require.NoError(t, NewMigrator(k).Migrate3to4(ctx))
+
+	// A grant without a target moves to the default target.
+	g, found := k.GetGrant(ctx, testTarget, types.Resource_RESOURCE_VIEW, "view-1", "did:key:alice")
+	require.True(t, found)
+	require.Equal(t, testTarget, g.Target)
+	has, err := k.GrantsByExpiration.Has(ctx, collections.Join(uint64(2000), grantKey(testTarget, types.Resource_RESOURCE_VIEW, "view-1", "did:key:alice")))
+	require.NoError(t, err)
+	require.True(t, has)
+
+	require.True(t, k.HasRelationship(ctx, "sourcehub-test", types.ViewResourceName, "view-1", subscriberRelation, "did:key:alice"))
+	require.False(t, k.HasRelationship(ctx, testTarget, types.ViewResourceName, "view-1", subscriberRelation, "did:key:alice"))
+	has, err = k.RelationshipsByActor.Has(ctx, actorKey(relationship))
+	require.NoError(t, err)
+	require.True(t, has)
+
+	for _, prefix := range []collections.Prefix{
+		types.KeyPrefixGrantsV3,
+		types.KeyPrefixGrantsByExpirationV3,
+		types.KeyPrefixRelationshipsV3,
+		types.KeyPrefixRelationshipsByActorV3,
+	} {
+		iter := store.Iterator(prefix.Bytes(), storetypes.PrefixEndBytes(prefix.Bytes()))
+		require.False(t, iter.Valid(), prefix)
+		iter.Close()
+	}
+}
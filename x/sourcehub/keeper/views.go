@@ -0,0 +1,137 @@
+package keeper
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	coretypes "github.com/sourcenetwork/acp_core/pkg/types"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/lexer"
+	"github.com/vektah/gqlparser/v2/parser"
+
+	"github.com/shinzonetwork/shinzohub/x/sourcehub/types"
+)
+
+const (
+	parentRelation  = "parent"
+	creatorRelation = "creator"
+)
+
+// viewSources returns the collections selected at the top level of a view
+// query, in order and without duplicates. The query is either a bare
+// selection, e.g. "Log {address topics}", or a GraphQL query operation
+// wrapping one.
+func viewSources(query string) ([]string, error) {
+	// A bare selection is the selection set of an anonymous query.
+	if isBareSelection(query) {
+		query = "{" + query + "}"
+	}
+	doc, err := parser.ParseQuery(&ast.Source{Input: query})
+	if err != nil {
+		return nil, err
+	}
+	if len(doc.Operations) != 1 || doc.Operations[0].Operation != ast.Query {
+		return nil, fmt.Errorf("query must be a single query operation")
+	}
+
+	var sources []string
+	for _, sel := range doc.Operations[0].SelectionSet {
+		field, ok := sel.(*ast.Field)
+		if !ok {
+			return nil, fmt.Errorf("query selects collections through a fragment")
+		}
+		if !slices.Contains(sources, field.Name) {
+			sources = append(sources, field.Name)
+		}
+	}
+
+	if len(sources) == 0 {
+		return nil, fmt.Errorf("query selects no collection")
+	}
+	return sources, nil
+}
+
+// isBareSelection reports whether query starts with a field rather than an
+// executable GraphQL definition.
+func isBareSelection(query string) bool {
+	lex := lexer.New(&ast.Source{Input: query})
+	for {
+		tok, err := lex.ReadToken()
+		if err != nil {
+			return false
+		}
+		switch tok.Kind {
+		case lexer.Comment:
+			continue
+		case lexer.Name:
+			return !slices.Contains([]string{"query", "mutation", "subscription", "fragment"}, tok.Value)
+		default:
+			return false
+		}
+	}
+}
+
+// registeredObject returns the ID of the object of resource registered on
+// target t by its interchain account addr whose ID matches name without
+// regard to case, preferring an exact match, or "" if there is none. An
+// object is registered if its owner relationship is mirrored and did not
+// fail. Registrations still in flight count, as commands reach SourceHub in
+// order.
+func (k Keeper) registeredObject(ctx sdk.Context, t types.SourcehubTarget, addr, resource, name string) (string, error) {
+	registered := func(id string) bool {
+		r, found := k.GetRelationship(ctx, t.ChainId, resource, id, ownerRelation, addr)
+		return found && r.Status != types.RelationshipStatus_RELATIONSHIP_STATUS_FAILED
+	}
+	if registered(name) {
+		return name, nil
+	}
+
+	var match string
+	prefix := objectKey(resource, "")
+	rng := collections.NewSuperPrefixedQuadRange[string, string, string, string](t.ChainId, addr)
+	err := k.RelationshipsByActor.Walk(ctx, rng, func(key collections.Quad[string, string, string, string]) (bool, error) {
+		id, ok := strings.CutPrefix(key.K3(), prefix)
+		if ok && key.K4() == ownerRelation && strings.EqualFold(id, name) && registered(id) {
+			match = id
+			return true, nil
+		}
+		return false, nil
+	})
+	return match, err
+}
+
+// viewParents returns the objects a view derives from, the collections its
+// query selects. Every one of them must be a view or primitive registered by
+// the interchain account addr of target t, collection names match object IDs
+// without regard to case.
+func (k Keeper) viewParents(ctx sdk.Context, t types.SourcehubTarget, addr, query string) ([]*coretypes.Object, error) {
+	sources, err := viewSources(query)
+	if err != nil {
+		return nil, types.ErrInvalidView.Wrapf("view query: %s", err)
+	}
+
+	parents := make([]*coretypes.Object, 0, len(sources))
+	for _, source := range sources {
+		var parent *coretypes.Object
+		for _, resource := range []string{types.ViewResourceName, types.PrimitiveResourceName} {
+			id, err := k.registeredObject(ctx, t, addr, resource, source)
+			if err != nil {
+				return nil, err
+			}
+			if id != "" {
+				parent = coretypes.NewObject(resource, id)
+				break
+			}
+		}
+		if parent == nil {
+			return nil, types.ErrInvalidView.Wrapf("source collection %q is not a registered primitive or view on %s", source, t.ChainId)
+		}
+		if !slices.ContainsFunc(parents, func(p *coretypes.Object) bool { return p.Resource == parent.Resource && p.Id == parent.Id }) {
+			parents = append(parents, parent)
+		}
+	}
+	return parents, nil
+}
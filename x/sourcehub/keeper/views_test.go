@@ -0,0 +1,222 @@
+package keeper
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+	coretypes "github.com/sourcenetwork/acp_core/pkg/types"
+	acptypes "github.com/sourcenetwork/sourcehub/x/acp/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/shinzonetwork/shinzohub/x/sourcehub/types"
+)
+
+// registerPrimitives records the primitives as registered on the default
+// target, as if SourceHub acknowledged MsgRegisterShinzoObjects.
+func registerPrimitives(t *testing.T, k Keeper, ctx sdk.Context, names ...string) {
+	t.Helper()
+
+	for _, name := range names {
+		require.NoError(t, k.SetRelationship(ctx, types.Relationship{
+			Resource: types.PrimitiveResourceName,
+			ObjectId: name,
+			Relation: ownerRelation,
+			Actor:    testIcaAddress,
+			Status:   types.RelationshipStatus_RELATIONSHIP_STATUS_CONFIRMED,
+			Target:   testTarget,
+		}))
+	}
+}
+
+func TestViewSources(t *testing.T) {
+	for name, tc := range map[string]struct {
+		query   string
+		sources []string
+		err     string
+	}{
+		"bare selection": {
+			query:   "Log {address topics data transactionHash blockNumber}",
+			sources: []string{"Log"},
+		},
+		"several collections": {
+			query:   "Log {address} Block(filter: {number: {_gt: 10}}) {hash}",
+			sources: []string{"Log", "Block"},
+		},
+		"query operation": {
+			query:   "query Transfers($min: Int) {\n  # transfers only\n  transfers: Log(filter: {topics: {_any: {_eq: \"0xddf2}\"}}}) @include(if: true) {address}\n}",
+			sources: []string{"Log"},
+		},
+		"anonymous operation": {
+			query:   "{ Log {address}, Log {topics} Logs_0x01 {data} }",
+			sources: []string{"Log", "Logs_0x01"},
+		},
+		"empty": {
+			query: " ",
+			err:   "query must be a single query operation",
+		},
+		"unterminated selection set": {
+			query: "{ Log {address}",
+			err:   "Expected Name, found <EOF>",
+		},
+		"unterminated group": {
+			query: "Log {address",
+			err:   "Expected Name, found <EOF>",
+		},
+		"mismatched group": {
+			query: "Log(filter: {number: 1)) {hash}",
+			err:   "Expected Name, found )",
+		},
+		"not a name": {
+			query: "1Log {address}",
+			err:   "Unexpected Int \"1\"",
+		},
+		"mutation": {
+			query: "mutation { create_Log(input: {}) {address} }",
+			err:   "query must be a single query operation",
+		},
+		"fragment": {
+			query: "{ ...logs } fragment logs on Query { Log {address} }",
+			err:   "query selects collections through a fragment",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			sources, err := viewSources(tc.query)
+			if tc.err != "" {
+				require.ErrorContains(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.sources, sources)
+		})
+	}
+}
+
+func TestRegisterObjectSetsParentsAndCreator(t *testing.T) {
+	k, ctx, ica := setupKeeperWithICA(t)
+	registerPrimitives(t, k, ctx, "Log", "Block")
+
+	owner := sdk.AccAddress("owner_______________")
+	did, _, err := newTestEntityKeys(t).register(ctx, k, owner, types.RoleHost)
+	require.NoError(t, err)
+	require.NoError(t, k.FlushOutbox(ctx))
+
+	require.NoError(t, k.RegisterObject(ctx, "", "Logs_0x01", owner, "Log {address} Block {hash}"))
+	require.NoError(t, k.RegisterObject(ctx, "", "Derived_0x02", sdk.AccAddress("creator_____________"), "Logs_0x01 {address}"))
+	require.NoError(t, k.FlushOutbox(ctx))
+	require.Len(t, ica.sent, 2)
+
+	var tx icatypes.CosmosTx
+	require.NoError(t, gogoproto.Unmarshal(ica.sent[1].Data, &tx))
+	var cmds []*acptypes.PolicyCmd
+	for _, m := range tx.Messages {
+		var msg acptypes.MsgDirectPolicyCmd
+		require.NoError(t, gogoproto.Unmarshal(m.Value, &msg))
+		cmds = append(cmds, msg.Cmd)
+	}
+	require.Equal(t, []*acptypes.PolicyCmd{
+		acptypes.NewRegisterObjectCmd(coretypes.NewObject(types.ViewResourceName, "Logs_0x01")),
+		acptypes.NewSetRelationshipCmd(coretypes.NewRelationship(types.ViewResourceName, "Logs_0x01", "parent", types.PrimitiveResourceName, "Log")),
+		acptypes.NewSetRelationshipCmd(coretypes.NewRelationship(types.ViewResourceName, "Logs_0x01", "parent", types.PrimitiveResourceName, "Block")),
+		acptypes.NewSetRelationshipCmd(coretypes.NewActorRelationship(types.ViewResourceName, "Logs_0x01", "creator", string(did))),
+		// A creator without an entity gets no creator relationship.
+		acptypes.NewRegisterObjectCmd(coretypes.NewObject(types.ViewResourceName, "Derived_0x02")),
+		acptypes.NewSetRelationshipCmd(coretypes.NewRelationship(types.ViewResourceName, "Derived_0x02", "parent", types.ViewResourceName, "Logs_0x01")),
+	}, cmds)
+
+	parent, found := k.GetRelationship(ctx, "", types.ViewResourceName, "Derived_0x02", "parent", objectKey(types.ViewResourceName, "Logs_0x01"))
+	require.True(t, found)
+	require.Equal(t, types.RelationshipStatus_RELATIONSHIP_STATUS_PENDING, parent.Status)
+}
+
+func TestRegisterObjectRejectsUnknownSources(t *testing.T) {
+	k, ctx, _ := setupKeeperWithICA(t)
+	registerPrimitives(t, k, ctx, "Log")
+
+	failed := types.Relationship{
+		Resource: types.ViewResourceName,
+		ObjectId: "Failed_0x01",
+		Relation: ownerRelation,
+		Actor:    testIcaAddress,
+		Status:   types.RelationshipStatus_RELATIONSHIP_STATUS_FAILED,
+		Target:   testTarget,
+	}
+	require.NoError(t, k.SetRelationship(ctx, failed))
+
+	for _, query := range []string{"Log {address} Block {hash}", "Failed_0x01 {address}", "Log {address"} {
+		err := k.RegisterObject(ctx, "", "Logs_0x01", sdk.AccAddress("creator_____________"), query)
+		require.ErrorIs(t, err, types.ErrInvalidView, query)
+	}
+
+	has, err := k.Views.Has(ctx, "Logs_0x01")
+	require.NoError(t, err)
+	require.False(t, has)
+	next, err := k.NextCommandId.Peek(ctx)
+	require.NoError(t, err)
+	require.Zero(t, next)
+}
+
+func TestRegisterObjectMatchesSourcesIgnoringCase(t *testing.T) {
+	k, ctx, _ := setupKeeperWithICA(t)
+	// register-objects lower-cases primitive names.
+	registerPrimitives(t, k, ctx, "log", "block")
+
+	require.NoError(t, k.RegisterObject(ctx, "", "Logs_0x01", sdk.AccAddress("creator_____________"), "Log {address} LOG {topics} block {hash}"))
+
+	for _, id := range []string{"log", "block"} {
+		parent, found := k.GetRelationship(ctx, "", types.ViewResourceName, "Logs_0x01", parentRelation, objectKey(types.PrimitiveResourceName, id))
+		require.True(t, found, id)
+		require.Equal(t, types.RelationshipStatus_RELATIONSHIP_STATUS_PENDING, parent.Status)
+	}
+	var parents int
+	rng := collections.NewSuperPrefixedQuadRange3[string, string, string, string](testTarget, objectKey(types.ViewResourceName, "Logs_0x01"), parentRelation)
+	require.NoError(t, k.Relationships.Walk(ctx, rng, func(collections.Quad[string, string, string, string], types.Relationship) (bool, error) {
+		parents++
+		return false, nil
+	}))
+	require.Equal(t, 2, parents)
+
+	// Selecting an unregistered collection fails the registration.
+	err := k.RegisterObject(ctx, "", "Logs_0x02", sdk.AccAddress("creator_____________"), "Log {address} Transaction {hash}")
+	require.ErrorIs(t, err, types.ErrInvalidView)
+	require.ErrorContains(t, err, `"Transaction"`)
+}
+
+func TestBackfillObjects(t *testing.T) {
+	k, ctx, _ := setupKeeperWithICA(t)
+	ms := NewMsgServerImpl(k)
+	creator := sdk.AccAddress("creator_____________")
+
+	// Primitives registered before the mirror existed are unknown.
+	require.ErrorIs(t, k.RegisterObject(ctx, "", "Logs_0x01", creator, "Log {address}"), types.ErrInvalidView)
+
+	msg := &types.MsgBackfillObjects{Signer: testOutsider, Resource: types.Resource_RESOURCE_PRIMITIVE, Ids: []string{"log", "block"}}
+	_, err := ms.BackfillObjects(ctx, msg)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	msg.Signer = k.GetAuthority()
+	res, err := ms.BackfillObjects(ctx, msg)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), res.Backfilled)
+
+	var ev types.EventObjectsBackfilled
+	require.True(t, lastTypedEvent(t, ctx, &ev))
+	require.Equal(t, types.EventObjectsBackfilled{Target: testTarget, Signer: k.GetAuthority(), Ids: []string{"log", "block"}}, ev)
+
+	owner, found := k.GetRelationship(ctx, "", types.PrimitiveResourceName, "log", ownerRelation, testIcaAddress)
+	require.True(t, found)
+	require.Equal(t, types.RelationshipStatus_RELATIONSHIP_STATUS_CONFIRMED, owner.Status)
+	require.Equal(t, testTarget, owner.Target)
+	require.NoError(t, k.RegisterObject(ctx, "", "Logs_0x01", creator, "Log {address}"))
+
+	// Objects already mirrored are left alone, nothing is sent to SourceHub.
+	res, err = ms.BackfillObjects(ctx, msg)
+	require.NoError(t, err)
+	require.Zero(t, res.Backfilled)
+	next, err := k.NextCommandId.Peek(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), next)
+}
@@ -1,7 +1,9 @@
 package sourcehub
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 
 	"cosmossdk.io/core/appmodule"
 	storetypes "cosmossdk.io/core/store"
@@ -18,12 +20,15 @@ import (
 	"github.com/shinzonetwork/shinzohub/x/sourcehub/types"
 )
 
-const ConsensusVersion = 1
+const ConsensusVersion = 4
 
 var (
-	_ module.AppModuleBasic = (*AppModule)(nil)
-	_ module.HasGenesis     = (*AppModule)(nil)
-	_ appmodule.AppModule   = (*AppModule)(nil)
+	_ module.AppModuleBasic     = (*AppModule)(nil)
+	_ module.HasGenesis         = (*AppModule)(nil)
+	_ appmodule.AppModule       = (*AppModule)(nil)
+	_ appmodule.HasEndBlocker   = (*AppModule)(nil)
+	_ appmodule.HasBeginBlocker = (*AppModule)(nil)
+	_ module.HasInvariants      = (*AppModule)(nil)
 )
 
 type AppModule struct {
@@ -84,9 +89,58 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
+	types.RegisterQueryServer(cfg.QueryServer(), keeper.NewQueryServerImpl(am.keeper))
+
+	m := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 2, m.Migrate2to3); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 2 to 3: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 3, m.Migrate3to4); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 3 to 4: %v", types.ModuleName, err))
+	}
+}
+
+// RegisterInvariants registers the sourcehub module invariants.
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper)
 }
 
-func (AppModule) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {}
+// BeginBlock reopens the closed ICA channels to the SourceHub targets if
+// automatic reopening is enabled.
+func (am AppModule) BeginBlock(goCtx context.Context) error {
+	return am.keeper.AutoReopenICAChannel(sdk.UnwrapSDKContext(goCtx))
+}
+
+// EndBlock revokes stream access grants that have expired, distributes the
+// subscription fees collected during an epoch that ends, releases the entity
+// bonds that finished unbonding, forgets expired registration messages, and
+// sends the ACP commands queued during the block to SourceHub.
+func (am AppModule) EndBlock(goCtx context.Context) error {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := am.keeper.RevokeExpiredGrants(ctx); err != nil {
+		return err
+	}
+	if err := am.keeper.DistributeRewards(ctx); err != nil {
+		return err
+	}
+	if err := am.keeper.ReleaseEntityUnbondings(ctx); err != nil {
+		return err
+	}
+	if err := am.keeper.PruneUsedRegistrations(ctx); err != nil {
+		return err
+	}
+	return am.keeper.FlushOutbox(ctx)
+}
+
+func (AppModule) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {
+	if err := types.RegisterQueryHandlerClient(context.Background(), mux, types.NewQueryClient(clientCtx)); err != nil {
+		panic(err)
+	}
+}
 
 func (AppModule) RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	types.RegisterInterfaces(registry)
@@ -96,4 +150,8 @@ func (AppModule) GetTxCmd() *cobra.Command {
 	return cli.GetTxCmd()
 }
 
+func (AppModule) GetQueryCmd() *cobra.Command {
+	return cli.GetQueryCmd()
+}
+
 func (AppModule) RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {}
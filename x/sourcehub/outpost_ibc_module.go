@@ -0,0 +1,194 @@
+package sourcehub
+
+import (
+	"encoding/json"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
+	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+
+	"github.com/shinzonetwork/shinzohub/x/sourcehub/keeper"
+	"github.com/shinzonetwork/shinzohub/x/sourcehub/types"
+)
+
+var _ porttypes.IBCModule = OutpostIBCModule{}
+
+// OutpostIBCModule is the IBC application bound to the outpost port. Outpost
+// contracts on Shinzo-supported chains report the payments made for stream
+// access over it, and the module grants the paying DIDs access in response.
+// Only channels on the connections listed in params.outpost_connections are
+// trusted.
+type OutpostIBCModule struct {
+	keeper        keeper.Keeper
+	channelKeeper types.ChannelKeeper
+}
+
+// NewOutpostIBCModule creates a new OutpostIBCModule given the sourcehub
+// keeper and the IBC channel keeper.
+func NewOutpostIBCModule(k keeper.Keeper, channelKeeper types.ChannelKeeper) OutpostIBCModule {
+	return OutpostIBCModule{keeper: k, channelKeeper: channelKeeper}
+}
+
+// validateChannel checks the parameters shared by both ends of a channel
+// handshake.
+func (im OutpostIBCModule) validateChannel(ctx sdk.Context, order channeltypes.Order, connectionHops []string, portID string) error {
+	if portID != types.OutpostPortID {
+		return errorsmod.Wrapf(porttypes.ErrInvalidPort, "expected port %s, got %s", types.OutpostPortID, portID)
+	}
+	if order != channeltypes.UNORDERED {
+		return errorsmod.Wrapf(channeltypes.ErrInvalidChannelOrdering, "expected %s channel, got %s", channeltypes.UNORDERED, order)
+	}
+	return im.requireOutpostConnection(ctx, connectionHops[0])
+}
+
+// requireOutpostConnection returns an unauthorized error unless the
+// connection is trusted to report Outpost payments.
+func (im OutpostIBCModule) requireOutpostConnection(ctx sdk.Context, connectionID string) error {
+	params, err := im.keeper.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	if !params.IsOutpostConnection(connectionID) {
+		return errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "connection %s is not an outpost connection", connectionID)
+	}
+	return nil
+}
+
+// OnChanOpenInit implements the IBCModule interface
+func (im OutpostIBCModule) OnChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	counterparty channeltypes.Counterparty,
+	version string,
+) (string, error) {
+	if err := im.validateChannel(ctx, order, connectionHops, portID); err != nil {
+		return "", err
+	}
+	if version == "" {
+		version = types.OutpostVersion
+	}
+	if version != types.OutpostVersion {
+		return "", errorsmod.Wrapf(ibcerrors.ErrInvalidVersion, "expected %s, got %s", types.OutpostVersion, version)
+	}
+	return version, nil
+}
+
+// OnChanOpenTry implements the IBCModule interface
+func (im OutpostIBCModule) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID,
+	channelID string,
+	counterparty channeltypes.Counterparty,
+	counterpartyVersion string,
+) (string, error) {
+	if err := im.validateChannel(ctx, order, connectionHops, portID); err != nil {
+		return "", err
+	}
+	if counterpartyVersion != types.OutpostVersion {
+		return "", errorsmod.Wrapf(ibcerrors.ErrInvalidVersion, "expected counterparty version %s, got %s", types.OutpostVersion, counterpartyVersion)
+	}
+	return types.OutpostVersion, nil
+}
+
+// OnChanOpenAck implements the IBCModule interface
+func (im OutpostIBCModule) OnChanOpenAck(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	counterpartyChannelID string,
+	counterpartyVersion string,
+) error {
+	if counterpartyVersion != types.OutpostVersion {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidVersion, "expected counterparty version %s, got %s", types.OutpostVersion, counterpartyVersion)
+	}
+	return nil
+}
+
+// OnChanOpenConfirm implements the IBCModule interface
+func (im OutpostIBCModule) OnChanOpenConfirm(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return nil
+}
+
+// OnChanCloseInit implements the IBCModule interface
+func (im OutpostIBCModule) OnChanCloseInit(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return errorsmod.Wrap(ibcerrors.ErrInvalidRequest, "user cannot close channel")
+}
+
+// OnChanCloseConfirm implements the IBCModule interface
+func (im OutpostIBCModule) OnChanCloseConfirm(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return nil
+}
+
+// OnRecvPacket implements the IBCModule interface. The payment is checked
+// against the price of the stream, and the acknowledgement carries the
+// resulting OutpostPaymentAcknowledgement or the reason it was refused.
+func (im OutpostIBCModule) OnRecvPacket(
+	ctx sdk.Context,
+	channelVersion string,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	// The connection may have been removed from the params since the channel
+	// was opened.
+	channel, found := im.channelKeeper.GetChannel(ctx, packet.DestinationPort, packet.DestinationChannel)
+	if !found {
+		return channeltypes.NewErrorAcknowledgement(errorsmod.Wrapf(channeltypes.ErrChannelNotFound, "port %s, channel %s", packet.DestinationPort, packet.DestinationChannel))
+	}
+	if err := im.requireOutpostConnection(ctx, channel.ConnectionHops[0]); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	var data types.OutpostPaymentPacketData
+	if err := json.Unmarshal(packet.GetData(), &data); err != nil {
+		return channeltypes.NewErrorAcknowledgement(errorsmod.Wrapf(ibcerrors.ErrInvalidType, "cannot unmarshal outpost payment packet data: %s", err))
+	}
+
+	res, err := im.keeper.OnOutpostPayment(ctx, packet.DestinationChannel, data)
+	if err != nil {
+		im.keeper.Logger(ctx).Error("outpost payment refused", "channel", packet.DestinationChannel, "sequence", packet.Sequence, "error", err)
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+	return channeltypes.NewResultAcknowledgement(res.GetBytes())
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface
+func (im OutpostIBCModule) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	channelVersion string,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	return fmt.Errorf("the %s port does not send packets", types.OutpostPortID)
+}
+
+// OnTimeoutPacket implements the IBCModule interface
+func (im OutpostIBCModule) OnTimeoutPacket(
+	ctx sdk.Context,
+	channelVersion string,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	return fmt.Errorf("the %s port does not send packets", types.OutpostPortID)
+}
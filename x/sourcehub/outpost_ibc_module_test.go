@@ -0,0 +1,195 @@
+package sourcehub_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	dbm "github.com/cosmos/cosmos-db"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+	"github.com/stretchr/testify/require"
+
+	"github.com/shinzonetwork/shinzohub/app"
+	"github.com/shinzonetwork/shinzohub/x/sourcehub/keeper"
+	"github.com/shinzonetwork/shinzohub/x/sourcehub/types"
+)
+
+var testPrice = sdk.NewCoins(sdk.NewInt64Coin("uatom", 100))
+
+// setupShinzoHub returns a ShinzoHub app for ibc-go's testing package. The
+// EVM base fee is disabled, the test chains send transactions without fees.
+func setupShinzoHub(t *testing.T) ibctesting.AppCreator {
+	return func() (ibctesting.TestingApp, map[string]json.RawMessage) {
+		chainApp := app.NewChainApp(
+			log.NewNopLogger(), dbm.NewMemDB(), nil, true,
+			simtestutil.NewAppOptionsWithFlagHome(t.TempDir()),
+			app.ChainID18Decimals, app.EVMAppOptions,
+		)
+
+		genesis := chainApp.DefaultGenesis()
+		var feemarket feemarkettypes.GenesisState
+		chainApp.AppCodec().MustUnmarshalJSON(genesis[feemarkettypes.ModuleName], &feemarket)
+		feemarket.Params.NoBaseFee = true
+		genesis[feemarkettypes.ModuleName] = chainApp.AppCodec().MustMarshalJSON(&feemarket)
+
+		return chainApp, genesis
+	}
+}
+
+// setupOutpostPath connects a ShinzoHub chain to a mock Outpost chain, which
+// sends arbitrary packets from the mock IBC application of ibc-go's SimApp.
+// The path is ready to open an outpost channel once the connection is trusted.
+func setupOutpostPath(t *testing.T) (*ibctesting.Path, *app.ChainApp) {
+	t.Helper()
+
+	coord := &ibctesting.Coordinator{T: t, CurrentTime: time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)}
+	shinzohub := ibctesting.NewCustomAppTestChain(t, coord, ibctesting.GetChainID(1), setupShinzoHub(t))
+	outpost := ibctesting.NewTestChain(t, coord, ibctesting.GetChainID(2))
+	coord.Chains = map[string]*ibctesting.TestChain{shinzohub.ChainID: shinzohub, outpost.ChainID: outpost}
+
+	path := ibctesting.NewPath(shinzohub, outpost)
+	path.EndpointA.ChannelConfig.PortID = types.OutpostPortID
+	path.EndpointA.ChannelConfig.Version = types.OutpostVersion
+	path.EndpointB.ChannelConfig.PortID = ibctesting.MockPort
+	path.EndpointB.ChannelConfig.Version = types.OutpostVersion
+	path.SetupConnections()
+
+	return path, shinzohub.App.(*app.ChainApp)
+}
+
+// trustOutpost lists the connection of the path as an outpost connection, and
+// prices view-1 and registers a SourceHub target with an interchain account
+// to grant access to it.
+func trustOutpost(t *testing.T, path *ibctesting.Path, chainApp *app.ChainApp) {
+	t.Helper()
+
+	ctx := path.EndpointA.Chain.GetContext()
+	k := chainApp.SourcehubKeeper
+
+	params, err := k.GetParams(ctx)
+	require.NoError(t, err)
+	params.OutpostConnections = []string{path.EndpointA.ConnectionID}
+	k.SetParams(ctx, params)
+
+	target := types.NewSourcehubTarget("sourcehub-dev", "connection-9", "connection-9")
+	target.PolicyId = "policy-1"
+	require.NoError(t, k.SetTarget(ctx, target))
+	require.NoError(t, k.SetDefaultTarget(ctx, target.ChainId))
+	chainApp.ICAControllerKeeper.SetInterchainAccountAddress(ctx, target.ControllerConnectionId, k.GetICAPortID(), "source1ica")
+
+	require.NoError(t, k.Views.Set(ctx, "view-1", types.View{Id: "view-1", Target: target.ChainId}))
+	_, err = keeper.NewMsgServerImpl(k).SetStreamPrice(ctx, &types.MsgSetStreamPrice{
+		Signer:   k.GetAuthority(),
+		Resource: types.Resource_RESOURCE_VIEW,
+		StreamId: "view-1",
+		Amount:   testPrice,
+		Duration: uint64((30 * 24 * time.Hour).Seconds()),
+	})
+	require.NoError(t, err)
+
+	path.EndpointA.Chain.NextBlock()
+}
+
+// openOutpostChannel opens a channel from the Outpost end of the path, as
+// Outposts do.
+func openOutpostChannel(t *testing.T, path *ibctesting.Path) {
+	t.Helper()
+
+	require.NoError(t, path.EndpointB.ChanOpenInit())
+	require.NoError(t, path.EndpointA.ChanOpenTry())
+	require.NoError(t, path.EndpointB.ChanOpenAck())
+	require.NoError(t, path.EndpointA.ChanOpenConfirm())
+}
+
+// sendPayment sends a payment packet from the Outpost and relays it, and
+// returns the acknowledgement written by ShinzoHub.
+func sendPayment(t *testing.T, path *ibctesting.Path, data types.OutpostPaymentPacketData) channeltypes.Acknowledgement {
+	t.Helper()
+
+	timeout := uint64(path.EndpointB.Chain.ProposedHeader.Time.Add(time.Hour).UnixNano())
+	sequence, err := path.EndpointB.SendPacket(clienttypes.ZeroHeight(), timeout, data.GetBytes())
+	require.NoError(t, err)
+
+	packet := channeltypes.NewPacket(data.GetBytes(), sequence,
+		path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID,
+		path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID,
+		clienttypes.ZeroHeight(), timeout)
+	_, bz, err := path.RelayPacketWithResults(packet)
+	require.NoError(t, err)
+
+	var ack channeltypes.Acknowledgement
+	require.NoError(t, channeltypes.SubModuleCdc.UnmarshalJSON(bz, &ack))
+	return ack
+}
+
+func TestOutpostPaymentGrantsAccess(t *testing.T) {
+	path, chainApp := setupOutpostPath(t)
+	trustOutpost(t, path, chainApp)
+	openOutpostChannel(t, path)
+
+	expiry := uint64(path.EndpointA.Chain.ProposedHeader.Time.Add(24 * time.Hour).Unix())
+	payment := types.OutpostPaymentPacketData{
+		Did:      "did:key:alice",
+		Resource: types.ViewResourceName,
+		StreamId: "view-1",
+		Amount:   testPrice,
+		Expiry:   expiry,
+		Payer:    "0xpayer",
+	}
+	ack := sendPayment(t, path, payment)
+	require.True(t, ack.Success(), ack.GetError())
+
+	var res types.OutpostPaymentAcknowledgement
+	require.NoError(t, json.Unmarshal(ack.GetResult(), &res))
+	require.Equal(t, types.OutpostPaymentAcknowledgement{Expiration: expiry}, res)
+
+	ctx := path.EndpointA.Chain.GetContext()
+	k := chainApp.SourcehubKeeper
+	grant, found := k.GetGrant(ctx, "", types.Resource_RESOURCE_VIEW, "view-1", "did:key:alice")
+	require.True(t, found)
+	require.Equal(t, expiry, grant.Expiration)
+	require.Equal(t, "sourcehub-dev", grant.Target)
+	r, found := k.GetRelationship(ctx, "", types.ViewResourceName, "view-1", "subscriber", "did:key:alice")
+	require.True(t, found)
+	require.Equal(t, types.RelationshipStatus_RELATIONSHIP_STATUS_PENDING, r.Status)
+
+	// A payment below the price is refused and grants nothing.
+	payment.Did = "did:key:bob"
+	payment.Amount = sdk.NewCoins(sdk.NewInt64Coin("uatom", 99))
+	ack = sendPayment(t, path, payment)
+	require.False(t, ack.Success())
+	_, found = k.GetGrant(path.EndpointA.Chain.GetContext(), "", types.Resource_RESOURCE_VIEW, "view-1", "did:key:bob")
+	require.False(t, found)
+
+	// Payments stop being accepted once the connection is no longer trusted.
+	ctx = path.EndpointA.Chain.GetContext()
+	params, err := k.GetParams(ctx)
+	require.NoError(t, err)
+	params.OutpostConnections = nil
+	k.SetParams(ctx, params)
+	path.EndpointA.Chain.NextBlock()
+
+	payment.Amount = testPrice
+	ack = sendPayment(t, path, payment)
+	require.False(t, ack.Success())
+}
+
+func TestOutpostChannelRequiresTrustedConnection(t *testing.T) {
+	path, _ := setupOutpostPath(t)
+
+	require.NoError(t, path.EndpointB.ChanOpenInit())
+	require.ErrorContains(t, path.EndpointA.ChanOpenTry(), "connection-0 is not an outpost connection")
+
+	// The channel version must match as well.
+	path, chainApp := setupOutpostPath(t)
+	trustOutpost(t, path, chainApp)
+	path.EndpointB.ChannelConfig.Version = "ics20-1"
+	require.NoError(t, path.EndpointB.ChanOpenInit())
+	require.ErrorContains(t, path.EndpointA.ChanOpenTry(), "expected counterparty version shinzo-outpost-1")
+}
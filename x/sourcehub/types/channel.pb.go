@@ -0,0 +1,433 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: shinzonetwork/sourcehub/v1/channel.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type IcaChannelState int32
+
+const (
+	IcaChannelState_ICA_CHANNEL_STATE_UNSPECIFIED IcaChannelState = 0
+	// The channel handshake was started and has not completed yet
+	IcaChannelState_ICA_CHANNEL_STATE_OPENING IcaChannelState = 1
+	IcaChannelState_ICA_CHANNEL_STATE_OPEN    IcaChannelState = 2
+	// The channel was closed, e.g. by a packet timeout on the ORDERED channel
+	IcaChannelState_ICA_CHANNEL_STATE_CLOSED IcaChannelState = 3
+)
+
+var IcaChannelState_name = map[int32]string{
+	0: "ICA_CHANNEL_STATE_UNSPECIFIED",
+	1: "ICA_CHANNEL_STATE_OPENING",
+	2: "ICA_CHANNEL_STATE_OPEN",
+	3: "ICA_CHANNEL_STATE_CLOSED",
+}
+
+var IcaChannelState_value = map[string]int32{
+	"ICA_CHANNEL_STATE_UNSPECIFIED": 0,
+	"ICA_CHANNEL_STATE_OPENING":     1,
+	"ICA_CHANNEL_STATE_OPEN":        2,
+	"ICA_CHANNEL_STATE_CLOSED":      3,
+}
+
+func (x IcaChannelState) String() string {
+	return proto.EnumName(IcaChannelState_name, int32(x))
+}
+
+func (IcaChannelState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ba229c43d289c72b, []int{0}
+}
+
+// IcaChannel is the state of the module's ICA channel to SourceHub.
+type IcaChannel struct {
+	// Channel ID of the current or last channel
+	ChannelId string          `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	State     IcaChannelState `protobuf:"varint,2,opt,name=state,proto3,enum=shinzonetwork.sourcehub.v1.IcaChannelState" json:"state,omitempty"`
+	// Block height of the last state change
+	UpdatedHeight int64 `protobuf:"varint,3,opt,name=updated_height,json=updatedHeight,proto3" json:"updated_height,omitempty"`
+}
+
+func (m *IcaChannel) Reset()         { *m = IcaChannel{} }
+func (m *IcaChannel) String() string { return proto.CompactTextString(m) }
+func (*IcaChannel) ProtoMessage()    {}
+func (*IcaChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ba229c43d289c72b, []int{0}
+}
+func (m *IcaChannel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IcaChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IcaChannel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IcaChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IcaChannel.Merge(m, src)
+}
+func (m *IcaChannel) XXX_Size() int {
+	return m.Size()
+}
+func (m *IcaChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_IcaChannel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IcaChannel proto.InternalMessageInfo
+
+func (m *IcaChannel) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *IcaChannel) GetState() IcaChannelState {
+	if m != nil {
+		return m.State
+	}
+	return IcaChannelState_ICA_CHANNEL_STATE_UNSPECIFIED
+}
+
+func (m *IcaChannel) GetUpdatedHeight() int64 {
+	if m != nil {
+		return m.UpdatedHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("shinzonetwork.sourcehub.v1.IcaChannelState", IcaChannelState_name, IcaChannelState_value)
+	proto.RegisterType((*IcaChannel)(nil), "shinzonetwork.sourcehub.v1.IcaChannel")
+}
+
+func init() {
+	proto.RegisterFile("shinzonetwork/sourcehub/v1/channel.proto", fileDescriptor_ba229c43d289c72b)
+}
+
+var fileDescriptor_ba229c43d289c72b = []byte{
+	// 307 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x28, 0xce, 0xc8, 0xcc,
+	0xab, 0xca, 0xcf, 0x4b, 0x2d, 0x29, 0xcf, 0x2f, 0xca, 0xd6, 0x2f, 0xce, 0x2f, 0x2d, 0x4a, 0x4e,
+	0xcd, 0x28, 0x4d, 0xd2, 0x2f, 0x33, 0xd4, 0x4f, 0xce, 0x48, 0xcc, 0xcb, 0x4b, 0xcd, 0xd1, 0x2b,
+	0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x42, 0x51, 0xa9, 0x07, 0x57, 0xa9, 0x57, 0x66, 0xa8, 0x34,
+	0x95, 0x91, 0x8b, 0xcb, 0x33, 0x39, 0xd1, 0x19, 0xa2, 0x41, 0x48, 0x96, 0x8b, 0x0b, 0xaa, 0x37,
+	0x3e, 0x33, 0x45, 0x82, 0x51, 0x81, 0x51, 0x83, 0x33, 0x88, 0x13, 0x2a, 0xe2, 0x99, 0x22, 0xe4,
+	0xc8, 0xc5, 0x5a, 0x5c, 0x92, 0x58, 0x92, 0x2a, 0xc1, 0xa4, 0xc0, 0xa8, 0xc1, 0x67, 0xa4, 0xad,
+	0x87, 0xdb, 0x64, 0x3d, 0x84, 0xa9, 0xc1, 0x20, 0x2d, 0x41, 0x10, 0x9d, 0x42, 0xaa, 0x5c, 0x7c,
+	0xa5, 0x05, 0x29, 0x89, 0x25, 0xa9, 0x29, 0xf1, 0x19, 0xa9, 0x99, 0xe9, 0x19, 0x25, 0x12, 0xcc,
+	0x0a, 0x8c, 0x1a, 0xcc, 0x41, 0xbc, 0x50, 0x51, 0x0f, 0xb0, 0xa0, 0x56, 0x2f, 0x23, 0x17, 0x3f,
+	0x9a, 0x09, 0x42, 0x8a, 0x5c, 0xb2, 0x9e, 0xce, 0x8e, 0xf1, 0xce, 0x1e, 0x8e, 0x7e, 0x7e, 0xae,
+	0x3e, 0xf1, 0xc1, 0x21, 0x8e, 0x21, 0xae, 0xf1, 0xa1, 0x7e, 0xc1, 0x01, 0xae, 0xce, 0x9e, 0x6e,
+	0x9e, 0xae, 0x2e, 0x02, 0x0c, 0x42, 0xb2, 0x5c, 0x92, 0x98, 0x4a, 0xfc, 0x03, 0x5c, 0xfd, 0x3c,
+	0xfd, 0xdc, 0x05, 0x18, 0x85, 0xa4, 0xb8, 0xc4, 0xb0, 0x4b, 0x0b, 0x30, 0x09, 0xc9, 0x70, 0x49,
+	0x60, 0xca, 0x39, 0xfb, 0xf8, 0x07, 0xbb, 0xba, 0x08, 0x30, 0x3b, 0xf9, 0x9d, 0x78, 0x24, 0xc7,
+	0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c,
+	0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x49, 0x7a, 0x66, 0x09, 0xc8, 0xcb, 0xc9, 0xf9, 0xb9,
+	0xfa, 0x68, 0x51, 0x02, 0xe6, 0x81, 0xa2, 0xa4, 0x02, 0x29, 0x7a, 0x4a, 0x2a, 0x0b, 0x52, 0x8b,
+	0x93, 0xd8, 0xc0, 0x51, 0x63, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0xf8, 0x58, 0xe3, 0x8d, 0xc6,
+	0x01, 0x00, 0x00,
+}
+
+func (m *IcaChannel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IcaChannel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IcaChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UpdatedHeight != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.UpdatedHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.State != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintChannel(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintChannel(dAtA []byte, offset int, v uint64) int {
+	offset -= sovChannel(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *IcaChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovChannel(uint64(l))
+	}
+	if m.State != 0 {
+		n += 1 + sovChannel(uint64(m.State))
+	}
+	if m.UpdatedHeight != 0 {
+		n += 1 + sovChannel(uint64(m.UpdatedHeight))
+	}
+	return n
+}
+
+func sovChannel(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozChannel(x uint64) (n int) {
+	return sovChannel(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *IcaChannel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IcaChannel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IcaChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= IcaChannelState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedHeight", wireType)
+			}
+			m.UpdatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipChannel(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowChannel
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthChannel
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupChannel
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthChannel
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthChannel        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowChannel          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupChannel = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,2168 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: shinzonetwork/sourcehub/v1/entity.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EntityRole is the role an entity registers for through the EntityRegistry
+// precompile.
+type EntityRole int32
+
+const (
+	EntityRole_ENTITY_ROLE_INDEXER EntityRole = 0
+	EntityRole_ENTITY_ROLE_HOST    EntityRole = 1
+)
+
+var EntityRole_name = map[int32]string{
+	0: "ENTITY_ROLE_INDEXER",
+	1: "ENTITY_ROLE_HOST",
+}
+
+var EntityRole_value = map[string]int32{
+	"ENTITY_ROLE_INDEXER": 0,
+	"ENTITY_ROLE_HOST":    1,
+}
+
+func (x EntityRole) String() string {
+	return proto.EnumName(EntityRole_name, int32(x))
+}
+
+func (EntityRole) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_c4123c93884aa35d, []int{0}
+}
+
+// EntityStatus tracks the group membership of an entity on SourceHub.
+type EntityStatus int32
+
+const (
+	EntityStatus_ENTITY_STATUS_UNSPECIFIED EntityStatus = 0
+	// The group guest relationship was sent and is awaiting acknowledgement
+	EntityStatus_ENTITY_STATUS_PENDING EntityStatus = 1
+	// SourceHub acknowledged the group guest relationship
+	EntityStatus_ENTITY_STATUS_ACTIVE EntityStatus = 2
+	// SourceHub rejected the relationship or the packet timed out
+	EntityStatus_ENTITY_STATUS_FAILED EntityStatus = 3
+	// An admin blocked the entity and removed its group membership
+	EntityStatus_ENTITY_STATUS_BLOCKED EntityStatus = 4
+)
+
+var EntityStatus_name = map[int32]string{
+	0: "ENTITY_STATUS_UNSPECIFIED",
+	1: "ENTITY_STATUS_PENDING",
+	2: "ENTITY_STATUS_ACTIVE",
+	3: "ENTITY_STATUS_FAILED",
+	4: "ENTITY_STATUS_BLOCKED",
+}
+
+var EntityStatus_value = map[string]int32{
+	"ENTITY_STATUS_UNSPECIFIED": 0,
+	"ENTITY_STATUS_PENDING":     1,
+	"ENTITY_STATUS_ACTIVE":      2,
+	"ENTITY_STATUS_FAILED":      3,
+	"ENTITY_STATUS_BLOCKED":     4,
+}
+
+func (x EntityStatus) String() string {
+	return proto.EnumName(EntityStatus_name, int32(x))
+}
+
+func (EntityStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_c4123c93884aa35d, []int{1}
+}
+
+// Entity is an indexer or host registered through the EntityRegistry precompile.
+type Entity struct {
+	// Account that registered the entity
+	Owner string     `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Role  EntityRole `protobuf:"varint,2,opt,name=role,proto3,enum=shinzonetwork.sourcehub.v1.EntityRole" json:"role,omitempty"`
+	// did:key derived from the node identity key
+	Did string `protobuf:"bytes,3,opt,name=did,proto3" json:"did,omitempty"`
+	// libp2p peer ID derived from the peer key
+	Pid string `protobuf:"bytes,4,opt,name=pid,proto3" json:"pid,omitempty"`
+	// ed25519 peer key
+	PeerKeyPubkey []byte `protobuf:"bytes,5,opt,name=peer_key_pubkey,json=peerKeyPubkey,proto3" json:"peer_key_pubkey,omitempty"`
+	// secp256k1 node identity key
+	NodeIdentityKeyPubkey []byte `protobuf:"bytes,6,opt,name=node_identity_key_pubkey,json=nodeIdentityKeyPubkey,proto3" json:"node_identity_key_pubkey,omitempty"`
+	// Message signed by both keys at registration
+	Message []byte `protobuf:"bytes,7,opt,name=message,proto3" json:"message,omitempty"`
+	// Block height the entity was registered at
+	Height int64        `protobuf:"varint,8,opt,name=height,proto3" json:"height,omitempty"`
+	Status EntityStatus `protobuf:"varint,9,opt,name=status,proto3,enum=shinzonetwork.sourcehub.v1.EntityStatus" json:"status,omitempty"`
+	// Chain ID of the SourceHub target holding the group membership
+	Target string `protobuf:"bytes,10,opt,name=target,proto3" json:"target,omitempty"`
+	// Bond locked in the module account while the entity is registered
+	Bond github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,11,rep,name=bond,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"bond"`
+}
+
+func (m *Entity) Reset()         { *m = Entity{} }
+func (m *Entity) String() string { return proto.CompactTextString(m) }
+func (*Entity) ProtoMessage()    {}
+func (*Entity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c4123c93884aa35d, []int{0}
+}
+func (m *Entity) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Entity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Entity.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Entity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Entity.Merge(m, src)
+}
+func (m *Entity) XXX_Size() int {
+	return m.Size()
+}
+func (m *Entity) XXX_DiscardUnknown() {
+	xxx_messageInfo_Entity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Entity proto.InternalMessageInfo
+
+func (m *Entity) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *Entity) GetRole() EntityRole {
+	if m != nil {
+		return m.Role
+	}
+	return EntityRole_ENTITY_ROLE_INDEXER
+}
+
+func (m *Entity) GetDid() string {
+	if m != nil {
+		return m.Did
+	}
+	return ""
+}
+
+func (m *Entity) GetPid() string {
+	if m != nil {
+		return m.Pid
+	}
+	return ""
+}
+
+func (m *Entity) GetPeerKeyPubkey() []byte {
+	if m != nil {
+		return m.PeerKeyPubkey
+	}
+	return nil
+}
+
+func (m *Entity) GetNodeIdentityKeyPubkey() []byte {
+	if m != nil {
+		return m.NodeIdentityKeyPubkey
+	}
+	return nil
+}
+
+func (m *Entity) GetMessage() []byte {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+func (m *Entity) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *Entity) GetStatus() EntityStatus {
+	if m != nil {
+		return m.Status
+	}
+	return EntityStatus_ENTITY_STATUS_UNSPECIFIED
+}
+
+func (m *Entity) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *Entity) GetBond() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Bond
+	}
+	return nil
+}
+
+// EntityUnbonding is the bond of a removed or blocked entity, released to its
+// owner once the unbonding period ends.
+type EntityUnbonding struct {
+	Owner  string                                   `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Role   EntityRole                               `protobuf:"varint,2,opt,name=role,proto3,enum=shinzonetwork.sourcehub.v1.EntityRole" json:"role,omitempty"`
+	Did    string                                   `protobuf:"bytes,3,opt,name=did,proto3" json:"did,omitempty"`
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// Unix time in seconds at which the bond is released
+	CompletionTime uint64 `protobuf:"varint,5,opt,name=completion_time,json=completionTime,proto3" json:"completion_time,omitempty"`
+}
+
+func (m *EntityUnbonding) Reset()         { *m = EntityUnbonding{} }
+func (m *EntityUnbonding) String() string { return proto.CompactTextString(m) }
+func (*EntityUnbonding) ProtoMessage()    {}
+func (*EntityUnbonding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c4123c93884aa35d, []int{1}
+}
+func (m *EntityUnbonding) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EntityUnbonding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EntityUnbonding.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EntityUnbonding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EntityUnbonding.Merge(m, src)
+}
+func (m *EntityUnbonding) XXX_Size() int {
+	return m.Size()
+}
+func (m *EntityUnbonding) XXX_DiscardUnknown() {
+	xxx_messageInfo_EntityUnbonding.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EntityUnbonding proto.InternalMessageInfo
+
+func (m *EntityUnbonding) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *EntityUnbonding) GetRole() EntityRole {
+	if m != nil {
+		return m.Role
+	}
+	return EntityRole_ENTITY_ROLE_INDEXER
+}
+
+func (m *EntityUnbonding) GetDid() string {
+	if m != nil {
+		return m.Did
+	}
+	return ""
+}
+
+func (m *EntityUnbonding) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *EntityUnbonding) GetCompletionTime() uint64 {
+	if m != nil {
+		return m.CompletionTime
+	}
+	return 0
+}
+
+// EntityCommand links an outbox command to the entity whose group membership
+// it carries.
+type EntityCommand struct {
+	CommandId uint64 `protobuf:"varint,1,opt,name=command_id,json=commandId,proto3" json:"command_id,omitempty"`
+	// Owner of the entity
+	Owner string     `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	Role  EntityRole `protobuf:"varint,3,opt,name=role,proto3,enum=shinzonetwork.sourcehub.v1.EntityRole" json:"role,omitempty"`
+}
+
+func (m *EntityCommand) Reset()         { *m = EntityCommand{} }
+func (m *EntityCommand) String() string { return proto.CompactTextString(m) }
+func (*EntityCommand) ProtoMessage()    {}
+func (*EntityCommand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c4123c93884aa35d, []int{2}
+}
+func (m *EntityCommand) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EntityCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EntityCommand.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EntityCommand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EntityCommand.Merge(m, src)
+}
+func (m *EntityCommand) XXX_Size() int {
+	return m.Size()
+}
+func (m *EntityCommand) XXX_DiscardUnknown() {
+	xxx_messageInfo_EntityCommand.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EntityCommand proto.InternalMessageInfo
+
+func (m *EntityCommand) GetCommandId() uint64 {
+	if m != nil {
+		return m.CommandId
+	}
+	return 0
+}
+
+func (m *EntityCommand) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *EntityCommand) GetRole() EntityRole {
+	if m != nil {
+		return m.Role
+	}
+	return EntityRole_ENTITY_ROLE_INDEXER
+}
+
+// RegistrationMessage is the payload signed by both entity keys to register
+// an entity or rotate its keys. It binds the signatures to one chain, caller
+// and role, and each message is accepted only once.
+type RegistrationMessage struct {
+	// Domain separator, always "shinzohub/entity-registration/v1"
+	Domain string `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	// Chain ID of the ShinzoHub network
+	ChainId string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// Account calling the EntityRegistry precompile
+	Address string     `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Role    EntityRole `protobuf:"varint,4,opt,name=role,proto3,enum=shinzonetwork.sourcehub.v1.EntityRole" json:"role,omitempty"`
+	// Caller chosen value that makes otherwise identical messages distinct
+	Nonce uint64 `protobuf:"varint,5,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// Last block height at which the message is accepted
+	ExpiryHeight int64 `protobuf:"varint,6,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+	// Chain ID of the SourceHub target to register on, the default target if
+	// empty. Ignored by key rotations, which stay on the entity's target.
+	Target string `protobuf:"bytes,7,opt,name=target,proto3" json:"target,omitempty"`
+}
+
+func (m *RegistrationMessage) Reset()         { *m = RegistrationMessage{} }
+func (m *RegistrationMessage) String() string { return proto.CompactTextString(m) }
+func (*RegistrationMessage) ProtoMessage()    {}
+func (*RegistrationMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c4123c93884aa35d, []int{3}
+}
+func (m *RegistrationMessage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegistrationMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegistrationMessage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RegistrationMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegistrationMessage.Merge(m, src)
+}
+func (m *RegistrationMessage) XXX_Size() int {
+	return m.Size()
+}
+func (m *RegistrationMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegistrationMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegistrationMessage proto.InternalMessageInfo
+
+func (m *RegistrationMessage) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *RegistrationMessage) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *RegistrationMessage) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *RegistrationMessage) GetRole() EntityRole {
+	if m != nil {
+		return m.Role
+	}
+	return EntityRole_ENTITY_ROLE_INDEXER
+}
+
+func (m *RegistrationMessage) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *RegistrationMessage) GetExpiryHeight() int64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
+func (m *RegistrationMessage) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+// UsedRegistrationMessage records a registration message that was accepted,
+// until its expiry height passes and it can no longer be replayed.
+type UsedRegistrationMessage struct {
+	// sha256 hash of the signed message
+	Hash []byte `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// Last block height at which the message is accepted
+	ExpiryHeight int64 `protobuf:"varint,2,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+}
+
+func (m *UsedRegistrationMessage) Reset()         { *m = UsedRegistrationMessage{} }
+func (m *UsedRegistrationMessage) String() string { return proto.CompactTextString(m) }
+func (*UsedRegistrationMessage) ProtoMessage()    {}
+func (*UsedRegistrationMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c4123c93884aa35d, []int{4}
+}
+func (m *UsedRegistrationMessage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UsedRegistrationMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UsedRegistrationMessage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UsedRegistrationMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UsedRegistrationMessage.Merge(m, src)
+}
+func (m *UsedRegistrationMessage) XXX_Size() int {
+	return m.Size()
+}
+func (m *UsedRegistrationMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_UsedRegistrationMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UsedRegistrationMessage proto.InternalMessageInfo
+
+func (m *UsedRegistrationMessage) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *UsedRegistrationMessage) GetExpiryHeight() int64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("shinzonetwork.sourcehub.v1.EntityRole", EntityRole_name, EntityRole_value)
+	proto.RegisterEnum("shinzonetwork.sourcehub.v1.EntityStatus", EntityStatus_name, EntityStatus_value)
+	proto.RegisterType((*Entity)(nil), "shinzonetwork.sourcehub.v1.Entity")
+	proto.RegisterType((*EntityUnbonding)(nil), "shinzonetwork.sourcehub.v1.EntityUnbonding")
+	proto.RegisterType((*EntityCommand)(nil), "shinzonetwork.sourcehub.v1.EntityCommand")
+	proto.RegisterType((*RegistrationMessage)(nil), "shinzonetwork.sourcehub.v1.RegistrationMessage")
+	proto.RegisterType((*UsedRegistrationMessage)(nil), "shinzonetwork.sourcehub.v1.UsedRegistrationMessage")
+}
+
+func init() {
+	proto.RegisterFile("shinzonetwork/sourcehub/v1/entity.proto", fileDescriptor_c4123c93884aa35d)
+}
+
+var fileDescriptor_c4123c93884aa35d = []byte{
+	// 818 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x55, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xf7, 0xda, 0x1b, 0xbb, 0x79, 0x4d, 0x9a, 0x65, 0xea, 0xd2, 0x75, 0xa4, 0xba, 0x96, 0x91,
+	0x5a, 0x2b, 0x52, 0x77, 0x95, 0x00, 0x42, 0x82, 0x0b, 0x89, 0xbd, 0xa5, 0xab, 0x06, 0x27, 0x1a,
+	0x3b, 0x08, 0xb8, 0xac, 0xd6, 0x9e, 0xd1, 0x7a, 0x94, 0xec, 0x8c, 0xb5, 0x33, 0x4e, 0x6b, 0xbe,
+	0x02, 0x17, 0x6e, 0x48, 0x1c, 0x39, 0x21, 0x4e, 0x1c, 0xf8, 0x10, 0x3d, 0x56, 0x9c, 0x38, 0x01,
+	0x4a, 0x0e, 0x1c, 0xf8, 0x12, 0x68, 0x67, 0x36, 0xb5, 0x43, 0xf8, 0xa3, 0x20, 0x21, 0x2e, 0xf6,
+	0xbc, 0xdf, 0x7b, 0x6f, 0xde, 0xef, 0xfd, 0xde, 0x3e, 0x0d, 0x3c, 0x94, 0x13, 0xc6, 0x3f, 0x13,
+	0x9c, 0xaa, 0x67, 0x22, 0x3b, 0xf6, 0xa5, 0x98, 0x65, 0x63, 0x3a, 0x99, 0x8d, 0xfc, 0xd3, 0x6d,
+	0x9f, 0x72, 0xc5, 0xd4, 0xdc, 0x9b, 0x66, 0x42, 0x09, 0xb4, 0x79, 0x29, 0xd0, 0x7b, 0x15, 0xe8,
+	0x9d, 0x6e, 0x6f, 0xbe, 0x16, 0xa7, 0x8c, 0x0b, 0x5f, 0xff, 0x9a, 0xf0, 0xcd, 0xe6, 0x58, 0xc8,
+	0x54, 0x48, 0x7f, 0x14, 0x4b, 0xea, 0x9f, 0x6e, 0x8f, 0xa8, 0x8a, 0xb7, 0xfd, 0xb1, 0x60, 0xbc,
+	0xf0, 0x37, 0x8c, 0x3f, 0xd2, 0x96, 0x6f, 0x8c, 0xc2, 0x55, 0x4f, 0x44, 0x22, 0x0c, 0x9e, 0x9f,
+	0x0c, 0xda, 0xfe, 0xad, 0x02, 0xd5, 0x40, 0x13, 0x42, 0x1e, 0xac, 0x88, 0x67, 0x9c, 0x66, 0xae,
+	0xd5, 0xb2, 0x3a, 0xab, 0x7b, 0xee, 0x0f, 0xdf, 0x3f, 0xaa, 0x17, 0x37, 0xec, 0x12, 0x92, 0x51,
+	0x29, 0x07, 0x2a, 0x63, 0x3c, 0xc1, 0x26, 0x0c, 0xbd, 0x0b, 0x76, 0x26, 0x4e, 0xa8, 0x5b, 0x6e,
+	0x59, 0x9d, 0x5b, 0x3b, 0x0f, 0xbc, 0xbf, 0xee, 0xc4, 0x33, 0x15, 0xb0, 0x38, 0xa1, 0x58, 0xe7,
+	0x20, 0x07, 0x2a, 0x84, 0x11, 0xb7, 0x92, 0x57, 0xc2, 0xf9, 0x31, 0x47, 0xa6, 0x8c, 0xb8, 0xb6,
+	0x41, 0xa6, 0x8c, 0xa0, 0x07, 0xb0, 0x31, 0xa5, 0x34, 0x8b, 0x8e, 0xe9, 0x3c, 0x9a, 0xce, 0x46,
+	0xc7, 0x74, 0xee, 0xae, 0xb4, 0xac, 0xce, 0x1a, 0x5e, 0xcf, 0xe1, 0xa7, 0x74, 0x7e, 0xa8, 0x41,
+	0xf4, 0x0e, 0xb8, 0x5c, 0x10, 0x1a, 0x31, 0x62, 0x94, 0x5d, 0x4e, 0xa8, 0xea, 0x84, 0x3b, 0xb9,
+	0x3f, 0x2c, 0xdc, 0x8b, 0x44, 0x17, 0x6a, 0x29, 0x95, 0x32, 0x4e, 0xa8, 0x5b, 0xd3, 0x71, 0x17,
+	0x26, 0x7a, 0x1d, 0xaa, 0x13, 0xca, 0x92, 0x89, 0x72, 0x6f, 0xb4, 0xac, 0x4e, 0x05, 0x17, 0x16,
+	0x7a, 0x1f, 0xaa, 0x52, 0xc5, 0x6a, 0x26, 0xdd, 0x55, 0xdd, 0x74, 0xe7, 0x9f, 0x9b, 0x1e, 0xe8,
+	0x78, 0x5c, 0xe4, 0xe5, 0x37, 0xab, 0x38, 0x4b, 0xa8, 0x72, 0x41, 0x77, 0x5a, 0x58, 0x88, 0x80,
+	0x3d, 0x12, 0x9c, 0xb8, 0x37, 0x5b, 0x95, 0xce, 0xcd, 0x9d, 0x86, 0x57, 0x08, 0x9f, 0xcf, 0xd9,
+	0x2b, 0xe6, 0xec, 0x75, 0x05, 0xe3, 0x7b, 0x6f, 0xbf, 0xf8, 0xe9, 0x7e, 0xe9, 0xdb, 0x9f, 0xef,
+	0x77, 0x12, 0xa6, 0xf2, 0x42, 0x63, 0x91, 0x16, 0x73, 0x2e, 0xfe, 0x1e, 0x49, 0x72, 0xec, 0xab,
+	0xf9, 0x94, 0x4a, 0x9d, 0x20, 0xbf, 0xf9, 0xf5, 0xbb, 0x2d, 0x0b, 0xeb, 0xdb, 0xdb, 0x5f, 0x97,
+	0x61, 0xc3, 0xd0, 0x3a, 0xe2, 0x39, 0xc0, 0x78, 0xf2, 0x3f, 0x8f, 0x7d, 0x02, 0xd5, 0x38, 0x15,
+	0x33, 0xae, 0x5c, 0xfb, 0x3f, 0xea, 0xbc, 0xb8, 0x1f, 0x3d, 0x84, 0x8d, 0xb1, 0x48, 0xa7, 0x27,
+	0x54, 0x31, 0xc1, 0x23, 0xc5, 0x52, 0xaa, 0x3f, 0x27, 0x1b, 0xdf, 0x5a, 0xc0, 0x43, 0x96, 0xd2,
+	0xf6, 0x57, 0x16, 0xac, 0x1b, 0xe6, 0x5d, 0x91, 0xa6, 0x31, 0x27, 0xe8, 0x1e, 0xc0, 0xd8, 0x1c,
+	0x23, 0x46, 0xb4, 0x4e, 0x36, 0x5e, 0x2d, 0x90, 0x90, 0x2c, 0x14, 0x2c, 0x5f, 0x4f, 0xc1, 0xca,
+	0xf5, 0x15, 0x6c, 0x7f, 0x5e, 0x86, 0xdb, 0x98, 0x26, 0x4c, 0xaa, 0x2c, 0xce, 0x19, 0x7f, 0xb8,
+	0xf8, 0x62, 0x89, 0x48, 0x63, 0xc6, 0xcd, 0x18, 0x71, 0x61, 0xa1, 0x06, 0xdc, 0x18, 0x4f, 0x62,
+	0xc6, 0x73, 0xe2, 0x9a, 0x1e, 0xae, 0x69, 0x3b, 0x24, 0x68, 0x07, 0x6a, 0xb1, 0xa1, 0x67, 0x06,
+	0xf2, 0x37, 0xc4, 0x2f, 0x02, 0x5f, 0x51, 0xb7, 0xff, 0xc5, 0xf0, 0xeb, 0xb0, 0xc2, 0x05, 0x1f,
+	0x5f, 0xc8, 0x6e, 0x0c, 0xf4, 0x06, 0xac, 0xd3, 0xe7, 0x53, 0x96, 0xcd, 0xa3, 0x62, 0xe3, 0xaa,
+	0x7a, 0xe3, 0xd6, 0x0c, 0xf8, 0xc4, 0xec, 0xdd, 0x62, 0x6b, 0x6a, 0xcb, 0x5b, 0xd3, 0xc6, 0x70,
+	0xf7, 0x48, 0x52, 0xf2, 0x67, 0x82, 0x20, 0xb0, 0x27, 0xb1, 0x9c, 0x68, 0x39, 0xd6, 0xb0, 0x3e,
+	0x5f, 0xad, 0x55, 0xbe, 0x5a, 0x6b, 0xeb, 0x3d, 0x80, 0x05, 0x75, 0x74, 0x17, 0x6e, 0x07, 0xfd,
+	0x61, 0x38, 0xfc, 0x24, 0xc2, 0x07, 0xfb, 0x41, 0x14, 0xf6, 0x7b, 0xc1, 0xc7, 0x01, 0x76, 0x4a,
+	0xa8, 0x0e, 0xce, 0xb2, 0xe3, 0xc9, 0xc1, 0x60, 0xe8, 0x58, 0x5b, 0x5f, 0x5a, 0xb0, 0xb6, 0xbc,
+	0xf7, 0xe8, 0x1e, 0x34, 0x8a, 0xb0, 0xc1, 0x70, 0x77, 0x78, 0x34, 0x88, 0x8e, 0xfa, 0x83, 0xc3,
+	0xa0, 0x1b, 0x3e, 0x0e, 0x83, 0x9e, 0x53, 0x42, 0x0d, 0xb8, 0x73, 0xd9, 0x7d, 0x18, 0xf4, 0x7b,
+	0x61, 0xff, 0x03, 0xc7, 0x42, 0x2e, 0xd4, 0x2f, 0xbb, 0x76, 0xbb, 0xc3, 0xf0, 0xa3, 0xc0, 0x29,
+	0x5f, 0xf5, 0x3c, 0xde, 0x0d, 0xf7, 0x83, 0x9e, 0x53, 0xb9, 0x7a, 0xdd, 0xde, 0xfe, 0x41, 0xf7,
+	0x69, 0xd0, 0x73, 0xec, 0xbd, 0xfe, 0x8b, 0xb3, 0xa6, 0xf5, 0xf2, 0xac, 0x69, 0xfd, 0x72, 0xd6,
+	0xb4, 0xbe, 0x38, 0x6f, 0x96, 0x5e, 0x9e, 0x37, 0x4b, 0x3f, 0x9e, 0x37, 0x4b, 0x9f, 0xbe, 0xb5,
+	0xb4, 0x4f, 0x7f, 0x78, 0xb6, 0xb4, 0x95, 0x3f, 0x5b, 0xcf, 0x97, 0x9e, 0x30, 0xbd, 0x61, 0xa3,
+	0xaa, 0x7e, 0x3f, 0xde, 0xfc, 0x3d, 0x00, 0x00, 0xff, 0xff, 0x9d, 0xe7, 0x3c, 0x94, 0xea, 0x06,
+	0x00, 0x00,
+}
+
+func (m *Entity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Entity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Entity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Bond) > 0 {
+		for iNdEx := len(m.Bond) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bond[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEntity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.Target) > 0 {
+		i -= len(m.Target)
+		copy(dAtA[i:], m.Target)
+		i = encodeVarintEntity(dAtA, i, uint64(len(m.Target)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Status != 0 {
+		i = encodeVarintEntity(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Height != 0 {
+		i = encodeVarintEntity(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintEntity(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.NodeIdentityKeyPubkey) > 0 {
+		i -= len(m.NodeIdentityKeyPubkey)
+		copy(dAtA[i:], m.NodeIdentityKeyPubkey)
+		i = encodeVarintEntity(dAtA, i, uint64(len(m.NodeIdentityKeyPubkey)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.PeerKeyPubkey) > 0 {
+		i -= len(m.PeerKeyPubkey)
+		copy(dAtA[i:], m.PeerKeyPubkey)
+		i = encodeVarintEntity(dAtA, i, uint64(len(m.PeerKeyPubkey)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Pid) > 0 {
+		i -= len(m.Pid)
+		copy(dAtA[i:], m.Pid)
+		i = encodeVarintEntity(dAtA, i, uint64(len(m.Pid)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintEntity(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Role != 0 {
+		i = encodeVarintEntity(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintEntity(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EntityUnbonding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EntityUnbonding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EntityUnbonding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CompletionTime != 0 {
+		i = encodeVarintEntity(dAtA, i, uint64(m.CompletionTime))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEntity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintEntity(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Role != 0 {
+		i = encodeVarintEntity(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintEntity(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EntityCommand) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EntityCommand) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EntityCommand) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Role != 0 {
+		i = encodeVarintEntity(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintEntity(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CommandId != 0 {
+		i = encodeVarintEntity(dAtA, i, uint64(m.CommandId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RegistrationMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegistrationMessage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegistrationMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Target) > 0 {
+		i -= len(m.Target)
+		copy(dAtA[i:], m.Target)
+		i = encodeVarintEntity(dAtA, i, uint64(len(m.Target)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintEntity(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintEntity(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Role != 0 {
+		i = encodeVarintEntity(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEntity(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintEntity(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintEntity(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UsedRegistrationMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UsedRegistrationMessage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UsedRegistrationMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintEntity(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintEntity(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEntity(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEntity(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Entity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovEntity(uint64(l))
+	}
+	if m.Role != 0 {
+		n += 1 + sovEntity(uint64(m.Role))
+	}
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovEntity(uint64(l))
+	}
+	l = len(m.Pid)
+	if l > 0 {
+		n += 1 + l + sovEntity(uint64(l))
+	}
+	l = len(m.PeerKeyPubkey)
+	if l > 0 {
+		n += 1 + l + sovEntity(uint64(l))
+	}
+	l = len(m.NodeIdentityKeyPubkey)
+	if l > 0 {
+		n += 1 + l + sovEntity(uint64(l))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovEntity(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovEntity(uint64(m.Height))
+	}
+	if m.Status != 0 {
+		n += 1 + sovEntity(uint64(m.Status))
+	}
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovEntity(uint64(l))
+	}
+	if len(m.Bond) > 0 {
+		for _, e := range m.Bond {
+			l = e.Size()
+			n += 1 + l + sovEntity(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EntityUnbonding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovEntity(uint64(l))
+	}
+	if m.Role != 0 {
+		n += 1 + sovEntity(uint64(m.Role))
+	}
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovEntity(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovEntity(uint64(l))
+		}
+	}
+	if m.CompletionTime != 0 {
+		n += 1 + sovEntity(uint64(m.CompletionTime))
+	}
+	return n
+}
+
+func (m *EntityCommand) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CommandId != 0 {
+		n += 1 + sovEntity(uint64(m.CommandId))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovEntity(uint64(l))
+	}
+	if m.Role != 0 {
+		n += 1 + sovEntity(uint64(m.Role))
+	}
+	return n
+}
+
+func (m *RegistrationMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovEntity(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovEntity(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEntity(uint64(l))
+	}
+	if m.Role != 0 {
+		n += 1 + sovEntity(uint64(m.Role))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovEntity(uint64(m.Nonce))
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovEntity(uint64(m.ExpiryHeight))
+	}
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovEntity(uint64(l))
+	}
+	return n
+}
+
+func (m *UsedRegistrationMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovEntity(uint64(l))
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovEntity(uint64(m.ExpiryHeight))
+	}
+	return n
+}
+
+func sovEntity(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEntity(x uint64) (n int) {
+	return sovEntity(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Entity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEntity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Entity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Entity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEntity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEntity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= EntityRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEntity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEntity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEntity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEntity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerKeyPubkey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEntity
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEntity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeerKeyPubkey = append(m.PeerKeyPubkey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PeerKeyPubkey == nil {
+				m.PeerKeyPubkey = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeIdentityKeyPubkey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEntity
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEntity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeIdentityKeyPubkey = append(m.NodeIdentityKeyPubkey[:0], dAtA[iNdEx:postIndex]...)
+			if m.NodeIdentityKeyPubkey == nil {
+				m.NodeIdentityKeyPubkey = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEntity
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEntity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = append(m.Message[:0], dAtA[iNdEx:postIndex]...)
+			if m.Message == nil {
+				m.Message = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= EntityStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEntity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEntity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Target = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bond", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEntity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEntity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bond = append(m.Bond, types.Coin{})
+			if err := m.Bond[len(m.Bond)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEntity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEntity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EntityUnbonding) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEntity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EntityUnbonding: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EntityUnbonding: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEntity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEntity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= EntityRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEntity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEntity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEntity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEntity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			m.CompletionTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompletionTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEntity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEntity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EntityCommand) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEntity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EntityCommand: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EntityCommand: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommandId", wireType)
+			}
+			m.CommandId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommandId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEntity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEntity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= EntityRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEntity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEntity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegistrationMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEntity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegistrationMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegistrationMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEntity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEntity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEntity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEntity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEntity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEntity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= EntityRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEntity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEntity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Target = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEntity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEntity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UsedRegistrationMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEntity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UsedRegistrationMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UsedRegistrationMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEntity
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEntity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEntity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEntity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEntity(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEntity
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEntity
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEntity
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEntity
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEntity
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEntity
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEntity        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEntity          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEntity = fmt.Errorf("proto: unexpected end of group")
+)
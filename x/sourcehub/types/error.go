@@ -5,5 +5,10 @@ import (
 )
 
 var (
-	ErrInvalidGenesis = sdkerrors.Register(ModuleName, 1, "invalid genesis")
+	ErrInvalidGenesis   = sdkerrors.Register(ModuleName, 1, "invalid genesis")
+	ErrInvalidPolicyCmd = sdkerrors.Register(ModuleName, 2, "policy command does not match the shinzohub policy")
+	ErrInvalidPolicy    = sdkerrors.Register(ModuleName, 3, "invalid shinzohub policy")
+	ErrInvalidView      = sdkerrors.Register(ModuleName, 4, "invalid view")
+	ErrNoRewards        = sdkerrors.Register(ModuleName, 5, "no rewards")
+	ErrUnknownStream    = sdkerrors.Register(ModuleName, 6, "unknown stream")
 )
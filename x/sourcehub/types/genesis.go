@@ -1,17 +1,401 @@
 package types
 
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 func DefaultGenesis() *GenesisState {
-	// you can override in genesis.json
-	return &GenesisState{
-		ControllerConnectionId: "connection-0",
-		HostConnectionId:       "connection-0",
-		Version:                "ics27-1",
-		Encoding:               "proto3",
-		TxType:                 "sdk_multi_msg",
-		PolicyId:               "",
+	// SourceHub targets are added with MsgRegisterSourcehubICA
+	return &GenesisState{}
+}
+
+// GetTargetsOrLegacy returns the genesis targets, or the v2 single SourceHub link as
+// the "sourcehub" target if no targets are set.
+func (gs *GenesisState) GetTargetsOrLegacy() ([]SourcehubTarget, string) {
+	if len(gs.Targets) > 0 || gs.ControllerConnectionId == "" {
+		return gs.Targets, gs.DefaultTarget
 	}
+
+	return []SourcehubTarget{{
+		ChainId:                LegacyTargetName,
+		ControllerConnectionId: gs.ControllerConnectionId,
+		HostConnectionId:       gs.HostConnectionId,
+		Version:                gs.Version,
+		Encoding:               gs.Encoding,
+		TxType:                 gs.TxType,
+		PolicyId:               gs.PolicyId,
+	}}, LegacyTargetName
 }
 
 func (gs *GenesisState) Validate() error {
-	return nil // TODO: validate genesis
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	allTargets, defaultTarget := gs.GetTargetsOrLegacy()
+	targets := make(map[string]struct{}, len(allTargets))
+	for _, t := range allTargets {
+		if err := t.Validate(); err != nil {
+			return err
+		}
+		if _, ok := targets[t.ChainId]; ok {
+			return fmt.Errorf("duplicate SourceHub target %s", t.ChainId)
+		}
+		targets[t.ChainId] = struct{}{}
+	}
+	if _, ok := targets[defaultTarget]; defaultTarget != "" && !ok {
+		return fmt.Errorf("default target %s is not a registered target", defaultTarget)
+	}
+	if defaultTarget == "" && len(targets) > 0 {
+		return fmt.Errorf("no default target among %d targets", len(targets))
+	}
+
+	var latestPolicy uint64
+	policyVersions := make(map[uint64]struct{}, len(gs.PolicyVersions))
+	for _, v := range gs.PolicyVersions {
+		if v.Version == 0 {
+			return fmt.Errorf("policy version 0 is the embedded policy")
+		}
+		if _, ok := policyVersions[v.Version]; ok {
+			return fmt.Errorf("duplicate policy version %d", v.Version)
+		}
+		policyVersions[v.Version] = struct{}{}
+		if v.Policy == "" {
+			return fmt.Errorf("policy version %d has no policy", v.Version)
+		}
+		if h := sha256.Sum256([]byte(v.Policy)); hex.EncodeToString(h[:]) != v.Hash {
+			return fmt.Errorf("policy version %d does not match its hash %s", v.Version, v.Hash)
+		}
+		if _, err := sdk.AccAddressFromBech32(v.Authority); err != nil {
+			return fmt.Errorf("invalid authority %q for policy version %d: %w", v.Authority, v.Version, err)
+		}
+		latestPolicy = max(latestPolicy, v.Version)
+	}
+	for _, t := range allTargets {
+		if t.PolicyVersion > latestPolicy {
+			return fmt.Errorf("target %s has unknown policy version %d", t.ChainId, t.PolicyVersion)
+		}
+	}
+	// Records written before targets existed have none and use the default.
+	knownTarget := func(name string) bool {
+		_, ok := targets[name]
+		return name == "" || ok
+	}
+	targetOf := func(name string) string {
+		if name == "" {
+			return defaultTarget
+		}
+		return name
+	}
+
+	seen := make(map[string]struct{}, len(gs.StreamGrants))
+	for _, g := range gs.StreamGrants {
+		if g.StreamId == "" || g.Did == "" {
+			return fmt.Errorf("stream grant must have a stream ID and a DID")
+		}
+		if _, ok := Resource_name[int32(g.Resource)]; !ok {
+			return fmt.Errorf("stream grant %s has invalid resource %d", g.StreamId, g.Resource)
+		}
+		if !knownTarget(g.Target) {
+			return fmt.Errorf("stream grant %s has unknown target %s", g.StreamId, g.Target)
+		}
+
+		key := fmt.Sprintf("%s/%d/%s/%s", targetOf(g.Target), g.Resource, g.StreamId, g.Did)
+		if _, ok := seen[key]; ok {
+			return fmt.Errorf("duplicate stream grant %s for %s on target %s", g.StreamId, g.Did, targetOf(g.Target))
+		}
+		seen[key] = struct{}{}
+	}
+
+	prices := make(map[string]struct{}, len(gs.StreamPrices))
+	for _, p := range gs.StreamPrices {
+		if p.StreamId == "" {
+			return fmt.Errorf("stream price must have a stream ID")
+		}
+		if _, ok := Resource_name[int32(p.Resource)]; !ok {
+			return fmt.Errorf("stream price %s has invalid resource %d", p.StreamId, p.Resource)
+		}
+		if err := p.Amount.Validate(); err != nil || p.Amount.IsZero() {
+			return fmt.Errorf("stream price %s has invalid amount %q", p.StreamId, p.Amount)
+		}
+		if _, err := sdk.AccAddressFromBech32(p.Setter); err != nil {
+			return fmt.Errorf("invalid setter %q for stream price %s: %w", p.Setter, p.StreamId, err)
+		}
+
+		key := fmt.Sprintf("%d/%s", p.Resource, p.StreamId)
+		if _, ok := prices[key]; ok {
+			return fmt.Errorf("duplicate stream price %s", p.StreamId)
+		}
+		prices[key] = struct{}{}
+	}
+
+	holders := make(map[string]struct{}, len(gs.RoleHolders))
+	for _, h := range gs.RoleHolders {
+		if err := ValidateModuleRole(h.Role); err != nil {
+			return err
+		}
+		if _, err := sdk.AccAddressFromBech32(h.Address); err != nil {
+			return fmt.Errorf("invalid role holder address %q: %w", h.Address, err)
+		}
+
+		key := fmt.Sprintf("%d/%s", h.Role, h.Address)
+		if _, ok := holders[key]; ok {
+			return fmt.Errorf("duplicate %s role holder %s", ModuleRoleName(h.Role), h.Address)
+		}
+		holders[key] = struct{}{}
+	}
+
+	owners := make(map[string]struct{}, len(gs.Entities))
+	dids := make(map[string]struct{}, len(gs.Entities))
+	pids := make(map[string]struct{}, len(gs.Entities))
+	for _, e := range gs.Entities {
+		if _, err := sdk.AccAddressFromBech32(e.Owner); err != nil {
+			return fmt.Errorf("invalid entity owner %q: %w", e.Owner, err)
+		}
+		if _, ok := EntityRole_name[int32(e.Role)]; !ok {
+			return fmt.Errorf("entity %s has invalid role %d", e.Owner, e.Role)
+		}
+		if e.Did == "" {
+			return fmt.Errorf("entity %s has no DID", e.Owner)
+		}
+		if !knownTarget(e.Target) {
+			return fmt.Errorf("entity %s has unknown target %s", e.Owner, e.Target)
+		}
+		if err := e.Bond.Validate(); err != nil {
+			return fmt.Errorf("entity %s has invalid bond %q: %w", e.Owner, e.Bond, err)
+		}
+
+		ownerKey := fmt.Sprintf("%d/%s", e.Role, e.Owner)
+		if _, ok := owners[ownerKey]; ok {
+			return fmt.Errorf("duplicate entity for %s", e.Owner)
+		}
+		owners[ownerKey] = struct{}{}
+
+		didKey := fmt.Sprintf("%d/%s", e.Role, e.Did)
+		if _, ok := dids[didKey]; ok {
+			return fmt.Errorf("DID %s registered by more than one address", e.Did)
+		}
+		dids[didKey] = struct{}{}
+
+		if e.Pid != "" {
+			pidKey := fmt.Sprintf("%d/%s", e.Role, e.Pid)
+			if _, ok := pids[pidKey]; ok {
+				return fmt.Errorf("PID %s registered by more than one address", e.Pid)
+			}
+			pids[pidKey] = struct{}{}
+		}
+	}
+
+	views := make(map[string]struct{}, len(gs.Views))
+	for _, v := range gs.Views {
+		if v.Id == "" {
+			return fmt.Errorf("view must have an ID")
+		}
+		if _, err := sdk.AccAddressFromBech32(v.Creator); err != nil {
+			return fmt.Errorf("invalid creator %q for view %s: %w", v.Creator, v.Id, err)
+		}
+		if !knownTarget(v.Target) {
+			return fmt.Errorf("view %s has unknown target %s", v.Id, v.Target)
+		}
+		if _, ok := views[v.Id]; ok {
+			return fmt.Errorf("duplicate view %s", v.Id)
+		}
+		views[v.Id] = struct{}{}
+	}
+
+	relationships := make(map[string]struct{}, len(gs.Relationships))
+	for _, r := range gs.Relationships {
+		if r.Resource == "" || r.ObjectId == "" || r.Relation == "" || r.Actor == "" {
+			return fmt.Errorf("relationship must have a resource, an object ID, a relation and an actor")
+		}
+		if _, ok := RelationshipStatus_name[int32(r.Status)]; !ok || r.Status == RelationshipStatus_RELATIONSHIP_STATUS_UNSPECIFIED {
+			return fmt.Errorf("relationship %s:%s#%s@%s has invalid status %d", r.Resource, r.ObjectId, r.Relation, r.Actor, r.Status)
+		}
+		if !knownTarget(r.Target) {
+			return fmt.Errorf("relationship %s:%s#%s@%s has unknown target %s", r.Resource, r.ObjectId, r.Relation, r.Actor, r.Target)
+		}
+
+		key := fmt.Sprintf("%s:%s#%s@%s", r.Resource, r.ObjectId, r.Relation, r.Actor)
+		if _, ok := relationships[targetOf(r.Target)+"/"+key]; ok {
+			return fmt.Errorf("duplicate relationship %s on target %s", key, targetOf(r.Target))
+		}
+		relationships[targetOf(r.Target)+"/"+key] = struct{}{}
+	}
+
+	commands := make(map[uint64]IcaCommand, len(gs.IcaCommands))
+	for _, c := range gs.IcaCommands {
+		if c.Id >= gs.NextCommandId {
+			return fmt.Errorf("ICA command %d is not below the next command ID %d", c.Id, gs.NextCommandId)
+		}
+		if _, ok := commands[c.Id]; ok {
+			return fmt.Errorf("duplicate ICA command %d", c.Id)
+		}
+		if !knownTarget(c.Target) {
+			return fmt.Errorf("ICA command %d has unknown target %s", c.Id, c.Target)
+		}
+		commands[c.Id] = c
+	}
+
+	// Packets keep the IDs of the commands they carried, even once those are
+	// retried in a later packet or purged from the dead-letter list.
+	packets := make(map[string]map[uint64]struct{}, len(gs.IcaPackets))
+	for _, p := range gs.IcaPackets {
+		if p.ChannelId == "" {
+			return fmt.Errorf("ICA packet %d has no channel ID", p.Sequence)
+		}
+		key := fmt.Sprintf("%s/%d", p.ChannelId, p.Sequence)
+		if _, ok := packets[key]; ok {
+			return fmt.Errorf("duplicate ICA packet %s", key)
+		}
+		if !knownTarget(p.Target) {
+			return fmt.Errorf("ICA packet %s has unknown target %s", key, p.Target)
+		}
+		packets[key] = make(map[uint64]struct{}, len(p.CommandIds))
+		for _, id := range p.CommandIds {
+			packets[key][id] = struct{}{}
+		}
+	}
+
+	for _, c := range gs.IcaCommands {
+		if c.Status != PacketStatus_PACKET_STATUS_PENDING {
+			continue
+		}
+		carried, ok := packets[fmt.Sprintf("%s/%d", c.ChannelId, c.Sequence)]
+		if !ok {
+			return fmt.Errorf("pending ICA command %d has no packet", c.Id)
+		}
+		if _, ok := carried[c.Id]; !ok {
+			return fmt.Errorf("pending ICA command %d is not carried by its packet", c.Id)
+		}
+	}
+
+	deadLetters := make(map[uint64]struct{}, len(gs.DeadLetters))
+	for _, id := range gs.DeadLetters {
+		if _, ok := deadLetters[id]; ok {
+			return fmt.Errorf("duplicate dead letter %d", id)
+		}
+		deadLetters[id] = struct{}{}
+
+		c, ok := commands[id]
+		if !ok {
+			return fmt.Errorf("dead letter %d has no ICA command", id)
+		}
+		if c.Status != PacketStatus_PACKET_STATUS_FAILED && c.Status != PacketStatus_PACKET_STATUS_TIMED_OUT {
+			return fmt.Errorf("dead letter %d refers to a %s ICA command", id, c.Status)
+		}
+	}
+
+	entityCommands := make(map[uint64]struct{}, len(gs.EntityCommands))
+	for _, ec := range gs.EntityCommands {
+		if _, ok := entityCommands[ec.CommandId]; ok {
+			return fmt.Errorf("duplicate entity command %d", ec.CommandId)
+		}
+		entityCommands[ec.CommandId] = struct{}{}
+
+		c, ok := commands[ec.CommandId]
+		if !ok {
+			return fmt.Errorf("entity command %d has no ICA command", ec.CommandId)
+		}
+		if c.Status != PacketStatus_PACKET_STATUS_QUEUED && c.Status != PacketStatus_PACKET_STATUS_PENDING {
+			return fmt.Errorf("entity command %d refers to a resolved ICA command", ec.CommandId)
+		}
+		if _, ok := owners[fmt.Sprintf("%d/%s", ec.Role, ec.Owner)]; !ok {
+			return fmt.Errorf("entity command %d refers to unknown %s entity %s", ec.CommandId, EntityRole_name[int32(ec.Role)], ec.Owner)
+		}
+	}
+
+	payments := make(map[uint64]struct{}, len(gs.StreamPayments))
+	for _, p := range gs.StreamPayments {
+		if _, ok := payments[p.CommandId]; ok {
+			return fmt.Errorf("duplicate stream payment for command %d", p.CommandId)
+		}
+		payments[p.CommandId] = struct{}{}
+
+		if p.StreamId == "" || p.Did == "" {
+			return fmt.Errorf("stream payment %d must have a stream ID and a DID", p.CommandId)
+		}
+		if _, ok := Resource_name[int32(p.Resource)]; !ok {
+			return fmt.Errorf("stream payment %d has invalid resource %d", p.CommandId, p.Resource)
+		}
+		if _, err := sdk.AccAddressFromBech32(p.Payer); err != nil {
+			return fmt.Errorf("invalid payer %q for stream payment %d: %w", p.Payer, p.CommandId, err)
+		}
+		if err := p.Amount.Validate(); err != nil || p.Amount.IsZero() {
+			return fmt.Errorf("stream payment %d has invalid amount %q", p.CommandId, p.Amount)
+		}
+
+		// A payment whose refund could not be sent outlives its failed command.
+		if c, ok := commands[p.CommandId]; ok && c.Status == PacketStatus_PACKET_STATUS_ACKNOWLEDGED {
+			return fmt.Errorf("stream payment %d refers to an acknowledged ICA command", p.CommandId)
+		}
+	}
+
+	revenues := make(map[string]struct{}, len(gs.StreamRevenues))
+	for _, r := range gs.StreamRevenues {
+		if _, ok := Resource_name[int32(r.Resource)]; !ok {
+			return fmt.Errorf("stream revenue of %s has invalid resource %d", r.StreamId, r.Resource)
+		}
+		if r.StreamId == "" {
+			return fmt.Errorf("stream revenue must have a stream ID")
+		}
+		key := fmt.Sprintf("%d/%s", r.Resource, r.StreamId)
+		if _, ok := revenues[key]; ok {
+			return fmt.Errorf("duplicate stream revenue of %s", r.StreamId)
+		}
+		revenues[key] = struct{}{}
+		if err := r.Amount.Validate(); err != nil || r.Amount.IsZero() {
+			return fmt.Errorf("stream revenue of %s has invalid amount %q", r.StreamId, r.Amount)
+		}
+	}
+
+	rewards := make(map[string]struct{}, len(gs.PendingRewards))
+	for _, r := range gs.PendingRewards {
+		addr, err := sdk.AccAddressFromBech32(r.Address)
+		if err != nil {
+			return fmt.Errorf("invalid pending reward address %q: %w", r.Address, err)
+		}
+		if _, ok := rewards[addr.String()]; ok {
+			return fmt.Errorf("duplicate pending reward for %s", r.Address)
+		}
+		rewards[addr.String()] = struct{}{}
+		if err := r.Amount.Validate(); err != nil || r.Amount.IsZero() {
+			return fmt.Errorf("pending reward of %s has invalid amount %q", r.Address, r.Amount)
+		}
+	}
+
+	unbondings := make(map[string]struct{}, len(gs.EntityUnbondings))
+	for _, u := range gs.EntityUnbondings {
+		addr, err := sdk.AccAddressFromBech32(u.Owner)
+		if err != nil {
+			return fmt.Errorf("invalid entity unbonding owner %q: %w", u.Owner, err)
+		}
+		if _, ok := EntityRole_name[int32(u.Role)]; !ok {
+			return fmt.Errorf("entity unbonding of %s has invalid role %d", u.Owner, u.Role)
+		}
+		key := fmt.Sprintf("%d/%s/%d", u.CompletionTime, addr, u.Role)
+		if _, ok := unbondings[key]; ok {
+			return fmt.Errorf("duplicate entity unbonding of %s completing at %d", u.Owner, u.CompletionTime)
+		}
+		unbondings[key] = struct{}{}
+		if err := u.Amount.Validate(); err != nil || u.Amount.IsZero() {
+			return fmt.Errorf("entity unbonding of %s has invalid amount %q", u.Owner, u.Amount)
+		}
+	}
+
+	used := make(map[string]struct{}, len(gs.UsedRegistrationMessages))
+	for _, u := range gs.UsedRegistrationMessages {
+		if len(u.Hash) != sha256.Size {
+			return fmt.Errorf("invalid registration message hash length %d", len(u.Hash))
+		}
+		if _, ok := used[string(u.Hash)]; ok {
+			return fmt.Errorf("duplicate registration message hash %X", u.Hash)
+		}
+		used[string(u.Hash)] = struct{}{}
+	}
+
+	return nil
 }
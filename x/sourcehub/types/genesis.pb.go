@@ -38,6 +38,45 @@ type GenesisState struct {
 	// Policy ID for shinzohub on sourcehub
 	PolicyId string `protobuf:"bytes,6,opt,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty"`
 	Params   Params `protobuf:"bytes,7,opt,name=params,proto3" json:"params"`
+	// Stream access grants and their expirations
+	StreamGrants []StreamGrant `protobuf:"bytes,8,rep,name=stream_grants,json=streamGrants,proto3" json:"stream_grants"`
+	// Module role assignments
+	RoleHolders []RoleHolder `protobuf:"bytes,9,rep,name=role_holders,json=roleHolders,proto3" json:"role_holders"`
+	// Registered indexers and hosts
+	Entities []Entity `protobuf:"bytes,10,rep,name=entities,proto3" json:"entities"`
+	// Registered views and their creators
+	Views []View `protobuf:"bytes,11,rep,name=views,proto3" json:"views"`
+	// ICA packets sent to SourceHub and their outcomes
+	IcaPackets []IcaPacket `protobuf:"bytes,12,rep,name=ica_packets,json=icaPackets,proto3" json:"ica_packets"`
+	// Unresolved outbox commands carrying an entity group membership
+	EntityCommands []EntityCommand `protobuf:"bytes,13,rep,name=entity_commands,json=entityCommands,proto3" json:"entity_commands"`
+	// Outbox commands, queued or sent
+	IcaCommands []IcaCommand `protobuf:"bytes,15,rep,name=ica_commands,json=icaCommands,proto3" json:"ica_commands"`
+	// Next outbox command ID
+	NextCommandId uint64 `protobuf:"varint,16,opt,name=next_command_id,json=nextCommandId,proto3" json:"next_command_id,omitempty"`
+	// IDs of the commands that exhausted their attempts
+	DeadLetters []uint64 `protobuf:"varint,17,rep,packed,name=dead_letters,json=deadLetters,proto3" json:"dead_letters,omitempty"`
+	// SourceHub chains the module is connected to
+	Targets []SourcehubTarget `protobuf:"bytes,19,rep,name=targets,proto3" json:"targets"`
+	// Chain ID of the target used when none is given
+	DefaultTarget string `protobuf:"bytes,20,opt,name=default_target,json=defaultTarget,proto3" json:"default_target,omitempty"`
+	// Mirror of the ACP relationships sent to SourceHub
+	Relationships []Relationship `protobuf:"bytes,21,rep,name=relationships,proto3" json:"relationships"`
+	// Versions of the shinzohub policy adopted through governance
+	PolicyVersions []PolicyVersion `protobuf:"bytes,22,rep,name=policy_versions,json=policyVersions,proto3" json:"policy_versions"`
+	// Prices of the streams open to MsgBuyStreamAccess
+	StreamPrices []StreamPrice `protobuf:"bytes,23,rep,name=stream_prices,json=streamPrices,proto3" json:"stream_prices"`
+	// Payments held in escrow until SourceHub acknowledges the access they bought
+	StreamPayments []StreamPayment `protobuf:"bytes,24,rep,name=stream_payments,json=streamPayments,proto3" json:"stream_payments"`
+	// Subscription fees collected in the current epoch
+	StreamRevenues []StreamRevenue `protobuf:"bytes,25,rep,name=stream_revenues,json=streamRevenues,proto3" json:"stream_revenues"`
+	// Rewards credited and not withdrawn yet
+	PendingRewards []PendingReward `protobuf:"bytes,26,rep,name=pending_rewards,json=pendingRewards,proto3" json:"pending_rewards"`
+	// Bonds of removed or blocked entities waiting for the end of their
+	// unbonding period
+	EntityUnbondings []EntityUnbonding `protobuf:"bytes,27,rep,name=entity_unbondings,json=entityUnbondings,proto3" json:"entity_unbondings"`
+	// Registration messages already used that have not expired yet
+	UsedRegistrationMessages []UsedRegistrationMessage `protobuf:"bytes,28,rep,name=used_registration_messages,json=usedRegistrationMessages,proto3" json:"used_registration_messages"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -122,6 +161,139 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetStreamGrants() []StreamGrant {
+	if m != nil {
+		return m.StreamGrants
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRoleHolders() []RoleHolder {
+	if m != nil {
+		return m.RoleHolders
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEntities() []Entity {
+	if m != nil {
+		return m.Entities
+	}
+	return nil
+}
+
+func (m *GenesisState) GetViews() []View {
+	if m != nil {
+		return m.Views
+	}
+	return nil
+}
+
+func (m *GenesisState) GetIcaPackets() []IcaPacket {
+	if m != nil {
+		return m.IcaPackets
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEntityCommands() []EntityCommand {
+	if m != nil {
+		return m.EntityCommands
+	}
+	return nil
+}
+
+func (m *GenesisState) GetIcaCommands() []IcaCommand {
+	if m != nil {
+		return m.IcaCommands
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextCommandId() uint64 {
+	if m != nil {
+		return m.NextCommandId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetDeadLetters() []uint64 {
+	if m != nil {
+		return m.DeadLetters
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTargets() []SourcehubTarget {
+	if m != nil {
+		return m.Targets
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDefaultTarget() string {
+	if m != nil {
+		return m.DefaultTarget
+	}
+	return ""
+}
+
+func (m *GenesisState) GetRelationships() []Relationship {
+	if m != nil {
+		return m.Relationships
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPolicyVersions() []PolicyVersion {
+	if m != nil {
+		return m.PolicyVersions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetStreamPrices() []StreamPrice {
+	if m != nil {
+		return m.StreamPrices
+	}
+	return nil
+}
+
+func (m *GenesisState) GetStreamPayments() []StreamPayment {
+	if m != nil {
+		return m.StreamPayments
+	}
+	return nil
+}
+
+func (m *GenesisState) GetStreamRevenues() []StreamRevenue {
+	if m != nil {
+		return m.StreamRevenues
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingRewards() []PendingReward {
+	if m != nil {
+		return m.PendingRewards
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEntityUnbondings() []EntityUnbonding {
+	if m != nil {
+		return m.EntityUnbondings
+	}
+	return nil
+}
+
+func (m *GenesisState) GetUsedRegistrationMessages() []UsedRegistrationMessage {
+	if m != nil {
+		return m.UsedRegistrationMessages
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "shinzonetwork.sourcehub.v1.GenesisState")
 }
@@ -131,28 +303,62 @@ func init() {
 }
 
 var fileDescriptor_3c6565e3fe08f82e = []byte{
-	// 333 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xc1, 0x4e, 0x32, 0x31,
-	0x14, 0x85, 0x67, 0xf8, 0xf9, 0x07, 0xa9, 0x2e, 0x4c, 0x63, 0xb4, 0x19, 0x93, 0x4a, 0xd8, 0xc8,
-	0xc2, 0xcc, 0x04, 0x75, 0xe1, 0xd2, 0xe0, 0xc2, 0xb0, 0x31, 0x06, 0x5d, 0xb9, 0x21, 0xd0, 0x69,
-	0x86, 0x46, 0xe8, 0x9d, 0xb4, 0x05, 0xc1, 0xa7, 0xf0, 0x21, 0x7c, 0x18, 0x96, 0x2c, 0x5d, 0x19,
-	0x03, 0x2f, 0x62, 0xda, 0x41, 0x14, 0x12, 0xdd, 0xcd, 0x99, 0x73, 0xbe, 0x7b, 0xdb, 0x53, 0x54,
-	0xd3, 0x3d, 0x21, 0x9f, 0x41, 0x72, 0xf3, 0x04, 0xea, 0x31, 0xd6, 0x30, 0x54, 0x8c, 0xf7, 0x86,
-	0xdd, 0x78, 0x54, 0x8f, 0x53, 0x2e, 0xb9, 0x16, 0x3a, 0xca, 0x14, 0x18, 0xc0, 0xe1, 0x5a, 0x32,
-	0x5a, 0x25, 0xa3, 0x51, 0x3d, 0xdc, 0x4b, 0x21, 0x05, 0x17, 0x8b, 0xed, 0x57, 0x4e, 0x84, 0xc7,
-	0x7f, 0xcc, 0xce, 0x3a, 0xaa, 0x33, 0x58, 0x8e, 0xae, 0xbe, 0x16, 0xd0, 0xce, 0x75, 0xbe, 0xec,
-	0xce, 0x74, 0x0c, 0xc7, 0x17, 0x88, 0x30, 0x90, 0x46, 0x41, 0xbf, 0xcf, 0x55, 0x9b, 0x81, 0x94,
-	0x9c, 0x19, 0x01, 0xb2, 0x2d, 0x12, 0xe2, 0x57, 0xfc, 0x5a, 0xb9, 0xb5, 0xff, 0xed, 0x5f, 0xad,
-	0xec, 0x66, 0x82, 0x4f, 0x10, 0xee, 0x81, 0x36, 0x1b, 0x4c, 0xc1, 0x31, 0xbb, 0xd6, 0x59, 0x4b,
-	0x13, 0x54, 0x1a, 0x71, 0xa5, 0x05, 0x48, 0xf2, 0xcf, 0x45, 0xbe, 0x24, 0x0e, 0xd1, 0x16, 0x97,
-	0x0c, 0x12, 0x21, 0x53, 0x52, 0x74, 0xd6, 0x4a, 0xe3, 0x03, 0x54, 0x32, 0xe3, 0xb6, 0x99, 0x64,
-	0x9c, 0xfc, 0x77, 0x56, 0x60, 0xc6, 0xf7, 0x93, 0x8c, 0xe3, 0x43, 0x54, 0xce, 0xa0, 0x2f, 0xd8,
-	0xc4, 0xee, 0x0c, 0x72, 0x2a, 0xff, 0xd1, 0x4c, 0xf0, 0x25, 0x0a, 0xf2, 0x4b, 0x93, 0x52, 0xc5,
-	0xaf, 0x6d, 0x9f, 0x56, 0xa3, 0xdf, 0x0b, 0x8d, 0x6e, 0x5d, 0xb2, 0x51, 0x9c, 0xbe, 0x1f, 0x79,
-	0xad, 0x25, 0xd7, 0xb8, 0x99, 0xce, 0xa9, 0x3f, 0x9b, 0x53, 0xff, 0x63, 0x4e, 0xfd, 0x97, 0x05,
-	0xf5, 0x66, 0x0b, 0xea, 0xbd, 0x2d, 0xa8, 0xf7, 0x70, 0x9e, 0x0a, 0x63, 0x49, 0x06, 0x83, 0x78,
-	0xa3, 0x74, 0xa7, 0x6c, 0xe9, 0xe3, 0x1f, 0x0f, 0x60, 0xcf, 0xae, 0xbb, 0x81, 0x6b, 0xff, 0xec,
-	0x33, 0x00, 0x00, 0xff, 0xff, 0xdb, 0xd4, 0x1f, 0xde, 0x04, 0x02, 0x00, 0x00,
+	// 865 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0x4d, 0x8f, 0x1b, 0x45,
+	0x10, 0x5d, 0xb3, 0xce, 0x7e, 0xb4, 0xed, 0x5d, 0xa7, 0x13, 0x92, 0xc6, 0x41, 0xc6, 0x44, 0xda,
+	0x8d, 0x11, 0x60, 0x2b, 0x09, 0x07, 0x0e, 0x1c, 0x50, 0x02, 0x0a, 0x0e, 0x01, 0x56, 0x93, 0x4d,
+	0x84, 0x38, 0x30, 0x9a, 0x9d, 0x29, 0xc6, 0xad, 0x8c, 0xbb, 0xad, 0xae, 0xb6, 0xbd, 0xcb, 0xaf,
+	0xe0, 0x67, 0xe5, 0x98, 0x23, 0x27, 0x84, 0x76, 0xff, 0x06, 0x07, 0xd4, 0x1f, 0x33, 0x19, 0x47,
+	0x30, 0x9e, 0xdb, 0xcc, 0xeb, 0xf7, 0x5e, 0x55, 0x57, 0x57, 0x75, 0x93, 0x21, 0x4e, 0xb9, 0xf8,
+	0x5d, 0x0a, 0xd0, 0x2b, 0xa9, 0x5e, 0x8d, 0x51, 0x2e, 0x54, 0x0c, 0xd3, 0xc5, 0xd9, 0x78, 0x79,
+	0x7f, 0x9c, 0x82, 0x00, 0xe4, 0x38, 0x9a, 0x2b, 0xa9, 0x25, 0xed, 0xad, 0x31, 0x47, 0x05, 0x73,
+	0xb4, 0xbc, 0xdf, 0xbb, 0x99, 0xca, 0x54, 0x5a, 0xda, 0xd8, 0x7c, 0x39, 0x45, 0xef, 0x5e, 0x85,
+	0x37, 0x08, 0xcd, 0xf5, 0x85, 0x27, 0x1e, 0x57, 0x25, 0xa1, 0x22, 0xa1, 0x6b, 0x18, 0xce, 0xa3,
+	0xf8, 0x15, 0xd4, 0x23, 0xaa, 0x68, 0xe6, 0x37, 0xd5, 0x1b, 0x56, 0x12, 0x2f, 0x66, 0x50, 0x2f,
+	0xb6, 0xcc, 0x78, 0x9c, 0x6f, 0xe6, 0xf3, 0x0a, 0xa2, 0x82, 0x2c, 0xd2, 0x5c, 0x0a, 0x9c, 0xf2,
+	0x79, 0x8d, 0x0c, 0x14, 0x2c, 0x41, 0x2c, 0xc0, 0x33, 0x8f, 0xaa, 0x98, 0x32, 0x83, 0x1a, 0x89,
+	0xea, 0x48, 0xa5, 0x45, 0x91, 0xaa, 0xfc, 0x96, 0x1c, 0x56, 0x8e, 0x76, 0xf7, 0x9f, 0x0e, 0x69,
+	0x3f, 0x71, 0x9d, 0xf0, 0x5c, 0x47, 0x1a, 0xe8, 0x97, 0x84, 0xc5, 0x52, 0x68, 0x25, 0xb3, 0x0c,
+	0x54, 0x18, 0x4b, 0x21, 0x20, 0x36, 0x9b, 0x0a, 0x79, 0xc2, 0x1a, 0x83, 0xc6, 0x70, 0x3f, 0xb8,
+	0xf5, 0x76, 0xfd, 0x71, 0xb1, 0x3c, 0x49, 0xe8, 0x67, 0x84, 0x4e, 0x25, 0xea, 0x77, 0x34, 0xef,
+	0x59, 0x4d, 0xd7, 0xac, 0xac, 0xb1, 0x19, 0xd9, 0x5d, 0x82, 0x42, 0x2e, 0x05, 0xdb, 0xb6, 0x94,
+	0xfc, 0x97, 0xf6, 0xc8, 0x1e, 0x88, 0x58, 0x26, 0x5c, 0xa4, 0xac, 0x69, 0x97, 0x8a, 0x7f, 0x7a,
+	0x9b, 0xec, 0xea, 0xf3, 0x50, 0x5f, 0xcc, 0x81, 0x5d, 0xb3, 0x4b, 0x3b, 0xfa, 0xfc, 0xf4, 0x62,
+	0x0e, 0xf4, 0x0e, 0xd9, 0x77, 0xe7, 0x64, 0x62, 0xee, 0x38, 0x95, 0x03, 0x26, 0x09, 0xfd, 0x9a,
+	0xec, 0xb8, 0xbe, 0x60, 0xbb, 0x83, 0xc6, 0xb0, 0xf5, 0xe0, 0xee, 0xe8, 0xff, 0xbb, 0x7d, 0x74,
+	0x62, 0x99, 0x8f, 0x9a, 0xaf, 0xff, 0xfa, 0x68, 0x2b, 0xf0, 0x3a, 0x1a, 0x90, 0x0e, 0x6a, 0x05,
+	0xd1, 0x2c, 0xb4, 0x1d, 0x8b, 0x6c, 0x6f, 0xb0, 0x3d, 0x6c, 0x3d, 0xb8, 0x57, 0x65, 0xf4, 0xdc,
+	0x0a, 0x9e, 0x18, 0xbe, 0x77, 0x6b, 0xe3, 0x5b, 0x08, 0xe9, 0x4f, 0xa4, 0x6d, 0x0e, 0x36, 0x9c,
+	0xca, 0x2c, 0x01, 0x85, 0x6c, 0xdf, 0x5a, 0x1e, 0x57, 0x59, 0x06, 0x32, 0x83, 0xef, 0x2c, 0xdd,
+	0x3b, 0xb6, 0x54, 0x81, 0x20, 0xfd, 0xc6, 0x14, 0x4e, 0x73, 0xcd, 0x01, 0x19, 0xb1, 0x66, 0x95,
+	0x1b, 0xfd, 0xd6, 0x0e, 0xa9, 0x37, 0x2a, 0x94, 0xf4, 0x2b, 0x72, 0xcd, 0xf4, 0x07, 0xb2, 0x96,
+	0xb5, 0x18, 0x54, 0x59, 0xbc, 0xe4, 0xb0, 0xf2, 0x06, 0x4e, 0x44, 0x9f, 0x91, 0x16, 0x8f, 0xa3,
+	0xd0, 0xcd, 0x2b, 0xb2, 0xb6, 0xf5, 0x38, 0xaa, 0xf2, 0x98, 0xc4, 0xd1, 0x89, 0x65, 0x7b, 0x23,
+	0xc2, 0x73, 0x00, 0xe9, 0xcf, 0xe4, 0xd0, 0x5d, 0x25, 0x61, 0x2c, 0x67, 0xb3, 0x48, 0x24, 0xc8,
+	0x3a, 0xd6, 0xf1, 0x93, 0xcd, 0x1b, 0x7b, 0xec, 0x14, 0xde, 0xf5, 0x00, 0xca, 0xa0, 0x2d, 0xbe,
+	0xc9, 0xb3, 0xb0, 0x3d, 0xdc, 0x5c, 0xfc, 0x49, 0x1c, 0xad, 0x7b, 0x9a, 0x9d, 0x16, 0x86, 0xc7,
+	0xe4, 0x50, 0xc0, 0xb9, 0xce, 0x1d, 0x4d, 0x1b, 0x76, 0x07, 0x8d, 0x61, 0x33, 0xe8, 0x18, 0xd8,
+	0xd3, 0x26, 0x09, 0xfd, 0x98, 0xb4, 0x13, 0x88, 0x92, 0x30, 0x03, 0xad, 0xcd, 0xa9, 0x5f, 0x1f,
+	0x6c, 0x0f, 0x9b, 0x41, 0xcb, 0x60, 0xcf, 0x1c, 0x44, 0xbf, 0x27, 0xbb, 0x6e, 0x94, 0x91, 0xdd,
+	0xb0, 0x69, 0x7d, 0x5a, 0xd9, 0x66, 0xf9, 0xcf, 0xa9, 0xd5, 0xf8, 0xdc, 0x72, 0x07, 0x7a, 0x44,
+	0x0e, 0x12, 0xf8, 0x2d, 0x5a, 0x64, 0x3a, 0x74, 0x10, 0xbb, 0x69, 0xa7, 0xa3, 0xe3, 0x51, 0xa7,
+	0xa2, 0xa7, 0xa4, 0x53, 0xbe, 0xbe, 0x90, 0xbd, 0x6f, 0x23, 0x0f, 0x2b, 0xbb, 0xb1, 0x24, 0xf0,
+	0x61, 0xd7, 0x4d, 0xcc, 0xf9, 0xf9, 0xa9, 0xf4, 0xc3, 0x8d, 0xec, 0xd6, 0xe6, 0xf3, 0x3b, 0xb1,
+	0x92, 0x97, 0x4e, 0x91, 0x9f, 0xdf, 0xbc, 0x0c, 0x96, 0x07, 0x72, 0xae, 0x78, 0x0c, 0xc8, 0x6e,
+	0xd7, 0x1d, 0xc8, 0x13, 0xc3, 0x5f, 0x1f, 0x48, 0x0b, 0xd9, 0x6c, 0x73, 0x4f, 0xf7, 0x38, 0x20,
+	0x63, 0x9b, 0xb3, 0xf5, 0xae, 0x4e, 0x91, 0x67, 0x8b, 0x65, 0xb0, 0xec, 0xec, 0x2f, 0x7d, 0x64,
+	0x1f, 0xd4, 0x75, 0x0e, 0x9c, 0x62, 0xdd, 0xd9, 0x83, 0xae, 0xc2, 0x20, 0xcc, 0xdd, 0x18, 0x2a,
+	0x58, 0x45, 0x2a, 0x41, 0xd6, 0xab, 0x51, 0x61, 0x27, 0x09, 0xac, 0xa2, 0xa8, 0x70, 0x19, 0x44,
+	0xfa, 0x2b, 0xb9, 0xee, 0x67, 0x6f, 0x21, 0xce, 0xa4, 0x5d, 0x42, 0x76, 0x67, 0x73, 0x3f, 0xba,
+	0xe9, 0x7b, 0x91, 0x6b, 0xbc, 0x7b, 0x17, 0xd6, 0x61, 0xa4, 0x2b, 0xd2, 0x5b, 0x20, 0x24, 0xa1,
+	0x82, 0x94, 0xa3, 0x56, 0xb6, 0x6b, 0xc2, 0x19, 0x20, 0x46, 0x29, 0x20, 0xfb, 0xd0, 0x06, 0x7a,
+	0x58, 0x15, 0xe8, 0x05, 0x42, 0x12, 0x94, 0xc4, 0x3f, 0x38, 0xad, 0x0f, 0xc8, 0x16, 0xff, 0xbd,
+	0x8c, 0x4f, 0x9b, 0x7b, 0x07, 0xdd, 0xc3, 0xa7, 0xcd, 0x3d, 0xda, 0xbd, 0xf1, 0xe8, 0xc7, 0xd7,
+	0x97, 0xfd, 0xc6, 0x9b, 0xcb, 0x7e, 0xe3, 0xef, 0xcb, 0x7e, 0xe3, 0x8f, 0xab, 0xfe, 0xd6, 0x9b,
+	0xab, 0xfe, 0xd6, 0x9f, 0x57, 0xfd, 0xad, 0x5f, 0xbe, 0x48, 0xb9, 0x36, 0x81, 0x62, 0x39, 0x1b,
+	0xbf, 0xf3, 0x94, 0xda, 0x3f, 0xf3, 0x94, 0x9e, 0x97, 0x9e, 0x55, 0xf3, 0x26, 0xe1, 0xd9, 0x8e,
+	0x7d, 0x55, 0x1f, 0xfe, 0x1b, 0x00, 0x00, 0xff, 0xff, 0xf3, 0xf8, 0xc7, 0x26, 0x79, 0x09, 0x00,
+	0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -175,6 +381,284 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.UsedRegistrationMessages) > 0 {
+		for iNdEx := len(m.UsedRegistrationMessages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UsedRegistrationMessages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xe2
+		}
+	}
+	if len(m.EntityUnbondings) > 0 {
+		for iNdEx := len(m.EntityUnbondings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EntityUnbondings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xda
+		}
+	}
+	if len(m.PendingRewards) > 0 {
+		for iNdEx := len(m.PendingRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xd2
+		}
+	}
+	if len(m.StreamRevenues) > 0 {
+		for iNdEx := len(m.StreamRevenues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StreamRevenues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if len(m.StreamPayments) > 0 {
+		for iNdEx := len(m.StreamPayments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StreamPayments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if len(m.StreamPrices) > 0 {
+		for iNdEx := len(m.StreamPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StreamPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if len(m.PolicyVersions) > 0 {
+		for iNdEx := len(m.PolicyVersions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PolicyVersions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if len(m.Relationships) > 0 {
+		for iNdEx := len(m.Relationships) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Relationships[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.DefaultTarget) > 0 {
+		i -= len(m.DefaultTarget)
+		copy(dAtA[i:], m.DefaultTarget)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.DefaultTarget)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if len(m.Targets) > 0 {
+		for iNdEx := len(m.Targets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Targets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.DeadLetters) > 0 {
+		dAtA2 := make([]byte, len(m.DeadLetters)*10)
+		var j1 int
+		for _, num := range m.DeadLetters {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintGenesis(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.NextCommandId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextCommandId))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.IcaCommands) > 0 {
+		for iNdEx := len(m.IcaCommands) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IcaCommands[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.EntityCommands) > 0 {
+		for iNdEx := len(m.EntityCommands) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EntityCommands[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.IcaPackets) > 0 {
+		for iNdEx := len(m.IcaPackets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IcaPackets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.Views) > 0 {
+		for iNdEx := len(m.Views) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Views[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.Entities) > 0 {
+		for iNdEx := len(m.Entities) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.RoleHolders) > 0 {
+		for iNdEx := len(m.RoleHolders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RoleHolders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.StreamGrants) > 0 {
+		for iNdEx := len(m.StreamGrants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StreamGrants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -273,6 +757,116 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.StreamGrants) > 0 {
+		for _, e := range m.StreamGrants {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RoleHolders) > 0 {
+		for _, e := range m.RoleHolders {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Entities) > 0 {
+		for _, e := range m.Entities {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Views) > 0 {
+		for _, e := range m.Views {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.IcaPackets) > 0 {
+		for _, e := range m.IcaPackets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.EntityCommands) > 0 {
+		for _, e := range m.EntityCommands {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.IcaCommands) > 0 {
+		for _, e := range m.IcaCommands {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextCommandId != 0 {
+		n += 2 + sovGenesis(uint64(m.NextCommandId))
+	}
+	if len(m.DeadLetters) > 0 {
+		l = 0
+		for _, e := range m.DeadLetters {
+			l += sovGenesis(uint64(e))
+		}
+		n += 2 + sovGenesis(uint64(l)) + l
+	}
+	if len(m.Targets) > 0 {
+		for _, e := range m.Targets {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = len(m.DefaultTarget)
+	if l > 0 {
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Relationships) > 0 {
+		for _, e := range m.Relationships {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PolicyVersions) > 0 {
+		for _, e := range m.PolicyVersions {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.StreamPrices) > 0 {
+		for _, e := range m.StreamPrices {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.StreamPayments) > 0 {
+		for _, e := range m.StreamPayments {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.StreamRevenues) > 0 {
+		for _, e := range m.StreamRevenues {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingRewards) > 0 {
+		for _, e := range m.PendingRewards {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.EntityUnbondings) > 0 {
+		for _, e := range m.EntityUnbondings {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.UsedRegistrationMessages) > 0 {
+		for _, e := range m.UsedRegistrationMessages {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -536,6 +1130,677 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamGrants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StreamGrants = append(m.StreamGrants, StreamGrant{})
+			if err := m.StreamGrants[len(m.StreamGrants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoleHolders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoleHolders = append(m.RoleHolders, RoleHolder{})
+			if err := m.RoleHolders[len(m.RoleHolders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entities", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entities = append(m.Entities, Entity{})
+			if err := m.Entities[len(m.Entities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Views", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Views = append(m.Views, View{})
+			if err := m.Views[len(m.Views)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IcaPackets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IcaPackets = append(m.IcaPackets, IcaPacket{})
+			if err := m.IcaPackets[len(m.IcaPackets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntityCommands", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EntityCommands = append(m.EntityCommands, EntityCommand{})
+			if err := m.EntityCommands[len(m.EntityCommands)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IcaCommands", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IcaCommands = append(m.IcaCommands, IcaCommand{})
+			if err := m.IcaCommands[len(m.IcaCommands)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextCommandId", wireType)
+			}
+			m.NextCommandId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextCommandId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.DeadLetters = append(m.DeadLetters, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.DeadLetters) == 0 {
+					m.DeadLetters = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.DeadLetters = append(m.DeadLetters, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadLetters", wireType)
+			}
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Targets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Targets = append(m.Targets, SourcehubTarget{})
+			if err := m.Targets[len(m.Targets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultTarget", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DefaultTarget = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relationships", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relationships = append(m.Relationships, Relationship{})
+			if err := m.Relationships[len(m.Relationships)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyVersions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PolicyVersions = append(m.PolicyVersions, PolicyVersion{})
+			if err := m.PolicyVersions[len(m.PolicyVersions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StreamPrices = append(m.StreamPrices, StreamPrice{})
+			if err := m.StreamPrices[len(m.StreamPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamPayments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StreamPayments = append(m.StreamPayments, StreamPayment{})
+			if err := m.StreamPayments[len(m.StreamPayments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamRevenues", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StreamRevenues = append(m.StreamRevenues, StreamRevenue{})
+			if err := m.StreamRevenues[len(m.StreamRevenues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingRewards = append(m.PendingRewards, PendingReward{})
+			if err := m.PendingRewards[len(m.PendingRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntityUnbondings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EntityUnbondings = append(m.EntityUnbondings, EntityUnbonding{})
+			if err := m.EntityUnbondings[len(m.EntityUnbondings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsedRegistrationMessages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UsedRegistrationMessages = append(m.UsedRegistrationMessages, UsedRegistrationMessage{})
+			if err := m.UsedRegistrationMessages[len(m.UsedRegistrationMessages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
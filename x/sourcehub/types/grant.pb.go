@@ -0,0 +1,590 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: shinzonetwork/sourcehub/v1/grant.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// StreamGrant records a subscriber relationship set on SourceHub through
+// MsgRequestStreamAccess.
+type StreamGrant struct {
+	Resource Resource `protobuf:"varint,1,opt,name=resource,proto3,enum=shinzonetwork.sourcehub.v1.Resource" json:"resource,omitempty"`
+	StreamId string   `protobuf:"bytes,2,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	// DID of the subscriber
+	Did string `protobuf:"bytes,3,opt,name=did,proto3" json:"did,omitempty"`
+	// Unix time in seconds after which the grant is revoked, 0 never expires
+	Expiration uint64 `protobuf:"varint,4,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	// Admin that requested or last renewed the grant
+	Signer string `protobuf:"bytes,5,opt,name=signer,proto3" json:"signer,omitempty"`
+	// Block height the grant was created at
+	GrantedHeight int64 `protobuf:"varint,6,opt,name=granted_height,json=grantedHeight,proto3" json:"granted_height,omitempty"`
+	// Chain ID of the SourceHub target holding the relationship
+	Target string `protobuf:"bytes,7,opt,name=target,proto3" json:"target,omitempty"`
+}
+
+func (m *StreamGrant) Reset()         { *m = StreamGrant{} }
+func (m *StreamGrant) String() string { return proto.CompactTextString(m) }
+func (*StreamGrant) ProtoMessage()    {}
+func (*StreamGrant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b765b1d700bd1388, []int{0}
+}
+func (m *StreamGrant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StreamGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamGrant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StreamGrant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamGrant.Merge(m, src)
+}
+func (m *StreamGrant) XXX_Size() int {
+	return m.Size()
+}
+func (m *StreamGrant) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamGrant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamGrant proto.InternalMessageInfo
+
+func (m *StreamGrant) GetResource() Resource {
+	if m != nil {
+		return m.Resource
+	}
+	return Resource_RESOURCE_PRIMITIVE
+}
+
+func (m *StreamGrant) GetStreamId() string {
+	if m != nil {
+		return m.StreamId
+	}
+	return ""
+}
+
+func (m *StreamGrant) GetDid() string {
+	if m != nil {
+		return m.Did
+	}
+	return ""
+}
+
+func (m *StreamGrant) GetExpiration() uint64 {
+	if m != nil {
+		return m.Expiration
+	}
+	return 0
+}
+
+func (m *StreamGrant) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *StreamGrant) GetGrantedHeight() int64 {
+	if m != nil {
+		return m.GrantedHeight
+	}
+	return 0
+}
+
+func (m *StreamGrant) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*StreamGrant)(nil), "shinzonetwork.sourcehub.v1.StreamGrant")
+}
+
+func init() {
+	proto.RegisterFile("shinzonetwork/sourcehub/v1/grant.proto", fileDescriptor_b765b1d700bd1388)
+}
+
+var fileDescriptor_b765b1d700bd1388 = []byte{
+	// 296 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x90, 0xcf, 0x4a, 0xc3, 0x40,
+	0x18, 0xc4, 0xb3, 0xa6, 0xc6, 0x76, 0xc5, 0x22, 0x7b, 0x90, 0xa5, 0xc2, 0x12, 0xfc, 0x47, 0x4e,
+	0x09, 0x55, 0x1f, 0x40, 0xbc, 0xa8, 0x17, 0x0f, 0xf1, 0xe6, 0xa5, 0xa4, 0xcd, 0x47, 0xb2, 0x48,
+	0xb3, 0x61, 0xf3, 0xb5, 0x46, 0x9f, 0xc2, 0xc7, 0xf2, 0xd8, 0xa3, 0x47, 0x49, 0x9e, 0xc1, 0xbb,
+	0x64, 0x13, 0x4a, 0x15, 0xf4, 0xb6, 0x33, 0xcc, 0xcc, 0xf2, 0xfd, 0xe8, 0x59, 0x91, 0xca, 0xec,
+	0x55, 0x65, 0x80, 0xcf, 0x4a, 0x3f, 0x05, 0x85, 0x5a, 0xe8, 0x19, 0xa4, 0x8b, 0x69, 0xb0, 0x1c,
+	0x07, 0x89, 0x8e, 0x32, 0xf4, 0x73, 0xad, 0x50, 0xb1, 0xd1, 0x8f, 0x9c, 0xbf, 0xce, 0xf9, 0xcb,
+	0xf1, 0xe8, 0xf8, 0x9f, 0x0d, 0x2c, 0xdb, 0x81, 0xa3, 0x2f, 0x42, 0x77, 0x1f, 0x50, 0x43, 0x34,
+	0xbf, 0x69, 0x66, 0xd9, 0x15, 0xed, 0x6b, 0x68, 0xa3, 0x9c, 0xb8, 0xc4, 0x1b, 0x9e, 0x9f, 0xf8,
+	0x7f, 0xff, 0xe1, 0x87, 0x5d, 0x36, 0x5c, 0xb7, 0xd8, 0x21, 0x1d, 0x14, 0x66, 0x70, 0x22, 0x63,
+	0xbe, 0xe5, 0x12, 0x6f, 0x10, 0xf6, 0x5b, 0xe3, 0x2e, 0x66, 0xfb, 0xd4, 0x8e, 0x65, 0xcc, 0x6d,
+	0x63, 0x37, 0x4f, 0x26, 0x28, 0x85, 0x32, 0x97, 0x3a, 0x42, 0xa9, 0x32, 0xde, 0x73, 0x89, 0xd7,
+	0x0b, 0x37, 0x1c, 0x76, 0x40, 0x9d, 0x42, 0x26, 0x19, 0x68, 0xbe, 0x6d, 0x4a, 0x9d, 0x62, 0xa7,
+	0x74, 0x68, 0x40, 0x40, 0x3c, 0x49, 0x41, 0x26, 0x29, 0x72, 0xc7, 0x25, 0x9e, 0x1d, 0xee, 0x75,
+	0xee, 0xad, 0x31, 0x9b, 0x3a, 0x46, 0x3a, 0x01, 0xe4, 0x3b, 0x6d, 0xbd, 0x55, 0xd7, 0xf7, 0xef,
+	0x95, 0x20, 0xab, 0x4a, 0x90, 0xcf, 0x4a, 0x90, 0xb7, 0x5a, 0x58, 0xab, 0x5a, 0x58, 0x1f, 0xb5,
+	0xb0, 0x1e, 0x2f, 0x13, 0x89, 0xcd, 0x75, 0x33, 0x35, 0x0f, 0x7e, 0x11, 0x34, 0xaa, 0x21, 0x58,
+	0x6e, 0xd0, 0xc4, 0x97, 0x1c, 0x8a, 0xa9, 0x63, 0x70, 0x5e, 0x7c, 0x07, 0x00, 0x00, 0xff, 0xff,
+	0xde, 0x45, 0x37, 0xed, 0xb9, 0x01, 0x00, 0x00,
+}
+
+func (m *StreamGrant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamGrant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Target) > 0 {
+		i -= len(m.Target)
+		copy(dAtA[i:], m.Target)
+		i = encodeVarintGrant(dAtA, i, uint64(len(m.Target)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.GrantedHeight != 0 {
+		i = encodeVarintGrant(dAtA, i, uint64(m.GrantedHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintGrant(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Expiration != 0 {
+		i = encodeVarintGrant(dAtA, i, uint64(m.Expiration))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintGrant(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.StreamId) > 0 {
+		i -= len(m.StreamId)
+		copy(dAtA[i:], m.StreamId)
+		i = encodeVarintGrant(dAtA, i, uint64(len(m.StreamId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Resource != 0 {
+		i = encodeVarintGrant(dAtA, i, uint64(m.Resource))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGrant(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGrant(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *StreamGrant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Resource != 0 {
+		n += 1 + sovGrant(uint64(m.Resource))
+	}
+	l = len(m.StreamId)
+	if l > 0 {
+		n += 1 + l + sovGrant(uint64(l))
+	}
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovGrant(uint64(l))
+	}
+	if m.Expiration != 0 {
+		n += 1 + sovGrant(uint64(m.Expiration))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovGrant(uint64(l))
+	}
+	if m.GrantedHeight != 0 {
+		n += 1 + sovGrant(uint64(m.GrantedHeight))
+	}
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovGrant(uint64(l))
+	}
+	return n
+}
+
+func sovGrant(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGrant(x uint64) (n int) {
+	return sovGrant(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *StreamGrant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGrant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StreamGrant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StreamGrant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+			}
+			m.Resource = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Resource |= Resource(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StreamId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			m.Expiration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Expiration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GrantedHeight", wireType)
+			}
+			m.GrantedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GrantedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Target = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGrant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGrant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGrant(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGrant
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGrant
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGrant
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGrant
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGrant
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGrant
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGrant        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGrant          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGrant = fmt.Errorf("proto: unexpected end of group")
+)
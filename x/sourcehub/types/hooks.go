@@ -0,0 +1,78 @@
+package types
+
+import (
+	"context"
+	"errors"
+)
+
+// SourcehubHooks lets other modules react to the entity and stream access
+// lifecycle of the sourcehub module. An error returned by a hook aborts the
+// state change, except for AfterStreamAccessRevoked, called in EndBlocker, and
+// AfterIcaAck, called while relaying an acknowledgement, whose errors are only
+// logged.
+type SourcehubHooks interface {
+	// AfterEntityRegistered is called when an entity is registered, or
+	// registered again, and its group membership was queued for SourceHub.
+	AfterEntityRegistered(ctx context.Context, entity Entity) error
+	// AfterEntityRemoved is called when an entity is unregistered, or blocked
+	// by the admin. A blocked entity is passed in its BLOCKED status.
+	AfterEntityRemoved(ctx context.Context, entity Entity) error
+	// AfterStreamAccessGranted is called when a stream grant is created or
+	// its expiration is renewed.
+	AfterStreamAccessGranted(ctx context.Context, grant StreamGrant) error
+	// AfterStreamAccessRevoked is called when an expired stream grant is
+	// removed.
+	AfterStreamAccessRevoked(ctx context.Context, grant StreamGrant) error
+	// AfterIcaAck is called once SourceHub acknowledged a tracked packet and
+	// its commands were resolved.
+	AfterIcaAck(ctx context.Context, packet IcaPacket) error
+}
+
+var _ SourcehubHooks = MultiSourcehubHooks{}
+
+// MultiSourcehubHooks calls several SourcehubHooks in order.
+type MultiSourcehubHooks []SourcehubHooks
+
+func NewMultiSourcehubHooks(hooks ...SourcehubHooks) MultiSourcehubHooks {
+	return hooks
+}
+
+func (h MultiSourcehubHooks) AfterEntityRegistered(ctx context.Context, entity Entity) error {
+	var errs error
+	for i := range h {
+		errs = errors.Join(errs, h[i].AfterEntityRegistered(ctx, entity))
+	}
+	return errs
+}
+
+func (h MultiSourcehubHooks) AfterEntityRemoved(ctx context.Context, entity Entity) error {
+	var errs error
+	for i := range h {
+		errs = errors.Join(errs, h[i].AfterEntityRemoved(ctx, entity))
+	}
+	return errs
+}
+
+func (h MultiSourcehubHooks) AfterStreamAccessGranted(ctx context.Context, grant StreamGrant) error {
+	var errs error
+	for i := range h {
+		errs = errors.Join(errs, h[i].AfterStreamAccessGranted(ctx, grant))
+	}
+	return errs
+}
+
+func (h MultiSourcehubHooks) AfterStreamAccessRevoked(ctx context.Context, grant StreamGrant) error {
+	var errs error
+	for i := range h {
+		errs = errors.Join(errs, h[i].AfterStreamAccessRevoked(ctx, grant))
+	}
+	return errs
+}
+
+func (h MultiSourcehubHooks) AfterIcaAck(ctx context.Context, packet IcaPacket) error {
+	var errs error
+	for i := range h {
+		errs = errors.Join(errs, h[i].AfterIcaAck(ctx, packet))
+	}
+	return errs
+}
@@ -18,7 +18,7 @@ var (
 )
 
 const (
-	// Prefix for metadata values
+	// Legacy raw ICA metadata values, migrated to the "sourcehub" target in v3
 	KeyConnectionID     = "controller_connection_id"
 	KeyHostConnectionID = "host_connection_id"
 	KeyVersion          = "version"
@@ -34,13 +34,51 @@ const (
 	GroupHostName    = "host"
 	GroupIndexerName = "indexer"
 
+	// Legacy raw entity mappings, migrated to the entity store in v2
 	AddrRolePrefix = "addr_role:" // addr_role:<address>:<role>
 	DIDRolePrefix  = "did_role:"  // did_role:<did>:<role>
 )
 
 // Store prefixes
 var (
-	KeyPrefixParams = collections.NewPrefix(0)
+	KeyPrefixParams     = collections.NewPrefix(0)
+	KeyPrefixIcaPackets = collections.NewPrefix(1)
+	// KeyPrefixGrantsV3 held the grants keyed without their target, migrated
+	// to KeyPrefixGrants in v4
+	KeyPrefixGrantsV3             = collections.NewPrefix(2)
+	KeyPrefixGrantsByExpirationV3 = collections.NewPrefix(3)
+	KeyPrefixRoleHolders          = collections.NewPrefix(4)
+	KeyPrefixEntities             = collections.NewPrefix(5)
+	KeyPrefixEntitiesByDid        = collections.NewPrefix(6)
+	KeyPrefixEntitiesByPid        = collections.NewPrefix(7)
+	KeyPrefixEntityCommands       = collections.NewPrefix(8)
+	KeyPrefixViews                = collections.NewPrefix(9)
+	KeyPrefixUsedRegistrations    = collections.NewPrefix(10)
+	KeyPrefixIcaCommands          = collections.NewPrefix(11)
+	KeyPrefixNextCommandId        = collections.NewPrefix(12)
+	KeyPrefixOutbox               = collections.NewPrefix(13)
+	KeyPrefixDeadLetters          = collections.NewPrefix(14)
+	// KeyPrefixIcaChannel held the single ICA channel, migrated to the targets in v3
+	KeyPrefixIcaChannel    = collections.NewPrefix(15)
+	KeyPrefixTargets       = collections.NewPrefix(16)
+	KeyPrefixDefaultTarget = collections.NewPrefix(17)
+	// KeyPrefixRelationshipsV3 held the mirror keyed without the target,
+	// migrated to KeyPrefixRelationships in v4
+	KeyPrefixRelationshipsV3        = collections.NewPrefix(18)
+	KeyPrefixRelationshipsByActorV3 = collections.NewPrefix(19)
+	KeyPrefixPolicyVersions         = collections.NewPrefix(20)
+	KeyPrefixStreamPrices           = collections.NewPrefix(21)
+	KeyPrefixStreamPayments         = collections.NewPrefix(22)
+	KeyPrefixStreamRevenues         = collections.NewPrefix(23)
+	KeyPrefixPendingRewards         = collections.NewPrefix(24)
+	KeyPrefixEntityUnbondings       = collections.NewPrefix(25)
+	KeyPrefixRevocationCursor       = collections.NewPrefix(26)
+	KeyPrefixGrants                 = collections.NewPrefix(27)
+	KeyPrefixGrantsByExpiration     = collections.NewPrefix(28)
+	// KeyPrefixRelationships mirrors the ACP relationships sent to SourceHub
+	KeyPrefixRelationships        = collections.NewPrefix(29)
+	KeyPrefixRelationshipsByActor = collections.NewPrefix(30)
+	KeyPrefixRevenueCursor        = collections.NewPrefix(31)
 )
 
 const (
@@ -0,0 +1,39 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+var moduleRoleNames = map[ModuleRole]string{
+	ModuleRole_MODULE_ROLE_POLICY_ADMIN:     "policy-admin",
+	ModuleRole_MODULE_ROLE_OBJECT_REGISTRAR: "object-registrar",
+	ModuleRole_MODULE_ROLE_ACCESS_GRANTER:   "access-granter",
+}
+
+// ModuleRoleName returns the CLI name of a module role, e.g. "policy-admin".
+func ModuleRoleName(role ModuleRole) string {
+	if name, ok := moduleRoleNames[role]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+// ParseModuleRole parses a module role from its CLI name.
+func ParseModuleRole(name string) (ModuleRole, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	for role, n := range moduleRoleNames {
+		if n == name {
+			return role, nil
+		}
+	}
+	return ModuleRole_MODULE_ROLE_UNSPECIFIED, fmt.Errorf("invalid role %q, expected policy-admin, object-registrar or access-granter", name)
+}
+
+// ValidateModuleRole checks that role is one of the assignable module roles.
+func ValidateModuleRole(role ModuleRole) error {
+	if _, ok := moduleRoleNames[role]; !ok {
+		return fmt.Errorf("invalid module role %d", role)
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = &MsgBackfillObjects{}
+
+// Route returns the module name
+func (m *MsgBackfillObjects) Route() string { return RouterKey }
+
+// Type returns the action
+func (m *MsgBackfillObjects) Type() string { return "BackfillObjects" }
+
+// GetSigners defines whose signature is required
+func (m *MsgBackfillObjects) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Signer)
+	if err != nil {
+		panic(err) // should never happen because ValidateBasic catches it
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic runs stateless checks
+func (m *MsgBackfillObjects) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Signer); err != nil {
+		return fmt.Errorf("invalid signer address: %w", err)
+	}
+
+	if _, ok := Resource_name[int32(m.Resource)]; !ok {
+		return fmt.Errorf("invalid resource %d", m.Resource)
+	}
+
+	if len(m.Ids) == 0 {
+		return fmt.Errorf("no object ID given")
+	}
+	for _, id := range m.Ids {
+		if id == "" {
+			return fmt.Errorf("empty object ID")
+		}
+	}
+
+	return nil
+}
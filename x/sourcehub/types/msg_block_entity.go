@@ -0,0 +1,41 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = &MsgBlockEntity{}
+
+// Route returns the module name
+func (m *MsgBlockEntity) Route() string { return RouterKey }
+
+// Type returns the action
+func (m *MsgBlockEntity) Type() string { return "BlockEntity" }
+
+// GetSigners defines whose signature is required
+func (m *MsgBlockEntity) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Signer)
+	if err != nil {
+		panic(err) // should never happen because ValidateBasic catches it
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic runs stateless checks
+func (m *MsgBlockEntity) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Signer); err != nil {
+		return fmt.Errorf("invalid signer address: %w", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Owner); err != nil {
+		return fmt.Errorf("invalid owner address: %w", err)
+	}
+
+	if _, ok := EntityRole_name[int32(m.Role)]; !ok {
+		return fmt.Errorf("invalid entity role %d", m.Role)
+	}
+
+	return nil
+}
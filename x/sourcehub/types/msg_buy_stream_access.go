@@ -0,0 +1,41 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = &MsgBuyStreamAccess{}
+
+// Route returns the module name
+func (m *MsgBuyStreamAccess) Route() string { return RouterKey }
+
+// Type returns the action
+func (m *MsgBuyStreamAccess) Type() string { return "BuyStreamAccess" }
+
+// GetSigners defines whose signature is required
+func (m *MsgBuyStreamAccess) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Buyer)
+	if err != nil {
+		panic(err) // should never happen because ValidateBasic catches it
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic runs stateless checks
+func (m *MsgBuyStreamAccess) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Buyer); err != nil {
+		return fmt.Errorf("invalid buyer address: %w", err)
+	}
+
+	if m.StreamId == "" {
+		return fmt.Errorf("Stream ID cannot be empty")
+	}
+
+	if m.Did == "" {
+		return fmt.Errorf("DID cannot be empty")
+	}
+
+	return nil
+}
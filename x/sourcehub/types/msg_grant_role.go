@@ -0,0 +1,38 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Make sure your generated MsgGrantRole implements sdk.Msg
+var _ sdk.Msg = &MsgGrantRole{}
+
+// Route returns the module name
+func (m *MsgGrantRole) Route() string { return RouterKey }
+
+// Type returns the action
+func (m *MsgGrantRole) Type() string { return "GrantRole" }
+
+// GetSigners defines whose signature is required
+func (m *MsgGrantRole) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Signer)
+	if err != nil {
+		panic(err) // should never happen because ValidateBasic catches it
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic runs stateless checks
+func (m *MsgGrantRole) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Signer); err != nil {
+		return fmt.Errorf("invalid signer address: %w", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Address); err != nil {
+		return fmt.Errorf("invalid role holder address: %w", err)
+	}
+
+	return ValidateModuleRole(m.Role)
+}
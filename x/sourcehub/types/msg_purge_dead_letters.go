@@ -0,0 +1,42 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Make sure your generated MsgPurgeDeadLetters implements sdk.Msg
+var _ sdk.Msg = &MsgPurgeDeadLetters{}
+
+// Route returns the module name
+func (m *MsgPurgeDeadLetters) Route() string { return RouterKey }
+
+// Type returns the action
+func (m *MsgPurgeDeadLetters) Type() string { return "PurgeDeadLetters" }
+
+// GetSigners defines whose signature is required
+func (m *MsgPurgeDeadLetters) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Signer)
+	if err != nil {
+		panic(err) // should never happen because ValidateBasic catches it
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic runs stateless checks
+func (m *MsgPurgeDeadLetters) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Signer); err != nil {
+		return fmt.Errorf("invalid signer address: %w", err)
+	}
+
+	seen := make(map[uint64]struct{}, len(m.Ids))
+	for _, id := range m.Ids {
+		if _, ok := seen[id]; ok {
+			return fmt.Errorf("duplicate command %d", id)
+		}
+		seen[id] = struct{}{}
+	}
+
+	return nil
+}
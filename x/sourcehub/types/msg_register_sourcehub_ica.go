@@ -38,6 +38,10 @@ func (m *MsgRegisterSourcehubICA) ValidateBasic() error {
 		return fmt.Errorf("host connection ID cannot be empty")
 	}
 
+	if m.ChainId == "" {
+		return fmt.Errorf("chain ID cannot be empty")
+	}
+
 	// TODO: validate admin
 
 	return nil
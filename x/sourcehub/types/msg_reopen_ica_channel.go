@@ -0,0 +1,34 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Make sure your generated MsgReopenICAChannel implements sdk.Msg
+var _ sdk.Msg = &MsgReopenICAChannel{}
+
+// Route returns the module name
+func (m *MsgReopenICAChannel) Route() string { return RouterKey }
+
+// Type returns the action
+func (m *MsgReopenICAChannel) Type() string { return "ReopenICAChannel" }
+
+// GetSigners defines whose signature is required
+func (m *MsgReopenICAChannel) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Signer)
+	if err != nil {
+		panic(err) // should never happen because ValidateBasic catches it
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic runs stateless checks
+func (m *MsgReopenICAChannel) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Signer); err != nil {
+		return fmt.Errorf("invalid signer address: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,38 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Make sure your generated MsgRevokeRole implements sdk.Msg
+var _ sdk.Msg = &MsgRevokeRole{}
+
+// Route returns the module name
+func (m *MsgRevokeRole) Route() string { return RouterKey }
+
+// Type returns the action
+func (m *MsgRevokeRole) Type() string { return "RevokeRole" }
+
+// GetSigners defines whose signature is required
+func (m *MsgRevokeRole) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Signer)
+	if err != nil {
+		panic(err) // should never happen because ValidateBasic catches it
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic runs stateless checks
+func (m *MsgRevokeRole) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Signer); err != nil {
+		return fmt.Errorf("invalid signer address: %w", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Address); err != nil {
+		return fmt.Errorf("invalid role holder address: %w", err)
+	}
+
+	return ValidateModuleRole(m.Role)
+}
@@ -0,0 +1,38 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Make sure your generated MsgSetDefaultTarget implements sdk.Msg
+var _ sdk.Msg = &MsgSetDefaultTarget{}
+
+// Route returns the module name
+func (m *MsgSetDefaultTarget) Route() string { return RouterKey }
+
+// Type returns the action
+func (m *MsgSetDefaultTarget) Type() string { return "SetDefaultTarget" }
+
+// GetSigners defines whose signature is required
+func (m *MsgSetDefaultTarget) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Signer)
+	if err != nil {
+		panic(err) // should never happen because ValidateBasic catches it
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic runs stateless checks
+func (m *MsgSetDefaultTarget) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Signer); err != nil {
+		return fmt.Errorf("invalid signer address: %w", err)
+	}
+
+	if m.ChainId == "" {
+		return fmt.Errorf("chain ID cannot be empty")
+	}
+
+	return nil
+}
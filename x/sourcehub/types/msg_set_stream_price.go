@@ -0,0 +1,41 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = &MsgSetStreamPrice{}
+
+// Route returns the module name
+func (m *MsgSetStreamPrice) Route() string { return RouterKey }
+
+// Type returns the action
+func (m *MsgSetStreamPrice) Type() string { return "SetStreamPrice" }
+
+// GetSigners defines whose signature is required
+func (m *MsgSetStreamPrice) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Signer)
+	if err != nil {
+		panic(err) // should never happen because ValidateBasic catches it
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic runs stateless checks
+func (m *MsgSetStreamPrice) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Signer); err != nil {
+		return fmt.Errorf("invalid signer address: %w", err)
+	}
+
+	if m.StreamId == "" {
+		return fmt.Errorf("Stream ID cannot be empty")
+	}
+
+	if err := m.Amount.Validate(); err != nil {
+		return fmt.Errorf("invalid price: %w", err)
+	}
+
+	return nil
+}
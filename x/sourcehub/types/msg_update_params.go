@@ -0,0 +1,34 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Make sure your generated MsgUpdateParams implements sdk.Msg
+var _ sdk.Msg = &MsgUpdateParams{}
+
+// Route returns the module name
+func (m *MsgUpdateParams) Route() string { return RouterKey }
+
+// Type returns the action
+func (m *MsgUpdateParams) Type() string { return "UpdateParams" }
+
+// GetSigners defines whose signature is required
+func (m *MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		panic(err) // should never happen because ValidateBasic catches it
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic runs stateless checks
+func (m *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return fmt.Errorf("invalid authority address: %w", err)
+	}
+
+	return m.Params.Validate()
+}
@@ -0,0 +1,37 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Make sure your generated MsgUpdateShinzoPolicy implements sdk.Msg
+var _ sdk.Msg = &MsgUpdateShinzoPolicy{}
+
+// Route returns the module name
+func (m *MsgUpdateShinzoPolicy) Route() string { return RouterKey }
+
+// Type returns the action
+func (m *MsgUpdateShinzoPolicy) Type() string { return "UpdateShinzoPolicy" }
+
+// GetSigners defines whose signature is required
+func (m *MsgUpdateShinzoPolicy) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		panic(err) // should never happen because ValidateBasic catches it
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic runs stateless checks
+func (m *MsgUpdateShinzoPolicy) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return fmt.Errorf("invalid authority address: %w", err)
+	}
+	if m.Policy == "" {
+		return fmt.Errorf("policy is required")
+	}
+
+	return nil
+}
@@ -0,0 +1,32 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = &MsgWithdrawRewards{}
+
+// Route returns the module name
+func (m *MsgWithdrawRewards) Route() string { return RouterKey }
+
+// Type returns the action
+func (m *MsgWithdrawRewards) Type() string { return "WithdrawRewards" }
+
+// GetSigners defines whose signature is required
+func (m *MsgWithdrawRewards) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Owner)
+	if err != nil {
+		panic(err) // should never happen because ValidateBasic catches it
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic runs stateless checks
+func (m *MsgWithdrawRewards) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Owner); err != nil {
+		return fmt.Errorf("invalid owner address: %w", err)
+	}
+	return nil
+}
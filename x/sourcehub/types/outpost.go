@@ -0,0 +1,64 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// OutpostPortID is the port Outpost contracts open channels to
+	OutpostPortID = "outpost"
+	// OutpostVersion is the version of the outpost channels
+	OutpostVersion = "shinzo-outpost-1"
+)
+
+// GetBytes returns the sorted JSON encoding of the packet data, as sent by an
+// Outpost.
+func (d OutpostPaymentPacketData) GetBytes() []byte {
+	bz, err := json.Marshal(d)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(bz)
+}
+
+// StreamResource returns the resource of the stream paid for.
+func (d OutpostPaymentPacketData) StreamResource() (Resource, error) {
+	switch d.Resource {
+	case PrimitiveResourceName:
+		return Resource_RESOURCE_PRIMITIVE, nil
+	case ViewResourceName:
+		return Resource_RESOURCE_VIEW, nil
+	default:
+		return 0, fmt.Errorf("invalid resource %q, expected %q or %q", d.Resource, PrimitiveResourceName, ViewResourceName)
+	}
+}
+
+// ValidateBasic checks the packet data without reading the state.
+func (d OutpostPaymentPacketData) ValidateBasic() error {
+	if d.Did == "" {
+		return errors.New("did cannot be empty")
+	}
+	if d.StreamId == "" {
+		return errors.New("stream ID cannot be empty")
+	}
+	if _, err := d.StreamResource(); err != nil {
+		return err
+	}
+	if !d.Amount.IsValid() || d.Amount.IsZero() {
+		return fmt.Errorf("invalid amount %q", d.Amount)
+	}
+	return nil
+}
+
+// GetBytes returns the JSON encoding of the acknowledgement result.
+func (a OutpostPaymentAcknowledgement) GetBytes() []byte {
+	bz, err := json.Marshal(a)
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
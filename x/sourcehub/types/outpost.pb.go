@@ -0,0 +1,882 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: shinzonetwork/sourcehub/v1/outpost.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// OutpostPaymentPacketData is sent, JSON encoded, over the outpost port by an
+// Outpost contract once a payment for stream access succeeded on its chain.
+type OutpostPaymentPacketData struct {
+	// DID granted access to the stream
+	Did string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
+	// ACP resource of the stream, "primitive" or "view"
+	Resource string `protobuf:"bytes,2,opt,name=resource,proto3" json:"resource,omitempty"`
+	StreamId string `protobuf:"bytes,3,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	// Amount paid on the Outpost chain
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// Unix time in seconds the access bought expires at, 0 never expires
+	Expiry uint64 `protobuf:"varint,5,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	// Account that paid on the Outpost chain
+	Payer string `protobuf:"bytes,6,opt,name=payer,proto3" json:"payer,omitempty"`
+	// SourceHub target, the default target if empty. Renewals keep the target
+	// of the existing grant.
+	Target string `protobuf:"bytes,7,opt,name=target,proto3" json:"target,omitempty"`
+}
+
+func (m *OutpostPaymentPacketData) Reset()         { *m = OutpostPaymentPacketData{} }
+func (m *OutpostPaymentPacketData) String() string { return proto.CompactTextString(m) }
+func (*OutpostPaymentPacketData) ProtoMessage()    {}
+func (*OutpostPaymentPacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36cc8bb8af407e13, []int{0}
+}
+func (m *OutpostPaymentPacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OutpostPaymentPacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OutpostPaymentPacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OutpostPaymentPacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OutpostPaymentPacketData.Merge(m, src)
+}
+func (m *OutpostPaymentPacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *OutpostPaymentPacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_OutpostPaymentPacketData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OutpostPaymentPacketData proto.InternalMessageInfo
+
+func (m *OutpostPaymentPacketData) GetDid() string {
+	if m != nil {
+		return m.Did
+	}
+	return ""
+}
+
+func (m *OutpostPaymentPacketData) GetResource() string {
+	if m != nil {
+		return m.Resource
+	}
+	return ""
+}
+
+func (m *OutpostPaymentPacketData) GetStreamId() string {
+	if m != nil {
+		return m.StreamId
+	}
+	return ""
+}
+
+func (m *OutpostPaymentPacketData) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *OutpostPaymentPacketData) GetExpiry() uint64 {
+	if m != nil {
+		return m.Expiry
+	}
+	return 0
+}
+
+func (m *OutpostPaymentPacketData) GetPayer() string {
+	if m != nil {
+		return m.Payer
+	}
+	return ""
+}
+
+func (m *OutpostPaymentPacketData) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+// OutpostPaymentAcknowledgement is the result of an accepted payment packet.
+type OutpostPaymentAcknowledgement struct {
+	// Unix time in seconds the access expires at, 0 never expires
+	Expiration uint64 `protobuf:"varint,1,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	// extended is set when the payment extended an existing grant, in which
+	// case no command is queued
+	Extended bool `protobuf:"varint,2,opt,name=extended,proto3" json:"extended,omitempty"`
+	// Outbox command carrying the subscriber relationship
+	CommandId uint64 `protobuf:"varint,3,opt,name=command_id,json=commandId,proto3" json:"command_id,omitempty"`
+}
+
+func (m *OutpostPaymentAcknowledgement) Reset()         { *m = OutpostPaymentAcknowledgement{} }
+func (m *OutpostPaymentAcknowledgement) String() string { return proto.CompactTextString(m) }
+func (*OutpostPaymentAcknowledgement) ProtoMessage()    {}
+func (*OutpostPaymentAcknowledgement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36cc8bb8af407e13, []int{1}
+}
+func (m *OutpostPaymentAcknowledgement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OutpostPaymentAcknowledgement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OutpostPaymentAcknowledgement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OutpostPaymentAcknowledgement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OutpostPaymentAcknowledgement.Merge(m, src)
+}
+func (m *OutpostPaymentAcknowledgement) XXX_Size() int {
+	return m.Size()
+}
+func (m *OutpostPaymentAcknowledgement) XXX_DiscardUnknown() {
+	xxx_messageInfo_OutpostPaymentAcknowledgement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OutpostPaymentAcknowledgement proto.InternalMessageInfo
+
+func (m *OutpostPaymentAcknowledgement) GetExpiration() uint64 {
+	if m != nil {
+		return m.Expiration
+	}
+	return 0
+}
+
+func (m *OutpostPaymentAcknowledgement) GetExtended() bool {
+	if m != nil {
+		return m.Extended
+	}
+	return false
+}
+
+func (m *OutpostPaymentAcknowledgement) GetCommandId() uint64 {
+	if m != nil {
+		return m.CommandId
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*OutpostPaymentPacketData)(nil), "shinzonetwork.sourcehub.v1.OutpostPaymentPacketData")
+	proto.RegisterType((*OutpostPaymentAcknowledgement)(nil), "shinzonetwork.sourcehub.v1.OutpostPaymentAcknowledgement")
+}
+
+func init() {
+	proto.RegisterFile("shinzonetwork/sourcehub/v1/outpost.proto", fileDescriptor_36cc8bb8af407e13)
+}
+
+var fileDescriptor_36cc8bb8af407e13 = []byte{
+	// 427 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x52, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0x8e, 0x9b, 0x34, 0x34, 0xcb, 0x05, 0x56, 0x15, 0x32, 0x41, 0x75, 0xa3, 0x9e, 0x2c, 0x24,
+	0xbc, 0x0a, 0x3f, 0x0f, 0x40, 0xe1, 0xd2, 0x0b, 0x54, 0x3e, 0x72, 0x41, 0x1b, 0xef, 0xc8, 0x59,
+	0x05, 0xef, 0x58, 0xde, 0x49, 0x1a, 0xf7, 0x29, 0xb8, 0xf0, 0x0e, 0x88, 0x13, 0x8f, 0xd1, 0x63,
+	0x8f, 0x9c, 0x00, 0x25, 0x07, 0x5e, 0x03, 0xed, 0xae, 0x89, 0x52, 0x2e, 0xf6, 0x7c, 0xdf, 0x7e,
+	0x1e, 0x7f, 0xf3, 0xed, 0xb0, 0xd4, 0xce, 0xb5, 0xb9, 0x46, 0x03, 0x74, 0x85, 0xcd, 0x42, 0x58,
+	0x5c, 0x36, 0x05, 0xcc, 0x97, 0x33, 0xb1, 0x9a, 0x0a, 0x5c, 0x52, 0x8d, 0x96, 0xb2, 0xba, 0x41,
+	0x42, 0x3e, 0xbe, 0xa3, 0xcc, 0x76, 0xca, 0x6c, 0x35, 0x1d, 0x3f, 0x94, 0x95, 0x36, 0x28, 0xfc,
+	0x33, 0xc8, 0xc7, 0x49, 0x81, 0xb6, 0x42, 0x2b, 0x66, 0xd2, 0x82, 0x58, 0x4d, 0x67, 0x40, 0x72,
+	0x2a, 0x0a, 0xd4, 0xa6, 0x3b, 0x3f, 0x2e, 0xb1, 0x44, 0x5f, 0x0a, 0x57, 0x05, 0xf6, 0xec, 0xcb,
+	0x01, 0x8b, 0xdf, 0x87, 0xdf, 0x5e, 0xca, 0xb6, 0x02, 0x43, 0x97, 0xb2, 0x58, 0x00, 0xbd, 0x95,
+	0x24, 0xf9, 0x03, 0xd6, 0x57, 0x5a, 0xc5, 0xd1, 0x24, 0x4a, 0x47, 0xb9, 0x2b, 0xf9, 0x98, 0x1d,
+	0x35, 0x10, 0x9c, 0xc4, 0x07, 0x9e, 0xde, 0x61, 0xfe, 0x84, 0x8d, 0x2c, 0x35, 0x20, 0xab, 0x8f,
+	0x5a, 0xc5, 0xfd, 0x70, 0x18, 0x88, 0x0b, 0xc5, 0xe7, 0x6c, 0x28, 0x2b, 0x5c, 0x1a, 0x8a, 0x07,
+	0x93, 0x7e, 0x7a, 0xff, 0xf9, 0xe3, 0x2c, 0xd8, 0xcd, 0x9c, 0xdd, 0xac, 0xb3, 0x9b, 0xbd, 0x41,
+	0x6d, 0xce, 0x5f, 0xdd, 0xfc, 0x3c, 0xed, 0x7d, 0xfb, 0x75, 0x9a, 0x96, 0x9a, 0xdc, 0xb8, 0x05,
+	0x56, 0xa2, 0x9b, 0x2d, 0xbc, 0x9e, 0x59, 0xb5, 0x10, 0xd4, 0xd6, 0x60, 0xfd, 0x07, 0xf6, 0xeb,
+	0x9f, 0xef, 0x4f, 0xa3, 0xbc, 0xeb, 0xcf, 0x1f, 0xb1, 0x21, 0xac, 0x6b, 0xdd, 0xb4, 0xf1, 0xe1,
+	0x24, 0x4a, 0x07, 0x79, 0x87, 0xf8, 0x31, 0x3b, 0xac, 0x65, 0x0b, 0x4d, 0x3c, 0xf4, 0xd6, 0x02,
+	0x70, 0x6a, 0x92, 0x4d, 0x09, 0x14, 0xdf, 0xf3, 0x74, 0x87, 0xce, 0xae, 0xd9, 0xc9, 0xdd, 0x58,
+	0x5e, 0x17, 0x0b, 0x83, 0x57, 0x9f, 0x40, 0x95, 0xe0, 0x20, 0x4f, 0x18, 0xf3, 0x8d, 0x25, 0x69,
+	0x34, 0x3e, 0xa2, 0x41, 0xbe, 0xc7, 0xb8, 0xa4, 0x60, 0x4d, 0x60, 0x14, 0x28, 0x9f, 0xd4, 0x51,
+	0xbe, 0xc3, 0xfc, 0x84, 0xb1, 0x02, 0xab, 0x4a, 0x1a, 0xf5, 0x2f, 0xaa, 0x41, 0x3e, 0xea, 0x98,
+	0x0b, 0x75, 0xfe, 0xee, 0x66, 0x93, 0x44, 0xb7, 0x9b, 0x24, 0xfa, 0xbd, 0x49, 0xa2, 0xcf, 0xdb,
+	0xa4, 0x77, 0xbb, 0x4d, 0x7a, 0x3f, 0xb6, 0x49, 0xef, 0xc3, 0xcb, 0xbd, 0x48, 0xfe, 0xdb, 0x23,
+	0x8f, 0xdc, 0x1e, 0xad, 0xf7, 0x76, 0xca, 0x87, 0x34, 0x1b, 0xfa, 0xab, 0x7e, 0xf1, 0x37, 0x00,
+	0x00, 0xff, 0xff, 0x1b, 0xdb, 0x52, 0xfe, 0x7b, 0x02, 0x00, 0x00,
+}
+
+func (m *OutpostPaymentPacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OutpostPaymentPacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OutpostPaymentPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Target) > 0 {
+		i -= len(m.Target)
+		copy(dAtA[i:], m.Target)
+		i = encodeVarintOutpost(dAtA, i, uint64(len(m.Target)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Payer) > 0 {
+		i -= len(m.Payer)
+		copy(dAtA[i:], m.Payer)
+		i = encodeVarintOutpost(dAtA, i, uint64(len(m.Payer)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Expiry != 0 {
+		i = encodeVarintOutpost(dAtA, i, uint64(m.Expiry))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOutpost(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.StreamId) > 0 {
+		i -= len(m.StreamId)
+		copy(dAtA[i:], m.StreamId)
+		i = encodeVarintOutpost(dAtA, i, uint64(len(m.StreamId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Resource) > 0 {
+		i -= len(m.Resource)
+		copy(dAtA[i:], m.Resource)
+		i = encodeVarintOutpost(dAtA, i, uint64(len(m.Resource)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintOutpost(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OutpostPaymentAcknowledgement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OutpostPaymentAcknowledgement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OutpostPaymentAcknowledgement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CommandId != 0 {
+		i = encodeVarintOutpost(dAtA, i, uint64(m.CommandId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Extended {
+		i--
+		if m.Extended {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Expiration != 0 {
+		i = encodeVarintOutpost(dAtA, i, uint64(m.Expiration))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintOutpost(dAtA []byte, offset int, v uint64) int {
+	offset -= sovOutpost(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *OutpostPaymentPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovOutpost(uint64(l))
+	}
+	l = len(m.Resource)
+	if l > 0 {
+		n += 1 + l + sovOutpost(uint64(l))
+	}
+	l = len(m.StreamId)
+	if l > 0 {
+		n += 1 + l + sovOutpost(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovOutpost(uint64(l))
+		}
+	}
+	if m.Expiry != 0 {
+		n += 1 + sovOutpost(uint64(m.Expiry))
+	}
+	l = len(m.Payer)
+	if l > 0 {
+		n += 1 + l + sovOutpost(uint64(l))
+	}
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovOutpost(uint64(l))
+	}
+	return n
+}
+
+func (m *OutpostPaymentAcknowledgement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Expiration != 0 {
+		n += 1 + sovOutpost(uint64(m.Expiration))
+	}
+	if m.Extended {
+		n += 2
+	}
+	if m.CommandId != 0 {
+		n += 1 + sovOutpost(uint64(m.CommandId))
+	}
+	return n
+}
+
+func sovOutpost(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozOutpost(x uint64) (n int) {
+	return sovOutpost(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *OutpostPaymentPacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOutpost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OutpostPaymentPacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OutpostPaymentPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutpost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOutpost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOutpost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutpost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOutpost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOutpost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resource = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutpost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOutpost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOutpost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StreamId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutpost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOutpost
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOutpost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			m.Expiry = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutpost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Expiry |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutpost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOutpost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOutpost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutpost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOutpost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOutpost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Target = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOutpost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOutpost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OutpostPaymentAcknowledgement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOutpost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OutpostPaymentAcknowledgement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OutpostPaymentAcknowledgement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			m.Expiration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutpost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Expiration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Extended", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutpost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Extended = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommandId", wireType)
+			}
+			m.CommandId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutpost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommandId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOutpost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOutpost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipOutpost(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowOutpost
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowOutpost
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowOutpost
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthOutpost
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupOutpost
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthOutpost
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthOutpost        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowOutpost          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupOutpost = fmt.Errorf("proto: unexpected end of group")
+)
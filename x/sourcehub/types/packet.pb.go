@@ -0,0 +1,1746 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: shinzonetwork/sourcehub/v1/packet.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PacketKind identifies the command carried by an outgoing ICA packet.
+type PacketKind int32
+
+const (
+	PacketKind_PACKET_KIND_UNSPECIFIED           PacketKind = 0
+	PacketKind_PACKET_KIND_REGISTER_ENTITY       PacketKind = 1
+	PacketKind_PACKET_KIND_REGISTER_OBJECT       PacketKind = 2
+	PacketKind_PACKET_KIND_REGISTER_POLICY       PacketKind = 3
+	PacketKind_PACKET_KIND_REGISTER_OBJECTS      PacketKind = 4
+	PacketKind_PACKET_KIND_REQUEST_STREAM_ACCESS PacketKind = 5
+	PacketKind_PACKET_KIND_REVOKE_STREAM_ACCESS  PacketKind = 6
+	PacketKind_PACKET_KIND_UNREGISTER_ENTITY     PacketKind = 7
+	PacketKind_PACKET_KIND_ROTATE_ENTITY_KEYS    PacketKind = 8
+	// Outbox commands of different kinds sent together
+	PacketKind_PACKET_KIND_BATCH         PacketKind = 9
+	PacketKind_PACKET_KIND_UPDATE_POLICY PacketKind = 10
+	// Removes the group membership of an entity blocked by an admin
+	PacketKind_PACKET_KIND_BLOCK_ENTITY PacketKind = 11
+)
+
+var PacketKind_name = map[int32]string{
+	0:  "PACKET_KIND_UNSPECIFIED",
+	1:  "PACKET_KIND_REGISTER_ENTITY",
+	2:  "PACKET_KIND_REGISTER_OBJECT",
+	3:  "PACKET_KIND_REGISTER_POLICY",
+	4:  "PACKET_KIND_REGISTER_OBJECTS",
+	5:  "PACKET_KIND_REQUEST_STREAM_ACCESS",
+	6:  "PACKET_KIND_REVOKE_STREAM_ACCESS",
+	7:  "PACKET_KIND_UNREGISTER_ENTITY",
+	8:  "PACKET_KIND_ROTATE_ENTITY_KEYS",
+	9:  "PACKET_KIND_BATCH",
+	10: "PACKET_KIND_UPDATE_POLICY",
+	11: "PACKET_KIND_BLOCK_ENTITY",
+}
+
+var PacketKind_value = map[string]int32{
+	"PACKET_KIND_UNSPECIFIED":           0,
+	"PACKET_KIND_REGISTER_ENTITY":       1,
+	"PACKET_KIND_REGISTER_OBJECT":       2,
+	"PACKET_KIND_REGISTER_POLICY":       3,
+	"PACKET_KIND_REGISTER_OBJECTS":      4,
+	"PACKET_KIND_REQUEST_STREAM_ACCESS": 5,
+	"PACKET_KIND_REVOKE_STREAM_ACCESS":  6,
+	"PACKET_KIND_UNREGISTER_ENTITY":     7,
+	"PACKET_KIND_ROTATE_ENTITY_KEYS":    8,
+	"PACKET_KIND_BATCH":                 9,
+	"PACKET_KIND_UPDATE_POLICY":         10,
+	"PACKET_KIND_BLOCK_ENTITY":          11,
+}
+
+func (x PacketKind) String() string {
+	return proto.EnumName(PacketKind_name, int32(x))
+}
+
+func (PacketKind) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_034674af8b8b3a4e, []int{0}
+}
+
+// PacketStatus is the lifecycle state of an outgoing ICA packet.
+type PacketStatus int32
+
+const (
+	PacketStatus_PACKET_STATUS_UNSPECIFIED  PacketStatus = 0
+	PacketStatus_PACKET_STATUS_PENDING      PacketStatus = 1
+	PacketStatus_PACKET_STATUS_ACKNOWLEDGED PacketStatus = 2
+	PacketStatus_PACKET_STATUS_FAILED       PacketStatus = 3
+	PacketStatus_PACKET_STATUS_TIMED_OUT    PacketStatus = 4
+	// Waiting in the module outbox to be sent
+	PacketStatus_PACKET_STATUS_QUEUED PacketStatus = 5
+)
+
+var PacketStatus_name = map[int32]string{
+	0: "PACKET_STATUS_UNSPECIFIED",
+	1: "PACKET_STATUS_PENDING",
+	2: "PACKET_STATUS_ACKNOWLEDGED",
+	3: "PACKET_STATUS_FAILED",
+	4: "PACKET_STATUS_TIMED_OUT",
+	5: "PACKET_STATUS_QUEUED",
+}
+
+var PacketStatus_value = map[string]int32{
+	"PACKET_STATUS_UNSPECIFIED":  0,
+	"PACKET_STATUS_PENDING":      1,
+	"PACKET_STATUS_ACKNOWLEDGED": 2,
+	"PACKET_STATUS_FAILED":       3,
+	"PACKET_STATUS_TIMED_OUT":    4,
+	"PACKET_STATUS_QUEUED":       5,
+}
+
+func (x PacketStatus) String() string {
+	return proto.EnumName(PacketStatus_name, int32(x))
+}
+
+func (PacketStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_034674af8b8b3a4e, []int{1}
+}
+
+// IcaPacket records an ACP command sent to SourceHub and its outcome.
+type IcaPacket struct {
+	// IBC channel the packet was sent on
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// IBC packet sequence on the channel
+	Sequence uint64     `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Kind     PacketKind `protobuf:"varint,3,opt,name=kind,proto3,enum=shinzonetwork.sourcehub.v1.PacketKind" json:"kind,omitempty"`
+	// Marshalled ICA CosmosTx carried by the packet
+	Payload []byte `protobuf:"bytes,4,opt,name=payload,proto3" json:"payload,omitempty"`
+	// Account that triggered the command
+	Sender string `protobuf:"bytes,5,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Block height the packet was sent at
+	Height int64        `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+	Status PacketStatus `protobuf:"varint,7,opt,name=status,proto3,enum=shinzonetwork.sourcehub.v1.PacketStatus" json:"status,omitempty"`
+	// Result bytes of a successful acknowledgement
+	Result []byte `protobuf:"bytes,8,opt,name=result,proto3" json:"result,omitempty"`
+	// Error reported by SourceHub for a failed acknowledgement
+	Error string `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+	// Block height the acknowledgement or timeout was processed at
+	ResolvedHeight int64 `protobuf:"varint,10,opt,name=resolved_height,json=resolvedHeight,proto3" json:"resolved_height,omitempty"`
+	// Outbox commands carried by the packet, in message order
+	CommandIds []uint64 `protobuf:"varint,11,rep,packed,name=command_ids,json=commandIds,proto3" json:"command_ids,omitempty"`
+	// Chain ID of the SourceHub target the packet was sent to
+	Target string `protobuf:"bytes,12,opt,name=target,proto3" json:"target,omitempty"`
+}
+
+func (m *IcaPacket) Reset()         { *m = IcaPacket{} }
+func (m *IcaPacket) String() string { return proto.CompactTextString(m) }
+func (*IcaPacket) ProtoMessage()    {}
+func (*IcaPacket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_034674af8b8b3a4e, []int{0}
+}
+func (m *IcaPacket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IcaPacket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IcaPacket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IcaPacket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IcaPacket.Merge(m, src)
+}
+func (m *IcaPacket) XXX_Size() int {
+	return m.Size()
+}
+func (m *IcaPacket) XXX_DiscardUnknown() {
+	xxx_messageInfo_IcaPacket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IcaPacket proto.InternalMessageInfo
+
+func (m *IcaPacket) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *IcaPacket) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *IcaPacket) GetKind() PacketKind {
+	if m != nil {
+		return m.Kind
+	}
+	return PacketKind_PACKET_KIND_UNSPECIFIED
+}
+
+func (m *IcaPacket) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (m *IcaPacket) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *IcaPacket) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *IcaPacket) GetStatus() PacketStatus {
+	if m != nil {
+		return m.Status
+	}
+	return PacketStatus_PACKET_STATUS_UNSPECIFIED
+}
+
+func (m *IcaPacket) GetResult() []byte {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (m *IcaPacket) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *IcaPacket) GetResolvedHeight() int64 {
+	if m != nil {
+		return m.ResolvedHeight
+	}
+	return 0
+}
+
+func (m *IcaPacket) GetCommandIds() []uint64 {
+	if m != nil {
+		return m.CommandIds
+	}
+	return nil
+}
+
+func (m *IcaPacket) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+// IcaCommand is a set of ACP messages queued in the module outbox. The
+// commands queued during a block are sent to SourceHub in EndBlocker, batched
+// into as few ICA packets as the max batch size allows.
+type IcaCommand struct {
+	Id   uint64     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind PacketKind `protobuf:"varint,2,opt,name=kind,proto3,enum=shinzonetwork.sourcehub.v1.PacketKind" json:"kind,omitempty"`
+	// Account that triggered the command
+	Sender string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Hash of the ShinzoHub transaction that queued the command
+	TxHash string `protobuf:"bytes,4,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// Block height the command was queued at
+	Height int64 `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	// Marshalled ICA CosmosTx holding the command messages
+	Payload []byte `protobuf:"bytes,6,opt,name=payload,proto3" json:"payload,omitempty"`
+	// IBC channel of the packet that carried the command
+	ChannelId string `protobuf:"bytes,7,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// IBC packet sequence of the packet that carried the command
+	Sequence uint64       `protobuf:"varint,8,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Status   PacketStatus `protobuf:"varint,9,opt,name=status,proto3,enum=shinzonetwork.sourcehub.v1.PacketStatus" json:"status,omitempty"`
+	// Marshalled TxMsgData holding the responses to the command messages
+	Result []byte `protobuf:"bytes,10,opt,name=result,proto3" json:"result,omitempty"`
+	// Error reported by SourceHub for the packet that carried the command
+	Error string `protobuf:"bytes,11,opt,name=error,proto3" json:"error,omitempty"`
+	// Number of times the command was sent to SourceHub
+	Attempts uint32 `protobuf:"varint,12,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// A retried command is not sent before this height
+	NextAttemptHeight int64 `protobuf:"varint,13,opt,name=next_attempt_height,json=nextAttemptHeight,proto3" json:"next_attempt_height,omitempty"`
+	// Chain ID of the SourceHub target the command is sent to
+	Target string `protobuf:"bytes,14,opt,name=target,proto3" json:"target,omitempty"`
+}
+
+func (m *IcaCommand) Reset()         { *m = IcaCommand{} }
+func (m *IcaCommand) String() string { return proto.CompactTextString(m) }
+func (*IcaCommand) ProtoMessage()    {}
+func (*IcaCommand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_034674af8b8b3a4e, []int{1}
+}
+func (m *IcaCommand) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IcaCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IcaCommand.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IcaCommand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IcaCommand.Merge(m, src)
+}
+func (m *IcaCommand) XXX_Size() int {
+	return m.Size()
+}
+func (m *IcaCommand) XXX_DiscardUnknown() {
+	xxx_messageInfo_IcaCommand.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IcaCommand proto.InternalMessageInfo
+
+func (m *IcaCommand) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *IcaCommand) GetKind() PacketKind {
+	if m != nil {
+		return m.Kind
+	}
+	return PacketKind_PACKET_KIND_UNSPECIFIED
+}
+
+func (m *IcaCommand) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *IcaCommand) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *IcaCommand) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *IcaCommand) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (m *IcaCommand) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *IcaCommand) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *IcaCommand) GetStatus() PacketStatus {
+	if m != nil {
+		return m.Status
+	}
+	return PacketStatus_PACKET_STATUS_UNSPECIFIED
+}
+
+func (m *IcaCommand) GetResult() []byte {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (m *IcaCommand) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *IcaCommand) GetAttempts() uint32 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func (m *IcaCommand) GetNextAttemptHeight() int64 {
+	if m != nil {
+		return m.NextAttemptHeight
+	}
+	return 0
+}
+
+func (m *IcaCommand) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("shinzonetwork.sourcehub.v1.PacketKind", PacketKind_name, PacketKind_value)
+	proto.RegisterEnum("shinzonetwork.sourcehub.v1.PacketStatus", PacketStatus_name, PacketStatus_value)
+	proto.RegisterType((*IcaPacket)(nil), "shinzonetwork.sourcehub.v1.IcaPacket")
+	proto.RegisterType((*IcaCommand)(nil), "shinzonetwork.sourcehub.v1.IcaCommand")
+}
+
+func init() {
+	proto.RegisterFile("shinzonetwork/sourcehub/v1/packet.proto", fileDescriptor_034674af8b8b3a4e)
+}
+
+var fileDescriptor_034674af8b8b3a4e = []byte{
+	// 764 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xcd, 0x6e, 0xeb, 0x44,
+	0x14, 0x8e, 0xe3, 0xfc, 0x9e, 0xf4, 0x06, 0xdf, 0xe1, 0x5e, 0xae, 0x6f, 0xda, 0xba, 0x6e, 0x05,
+	0x34, 0xea, 0x22, 0x51, 0x81, 0x15, 0x2b, 0x5c, 0x7b, 0xda, 0x9a, 0xa4, 0x49, 0x6a, 0x3b, 0xa0,
+	0xb2, 0xb1, 0x5c, 0x7b, 0x54, 0x5b, 0x4d, 0xec, 0x60, 0x4f, 0x4a, 0xca, 0x53, 0xb0, 0x62, 0xc9,
+	0x7b, 0xf0, 0x06, 0x88, 0x55, 0x97, 0x2c, 0x51, 0xfb, 0x22, 0x28, 0x8e, 0x93, 0xd8, 0x81, 0x16,
+	0x09, 0x96, 0xdf, 0x39, 0xdf, 0x39, 0xf3, 0xcd, 0xf9, 0xce, 0x68, 0xe0, 0x30, 0x72, 0x3d, 0xff,
+	0xc7, 0xc0, 0x27, 0xf4, 0x87, 0x20, 0xbc, 0x6d, 0x47, 0xc1, 0x34, 0xb4, 0x89, 0x3b, 0xbd, 0x6e,
+	0xdf, 0x1d, 0xb7, 0x27, 0x96, 0x7d, 0x4b, 0x68, 0x6b, 0x12, 0x06, 0x34, 0x40, 0x8d, 0x0c, 0xb1,
+	0xb5, 0x22, 0xb6, 0xee, 0x8e, 0x0f, 0x7e, 0x61, 0xa1, 0xaa, 0xda, 0xd6, 0x20, 0xe6, 0xa3, 0x5d,
+	0x00, 0xdb, 0xb5, 0x7c, 0x9f, 0x8c, 0x4c, 0xcf, 0xe1, 0x19, 0x91, 0x69, 0x56, 0xb5, 0x6a, 0x12,
+	0x51, 0x1d, 0xd4, 0x80, 0x4a, 0x44, 0xbe, 0x9f, 0x12, 0xdf, 0x26, 0x7c, 0x5e, 0x64, 0x9a, 0x05,
+	0x6d, 0x85, 0xd1, 0x97, 0x50, 0xb8, 0xf5, 0x7c, 0x87, 0x67, 0x45, 0xa6, 0x59, 0xff, 0xec, 0xd3,
+	0xd6, 0xf3, 0x67, 0xb6, 0x16, 0x87, 0x75, 0x3c, 0xdf, 0xd1, 0xe2, 0x1a, 0xc4, 0x43, 0x79, 0x62,
+	0xdd, 0x8f, 0x02, 0xcb, 0xe1, 0x0b, 0x22, 0xd3, 0xdc, 0xd2, 0x96, 0x10, 0x7d, 0x04, 0xa5, 0x88,
+	0xf8, 0x0e, 0x09, 0xf9, 0x62, 0x2c, 0x26, 0x41, 0xf3, 0xb8, 0x4b, 0xbc, 0x1b, 0x97, 0xf2, 0x25,
+	0x91, 0x69, 0xb2, 0x5a, 0x82, 0xd0, 0x57, 0x50, 0x8a, 0xa8, 0x45, 0xa7, 0x11, 0x5f, 0x8e, 0x75,
+	0x34, 0xff, 0x5d, 0x87, 0x1e, 0xf3, 0xb5, 0xa4, 0x6e, 0xde, 0x39, 0x24, 0xd1, 0x74, 0x44, 0xf9,
+	0x4a, 0x2c, 0x25, 0x41, 0xe8, 0x0d, 0x14, 0x49, 0x18, 0x06, 0x21, 0x5f, 0x8d, 0x85, 0x2c, 0x00,
+	0x3a, 0x84, 0x0f, 0x42, 0x12, 0x05, 0xa3, 0x3b, 0xe2, 0x98, 0x89, 0x20, 0x88, 0x05, 0xd5, 0x97,
+	0xe1, 0xf3, 0x85, 0xb0, 0x3d, 0xa8, 0xd9, 0xc1, 0x78, 0x6c, 0xf9, 0x8e, 0xe9, 0x39, 0x11, 0x5f,
+	0x13, 0xd9, 0x66, 0x41, 0x83, 0x24, 0xa4, 0x3a, 0xf1, 0xb9, 0xd4, 0x0a, 0x6f, 0x08, 0xe5, 0xb7,
+	0x16, 0x37, 0x5d, 0xa0, 0x83, 0xdf, 0x59, 0x00, 0xd5, 0xb6, 0xe4, 0x05, 0x13, 0xd5, 0x21, 0x9f,
+	0x38, 0x53, 0xd0, 0xf2, 0x9e, 0xb3, 0x1a, 0x7b, 0xfe, 0x3f, 0x8c, 0x7d, 0x3d, 0x5c, 0x36, 0x33,
+	0xdc, 0x77, 0x50, 0xa6, 0x33, 0xd3, 0xb5, 0x22, 0x37, 0xb6, 0x63, 0xae, 0x65, 0x76, 0x6e, 0x45,
+	0x6e, 0x6a, 0xea, 0xc5, 0xcc, 0xd4, 0x53, 0xfe, 0x95, 0xb2, 0xfe, 0x65, 0x17, 0xaa, 0xfc, 0xd2,
+	0x42, 0x55, 0x36, 0x16, 0x6a, 0x6d, 0x65, 0xf5, 0x7f, 0x5b, 0x09, 0xff, 0x6c, 0x65, 0x2d, 0x6d,
+	0x65, 0x03, 0x2a, 0x16, 0xa5, 0x64, 0x3c, 0xa1, 0x51, 0x6c, 0xc1, 0x2b, 0x6d, 0x85, 0x51, 0x0b,
+	0x3e, 0xf4, 0xc9, 0x8c, 0x9a, 0x49, 0x60, 0x69, 0xf5, 0xab, 0x78, 0x0a, 0xaf, 0xe7, 0x29, 0x69,
+	0x91, 0x49, 0xdc, 0x5e, 0x9b, 0x59, 0x4f, 0x9b, 0x79, 0xf4, 0x33, 0x0b, 0xb0, 0xb6, 0x01, 0x6d,
+	0xc3, 0xbb, 0x81, 0x24, 0x77, 0xb0, 0x61, 0x76, 0xd4, 0x9e, 0x62, 0x0e, 0x7b, 0xfa, 0x00, 0xcb,
+	0xea, 0xa9, 0x8a, 0x15, 0x2e, 0x87, 0xf6, 0x60, 0x3b, 0x9d, 0xd4, 0xf0, 0x99, 0xaa, 0x1b, 0x58,
+	0x33, 0x71, 0xcf, 0x50, 0x8d, 0x2b, 0x8e, 0x79, 0x96, 0xd0, 0x3f, 0xf9, 0x1a, 0xcb, 0x06, 0x97,
+	0x7f, 0x96, 0x30, 0xe8, 0x77, 0x55, 0xf9, 0x8a, 0x63, 0x91, 0x08, 0x3b, 0x2f, 0x74, 0xd0, 0xb9,
+	0x02, 0xfa, 0x04, 0xf6, 0xb3, 0x8c, 0xcb, 0x21, 0xd6, 0x0d, 0x53, 0x37, 0x34, 0x2c, 0x5d, 0x98,
+	0x92, 0x2c, 0x63, 0x5d, 0xe7, 0x8a, 0xe8, 0x63, 0x10, 0xb3, 0xb4, 0x6f, 0xfa, 0x1d, 0xbc, 0xc1,
+	0x2a, 0xa1, 0x7d, 0xd8, 0xcd, 0x5e, 0x77, 0xf3, 0x4e, 0x65, 0x74, 0x00, 0x42, 0xa6, 0x51, 0xdf,
+	0x90, 0x0c, 0x9c, 0xa4, 0xcd, 0x0e, 0xbe, 0xd2, 0xb9, 0x0a, 0x7a, 0x0b, 0xaf, 0xd3, 0x9c, 0x13,
+	0xc9, 0x90, 0xcf, 0xb9, 0x2a, 0xda, 0x85, 0xf7, 0x99, 0xee, 0x03, 0x65, 0x5e, 0x9a, 0xdc, 0x15,
+	0xd0, 0x0e, 0xf0, 0x99, 0xaa, 0x6e, 0x5f, 0xee, 0x2c, 0xcf, 0xad, 0x1d, 0xfd, 0xca, 0xc0, 0x56,
+	0x7a, 0x87, 0x52, 0xdd, 0x74, 0x43, 0x32, 0x86, 0xfa, 0x86, 0x39, 0xef, 0xe1, 0x6d, 0x36, 0x3d,
+	0xc0, 0x3d, 0x45, 0xed, 0x9d, 0x71, 0x0c, 0x12, 0xa0, 0x91, 0x4d, 0x49, 0x72, 0xa7, 0xd7, 0xff,
+	0xb6, 0x8b, 0x95, 0x33, 0xac, 0x70, 0x79, 0xc4, 0xc3, 0x9b, 0x6c, 0xfe, 0x54, 0x52, 0xbb, 0x58,
+	0xe1, 0xd8, 0xd4, 0x3a, 0x24, 0x19, 0x43, 0xbd, 0xc0, 0x8a, 0xd9, 0x1f, 0x1a, 0x5c, 0xe1, 0xef,
+	0x65, 0x97, 0x43, 0x3c, 0xc4, 0x0a, 0x57, 0x3c, 0xe9, 0xfd, 0xf6, 0x28, 0x30, 0x0f, 0x8f, 0x02,
+	0xf3, 0xe7, 0xa3, 0xc0, 0xfc, 0xf4, 0x24, 0xe4, 0x1e, 0x9e, 0x84, 0xdc, 0x1f, 0x4f, 0x42, 0xee,
+	0xbb, 0x2f, 0x6e, 0x3c, 0x3a, 0x7f, 0x20, 0x76, 0x30, 0x6e, 0x6f, 0x7c, 0x16, 0x31, 0x9a, 0x7f,
+	0x16, 0xb3, 0xd4, 0xc7, 0x41, 0xef, 0x27, 0x24, 0xba, 0x2e, 0xc5, 0xbf, 0xc6, 0xe7, 0x7f, 0x05,
+	0x00, 0x00, 0xff, 0xff, 0x86, 0xe8, 0x12, 0xff, 0x60, 0x06, 0x00, 0x00,
+}
+
+func (m *IcaPacket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IcaPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IcaPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Target) > 0 {
+		i -= len(m.Target)
+		copy(dAtA[i:], m.Target)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Target)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.CommandIds) > 0 {
+		dAtA2 := make([]byte, len(m.CommandIds)*10)
+		var j1 int
+		for _, num := range m.CommandIds {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintPacket(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.ResolvedHeight != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.ResolvedHeight))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Result) > 0 {
+		i -= len(m.Result)
+		copy(dAtA[i:], m.Result)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Result)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Status != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Height != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Kind != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.Kind))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IcaCommand) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IcaCommand) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IcaCommand) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Target) > 0 {
+		i -= len(m.Target)
+		copy(dAtA[i:], m.Target)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Target)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.NextAttemptHeight != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.NextAttemptHeight))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.Attempts != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.Attempts))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.Result) > 0 {
+		i -= len(m.Result)
+		copy(dAtA[i:], m.Result)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Result)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Status != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Height != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Kind != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.Kind))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPacket(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPacket(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *IcaPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovPacket(uint64(m.Sequence))
+	}
+	if m.Kind != 0 {
+		n += 1 + sovPacket(uint64(m.Kind))
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovPacket(uint64(m.Height))
+	}
+	if m.Status != 0 {
+		n += 1 + sovPacket(uint64(m.Status))
+	}
+	l = len(m.Result)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if m.ResolvedHeight != 0 {
+		n += 1 + sovPacket(uint64(m.ResolvedHeight))
+	}
+	if len(m.CommandIds) > 0 {
+		l = 0
+		for _, e := range m.CommandIds {
+			l += sovPacket(uint64(e))
+		}
+		n += 1 + sovPacket(uint64(l)) + l
+	}
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+
+func (m *IcaCommand) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovPacket(uint64(m.Id))
+	}
+	if m.Kind != 0 {
+		n += 1 + sovPacket(uint64(m.Kind))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovPacket(uint64(m.Height))
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovPacket(uint64(m.Sequence))
+	}
+	if m.Status != 0 {
+		n += 1 + sovPacket(uint64(m.Status))
+	}
+	l = len(m.Result)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if m.Attempts != 0 {
+		n += 1 + sovPacket(uint64(m.Attempts))
+	}
+	if m.NextAttemptHeight != 0 {
+		n += 1 + sovPacket(uint64(m.NextAttemptHeight))
+	}
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+
+func sovPacket(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPacket(x uint64) (n int) {
+	return sovPacket(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *IcaPacket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IcaPacket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IcaPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			m.Kind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Kind |= PacketKind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= PacketStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Result = append(m.Result[:0], dAtA[iNdEx:postIndex]...)
+			if m.Result == nil {
+				m.Result = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolvedHeight", wireType)
+			}
+			m.ResolvedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResolvedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPacket
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.CommandIds = append(m.CommandIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPacket
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPacket
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPacket
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.CommandIds) == 0 {
+					m.CommandIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPacket
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.CommandIds = append(m.CommandIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommandIds", wireType)
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Target = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IcaCommand) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IcaCommand: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IcaCommand: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			m.Kind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Kind |= PacketKind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= PacketStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Result = append(m.Result[:0], dAtA[iNdEx:postIndex]...)
+			if m.Result == nil {
+				m.Result = []byte{}
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
+			}
+			m.Attempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempts |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextAttemptHeight", wireType)
+			}
+			m.NextAttemptHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextAttemptHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Target = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPacket(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPacket
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPacket
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPacket
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPacket        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPacket          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPacket = fmt.Errorf("proto: unexpected end of group")
+)
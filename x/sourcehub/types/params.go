@@ -1,14 +1,43 @@
 package types
 
 import (
+	"fmt"
+	"slices"
+
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
 
+// DefaultMaxBatchSize is the number of outbox commands sent per ICA packet
+// when the max_batch_size param is 0.
+const DefaultMaxBatchSize uint32 = 50
+
+// DefaultMaxAttempts is the number of times a command is sent to SourceHub
+// before it is dead-lettered when the max_attempts param is 0.
+const DefaultMaxAttempts uint32 = 5
+
+// DefaultEpochLength is the number of blocks after which the collected
+// subscription fees are distributed when the epoch_length param is 0.
+const DefaultEpochLength uint64 = 1000
+
+// DefaultUnbondingPeriod is the number of seconds the bond of a removed or
+// blocked entity stays locked when the unbonding_period param is 0.
+const DefaultUnbondingPeriod uint64 = 21 * 24 * 60 * 60
+
+// DefaultMaxRegistrationWindow is the number of blocks past the current
+// height a registration message may expire at when the
+// max_registration_window param is 0, roughly one day of blocks.
+const DefaultMaxRegistrationWindow uint64 = 14400
+
+// RevenueShareTotal is the sum of the revenue shares, in basis points.
+const RevenueShareTotal uint32 = 10000
+
 // ParamKeyTable the param key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
@@ -25,7 +54,11 @@ func NewParams(admin string) Params {
 	}
 
 	return Params{
-		Admin: admin,
+		Admin:        admin,
+		MaxBatchSize: DefaultMaxBatchSize,
+		MaxAttempts:  DefaultMaxAttempts,
+		IndexerBond:  EntityBond{SlashFraction: math.LegacyZeroDec()},
+		HostBond:     EntityBond{SlashFraction: math.LegacyZeroDec()},
 	}
 }
 
@@ -42,5 +75,142 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 
 // Validate validates the set of params
 func (p *Params) Validate() error {
+	if err := validateAdmin(p.Admin); err != nil {
+		return err
+	}
+	if err := validateOutpostConnections(p.OutpostConnections); err != nil {
+		return err
+	}
+	if err := p.RevenueShares.Validate(); err != nil {
+		return err
+	}
+	if err := p.IndexerBond.Validate(); err != nil {
+		return fmt.Errorf("invalid indexer bond: %w", err)
+	}
+	if err := p.HostBond.Validate(); err != nil {
+		return fmt.Errorf("invalid host bond: %w", err)
+	}
 	return nil
 }
+
+// BatchSize returns the maximum number of outbox commands per ICA packet.
+func (p Params) BatchSize() uint32 {
+	if p.MaxBatchSize == 0 {
+		return DefaultMaxBatchSize
+	}
+	return p.MaxBatchSize
+}
+
+// AttemptLimit returns the number of times a command is sent to SourceHub
+// before it is dead-lettered.
+func (p Params) AttemptLimit() uint32 {
+	if p.MaxAttempts == 0 {
+		return DefaultMaxAttempts
+	}
+	return p.MaxAttempts
+}
+
+// BlocksPerEpoch returns the number of blocks after which the collected
+// subscription fees are distributed.
+func (p Params) BlocksPerEpoch() uint64 {
+	if p.EpochLength == 0 {
+		return DefaultEpochLength
+	}
+	return p.EpochLength
+}
+
+// UnbondingTime returns the number of seconds the bond of a removed or
+// blocked entity stays locked.
+func (p Params) UnbondingTime() uint64 {
+	if p.UnbondingPeriod == 0 {
+		return DefaultUnbondingPeriod
+	}
+	return p.UnbondingPeriod
+}
+
+// RegistrationWindow returns the number of blocks past the current height a
+// registration message may expire at.
+func (p Params) RegistrationWindow() uint64 {
+	if p.MaxRegistrationWindow == 0 {
+		return DefaultMaxRegistrationWindow
+	}
+	return p.MaxRegistrationWindow
+}
+
+// EntityBond returns the bond required to register an entity of role.
+func (p Params) EntityBond(role EntityRole) EntityBond {
+	if role == EntityRole_ENTITY_ROLE_HOST {
+		return p.HostBond
+	}
+	return p.IndexerBond
+}
+
+// IsOutpostConnection reports whether channels on the outpost port of the
+// connection are trusted to report Outpost payments.
+func (p Params) IsOutpostConnection(connectionID string) bool {
+	return slices.Contains(p.OutpostConnections, connectionID)
+}
+
+// validateAdmin accepts an empty admin, in which case only the module
+// authority can perform administrative actions.
+func validateAdmin(admin string) error {
+	if admin == "" {
+		return nil
+	}
+
+	if _, err := sdk.AccAddressFromBech32(admin); err != nil {
+		return fmt.Errorf("invalid admin address %q: %w", admin, err)
+	}
+
+	return nil
+}
+
+func validateOutpostConnections(connections []string) error {
+	seen := make(map[string]bool, len(connections))
+	for _, id := range connections {
+		if err := host.ConnectionIdentifierValidator(id); err != nil {
+			return fmt.Errorf("invalid outpost connection %q: %w", id, err)
+		}
+		if seen[id] {
+			return fmt.Errorf("duplicate outpost connection %s", id)
+		}
+		seen[id] = true
+	}
+	return nil
+}
+
+// Validate checks that the shares sum to RevenueShareTotal, or are all 0.
+func (s RevenueShares) Validate() error {
+	sum := uint64(s.Creator) + uint64(s.Hosts) + uint64(s.Indexers) + uint64(s.CommunityPool)
+	if sum != 0 && sum != uint64(RevenueShareTotal) {
+		return fmt.Errorf("revenue shares sum to %d basis points, expected %d", sum, RevenueShareTotal)
+	}
+	return nil
+}
+
+// Validate checks the bond amount and that the slash fraction lies between 0
+// and 1. An unset slash fraction slashes nothing.
+func (b EntityBond) Validate() error {
+	if err := b.Amount.Validate(); err != nil {
+		return fmt.Errorf("invalid amount %q: %w", b.Amount, err)
+	}
+	if b.SlashFraction.IsNil() {
+		return nil
+	}
+	if b.SlashFraction.IsNegative() || b.SlashFraction.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("slash fraction %s is not between 0 and 1", b.SlashFraction)
+	}
+	return nil
+}
+
+// Slash returns the part of bond slashed at the slash fraction, rounded down.
+func (b EntityBond) Slash(bond sdk.Coins) sdk.Coins {
+	if b.SlashFraction.IsNil() {
+		return sdk.NewCoins()
+	}
+	slashed := sdk.NewCoins()
+	for _, c := range bond {
+		slashed = slashed.Add(sdk.NewCoin(c.Denom, b.SlashFraction.MulInt(c.Amount).TruncateInt()))
+	}
+	return slashed
+}
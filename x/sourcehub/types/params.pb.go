@@ -4,8 +4,13 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -26,6 +31,33 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Params struct {
 	// admin is an account that can perform administrative actions
 	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+	// max_batch_size is the maximum number of outbox commands sent in one ICA
+	// packet, 0 uses the default
+	MaxBatchSize uint32 `protobuf:"varint,2,opt,name=max_batch_size,json=maxBatchSize,proto3" json:"max_batch_size,omitempty"`
+	// max_attempts is the number of times a command is sent to SourceHub before
+	// it is moved to the dead-letter list, 0 uses the default
+	MaxAttempts uint32 `protobuf:"varint,3,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`
+	// auto_reopen_channel reopens the ICA channel in BeginBlocker once it is
+	// closed
+	AutoReopenChannel bool `protobuf:"varint,4,opt,name=auto_reopen_channel,json=autoReopenChannel,proto3" json:"auto_reopen_channel,omitempty"`
+	// outpost_connections are the IBC connections whose channels on the outpost
+	// port are trusted to report Outpost payments
+	OutpostConnections []string `protobuf:"bytes,5,rep,name=outpost_connections,json=outpostConnections,proto3" json:"outpost_connections,omitempty"`
+	// revenue_shares splits the subscription fees collected in an epoch
+	RevenueShares RevenueShares `protobuf:"bytes,6,opt,name=revenue_shares,json=revenueShares,proto3" json:"revenue_shares"`
+	// epoch_length is the number of blocks after which the collected
+	// subscription fees are distributed, 0 uses the default
+	EpochLength uint64 `protobuf:"varint,7,opt,name=epoch_length,json=epochLength,proto3" json:"epoch_length,omitempty"`
+	// indexer_bond is the bond required to register an indexer
+	IndexerBond EntityBond `protobuf:"bytes,8,opt,name=indexer_bond,json=indexerBond,proto3" json:"indexer_bond"`
+	// host_bond is the bond required to register a host
+	HostBond EntityBond `protobuf:"bytes,9,opt,name=host_bond,json=hostBond,proto3" json:"host_bond"`
+	// unbonding_period is the number of seconds the bond of a removed or
+	// blocked entity stays locked, 0 uses the default
+	UnbondingPeriod uint64 `protobuf:"varint,10,opt,name=unbonding_period,json=unbondingPeriod,proto3" json:"unbonding_period,omitempty"`
+	// max_registration_window is the number of blocks past the current height a
+	// registration message may expire at, 0 uses the default
+	MaxRegistrationWindow uint64 `protobuf:"varint,11,opt,name=max_registration_window,json=maxRegistrationWindow,proto3" json:"max_registration_window,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -68,8 +100,205 @@ func (m *Params) GetAdmin() string {
 	return ""
 }
 
+func (m *Params) GetMaxBatchSize() uint32 {
+	if m != nil {
+		return m.MaxBatchSize
+	}
+	return 0
+}
+
+func (m *Params) GetMaxAttempts() uint32 {
+	if m != nil {
+		return m.MaxAttempts
+	}
+	return 0
+}
+
+func (m *Params) GetAutoReopenChannel() bool {
+	if m != nil {
+		return m.AutoReopenChannel
+	}
+	return false
+}
+
+func (m *Params) GetOutpostConnections() []string {
+	if m != nil {
+		return m.OutpostConnections
+	}
+	return nil
+}
+
+func (m *Params) GetRevenueShares() RevenueShares {
+	if m != nil {
+		return m.RevenueShares
+	}
+	return RevenueShares{}
+}
+
+func (m *Params) GetEpochLength() uint64 {
+	if m != nil {
+		return m.EpochLength
+	}
+	return 0
+}
+
+func (m *Params) GetIndexerBond() EntityBond {
+	if m != nil {
+		return m.IndexerBond
+	}
+	return EntityBond{}
+}
+
+func (m *Params) GetHostBond() EntityBond {
+	if m != nil {
+		return m.HostBond
+	}
+	return EntityBond{}
+}
+
+func (m *Params) GetUnbondingPeriod() uint64 {
+	if m != nil {
+		return m.UnbondingPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetMaxRegistrationWindow() uint64 {
+	if m != nil {
+		return m.MaxRegistrationWindow
+	}
+	return 0
+}
+
+// EntityBond is the stake backing the registration of an entity.
+type EntityBond struct {
+	// amount is locked in the module account at registration, none is required
+	// if empty
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// slash_fraction is the part of the bond sent to the community pool when an
+	// admin blocks the entity
+	SlashFraction cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=slash_fraction,json=slashFraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slash_fraction"`
+}
+
+func (m *EntityBond) Reset()         { *m = EntityBond{} }
+func (m *EntityBond) String() string { return proto.CompactTextString(m) }
+func (*EntityBond) ProtoMessage()    {}
+func (*EntityBond) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4dd59b5514e807b0, []int{1}
+}
+func (m *EntityBond) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EntityBond) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EntityBond.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EntityBond) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EntityBond.Merge(m, src)
+}
+func (m *EntityBond) XXX_Size() int {
+	return m.Size()
+}
+func (m *EntityBond) XXX_DiscardUnknown() {
+	xxx_messageInfo_EntityBond.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EntityBond proto.InternalMessageInfo
+
+func (m *EntityBond) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// RevenueShares are the shares of the subscription fees of a stream, in basis
+// points summing to 10000. Fees go to the community pool when every share is
+// 0, and so does a share that has no recipient.
+type RevenueShares struct {
+	// Creator of the view
+	Creator uint32 `protobuf:"varint,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// Hosts holding the syncer relationship on the view
+	Hosts uint32 `protobuf:"varint,2,opt,name=hosts,proto3" json:"hosts,omitempty"`
+	// Indexers holding the writer relationship on the primitives the stream
+	// derives from
+	Indexers      uint32 `protobuf:"varint,3,opt,name=indexers,proto3" json:"indexers,omitempty"`
+	CommunityPool uint32 `protobuf:"varint,4,opt,name=community_pool,json=communityPool,proto3" json:"community_pool,omitempty"`
+}
+
+func (m *RevenueShares) Reset()         { *m = RevenueShares{} }
+func (m *RevenueShares) String() string { return proto.CompactTextString(m) }
+func (*RevenueShares) ProtoMessage()    {}
+func (*RevenueShares) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4dd59b5514e807b0, []int{2}
+}
+func (m *RevenueShares) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RevenueShares) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RevenueShares.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RevenueShares) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevenueShares.Merge(m, src)
+}
+func (m *RevenueShares) XXX_Size() int {
+	return m.Size()
+}
+func (m *RevenueShares) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevenueShares.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevenueShares proto.InternalMessageInfo
+
+func (m *RevenueShares) GetCreator() uint32 {
+	if m != nil {
+		return m.Creator
+	}
+	return 0
+}
+
+func (m *RevenueShares) GetHosts() uint32 {
+	if m != nil {
+		return m.Hosts
+	}
+	return 0
+}
+
+func (m *RevenueShares) GetIndexers() uint32 {
+	if m != nil {
+		return m.Indexers
+	}
+	return 0
+}
+
+func (m *RevenueShares) GetCommunityPool() uint32 {
+	if m != nil {
+		return m.CommunityPool
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "shinzonetwork.sourcehub.v1.Params")
+	proto.RegisterType((*EntityBond)(nil), "shinzonetwork.sourcehub.v1.EntityBond")
+	proto.RegisterType((*RevenueShares)(nil), "shinzonetwork.sourcehub.v1.RevenueShares")
 }
 
 func init() {
@@ -77,20 +306,53 @@ func init() {
 }
 
 var fileDescriptor_4dd59b5514e807b0 = []byte{
-	// 196 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2f, 0xce, 0xc8, 0xcc,
-	0xab, 0xca, 0xcf, 0x4b, 0x2d, 0x29, 0xcf, 0x2f, 0xca, 0xd6, 0x2f, 0xce, 0x2f, 0x2d, 0x4a, 0x4e,
-	0xcd, 0x28, 0x4d, 0xd2, 0x2f, 0x33, 0xd4, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28,
-	0xca, 0x2f, 0xc9, 0x17, 0x92, 0x42, 0x51, 0xa8, 0x07, 0x57, 0xa8, 0x57, 0x66, 0x28, 0x25, 0x99,
-	0x9c, 0x5f, 0x9c, 0x9b, 0x5f, 0x1c, 0x0f, 0x56, 0xa9, 0x0f, 0xe1, 0x40, 0xb4, 0x29, 0x59, 0x70,
-	0xb1, 0x05, 0x80, 0x8d, 0x11, 0xd2, 0xe3, 0x62, 0x4d, 0x4c, 0xc9, 0xcd, 0xcc, 0x93, 0x60, 0x54,
-	0x60, 0xd4, 0xe0, 0x74, 0x92, 0xb8, 0xb4, 0x45, 0x57, 0x04, 0xaa, 0xd4, 0x31, 0x25, 0xa5, 0x28,
-	0xb5, 0xb8, 0x38, 0xb8, 0xa4, 0x28, 0x33, 0x2f, 0x3d, 0x08, 0xa2, 0xcc, 0xc9, 0xef, 0xc4, 0x23,
-	0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2,
-	0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0x4c, 0xd2, 0x33, 0x4b, 0x40, 0x36, 0x27, 0xe7,
-	0xe7, 0xea, 0xa3, 0x39, 0x1f, 0xcc, 0x03, 0x39, 0xbf, 0x02, 0xc9, 0x2b, 0x25, 0x95, 0x05, 0xa9,
-	0xc5, 0x49, 0x6c, 0x60, 0x07, 0x19, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0xc6, 0x2d, 0x41, 0xd6,
-	0xf2, 0x00, 0x00, 0x00,
+	// 721 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xdf, 0x6a, 0x3b, 0x45,
+	0x14, 0xce, 0xda, 0x34, 0x4d, 0x26, 0x4d, 0xb4, 0xd3, 0x8a, 0xdb, 0x08, 0x69, 0x2c, 0xfe, 0xd9,
+	0x0a, 0xdd, 0x25, 0x55, 0x7b, 0xdf, 0xb4, 0x0a, 0x42, 0xd1, 0xb2, 0x05, 0x05, 0x41, 0x96, 0xc9,
+	0xee, 0xb8, 0x3b, 0x34, 0x3b, 0x67, 0x99, 0x99, 0x4d, 0x93, 0x5e, 0xfa, 0x04, 0x3e, 0x86, 0x78,
+	0xe5, 0x45, 0x1f, 0xa2, 0x97, 0xa5, 0x20, 0x88, 0x48, 0x95, 0xf6, 0xc2, 0xd7, 0x90, 0x99, 0x9d,
+	0xa6, 0xa9, 0xe0, 0xef, 0xe2, 0x77, 0x93, 0xec, 0xf9, 0xbe, 0x73, 0xbe, 0x99, 0x73, 0xe6, 0x9c,
+	0x83, 0x3e, 0x92, 0x19, 0xe3, 0x57, 0xc0, 0xa9, 0xba, 0x04, 0x71, 0x11, 0x48, 0x28, 0x45, 0x4c,
+	0xb3, 0x72, 0x1c, 0x4c, 0x87, 0x41, 0x41, 0x04, 0xc9, 0xa5, 0x5f, 0x08, 0x50, 0x80, 0x7b, 0x2f,
+	0x1c, 0xfd, 0x85, 0xa3, 0x3f, 0x1d, 0xf6, 0x36, 0x48, 0xce, 0x38, 0x04, 0xe6, 0xb7, 0x72, 0xef,
+	0xf5, 0x63, 0x90, 0x39, 0xc8, 0x60, 0x4c, 0x24, 0x0d, 0xa6, 0xc3, 0x31, 0x55, 0x64, 0x18, 0xc4,
+	0xc0, 0xb8, 0xe5, 0xb7, 0x2b, 0x3e, 0x32, 0x56, 0x50, 0x19, 0x96, 0xda, 0x4a, 0x21, 0x85, 0x0a,
+	0xd7, 0x5f, 0x15, 0xba, 0xfb, 0x67, 0x1d, 0x35, 0xce, 0xcc, 0x85, 0xb0, 0x8f, 0x56, 0x49, 0x92,
+	0x33, 0xee, 0x3a, 0x03, 0xc7, 0x6b, 0x8d, 0xdc, 0xbb, 0xeb, 0xfd, 0x2d, 0xab, 0x70, 0x94, 0x24,
+	0x82, 0x4a, 0x79, 0xae, 0x04, 0xe3, 0x69, 0x58, 0xb9, 0xe1, 0xf7, 0x51, 0x37, 0x27, 0xb3, 0x68,
+	0x4c, 0x54, 0x9c, 0x45, 0x92, 0x5d, 0x51, 0xf7, 0x8d, 0x81, 0xe3, 0x75, 0xc2, 0xf5, 0x9c, 0xcc,
+	0x46, 0x1a, 0x3c, 0x67, 0x57, 0x14, 0xbf, 0x87, 0xb4, 0x1d, 0x11, 0xa5, 0x68, 0x5e, 0x28, 0xe9,
+	0xae, 0x18, 0x9f, 0x76, 0x4e, 0x66, 0x47, 0x16, 0xc2, 0x3e, 0xda, 0x24, 0xa5, 0x82, 0x48, 0x50,
+	0x28, 0x28, 0x8f, 0xe2, 0x8c, 0x70, 0x4e, 0x27, 0x6e, 0x7d, 0xe0, 0x78, 0xcd, 0x70, 0x43, 0x53,
+	0xa1, 0x61, 0x8e, 0x2b, 0x02, 0x07, 0x68, 0x13, 0x4a, 0x55, 0x80, 0x54, 0x51, 0x0c, 0x9c, 0xd3,
+	0x58, 0x31, 0xe0, 0xd2, 0x5d, 0x1d, 0xac, 0x78, 0xad, 0x10, 0x5b, 0xea, 0xf8, 0x99, 0xc1, 0xdf,
+	0xa0, 0xae, 0xa0, 0x53, 0xca, 0x4b, 0x1a, 0xc9, 0x8c, 0x08, 0x2a, 0xdd, 0xc6, 0xc0, 0xf1, 0xda,
+	0x07, 0x7b, 0xfe, 0xff, 0x57, 0xdf, 0x0f, 0xab, 0x88, 0x73, 0x13, 0x30, 0xaa, 0xdf, 0xdc, 0xef,
+	0xd4, 0xc2, 0x8e, 0x58, 0x06, 0x75, 0x6e, 0xb4, 0x80, 0x38, 0x8b, 0x26, 0x94, 0xa7, 0x2a, 0x73,
+	0xd7, 0x06, 0x8e, 0x57, 0x0f, 0xdb, 0x06, 0x3b, 0x35, 0x10, 0xfe, 0x1a, 0xad, 0x33, 0x9e, 0xd0,
+	0x19, 0x15, 0xd1, 0x18, 0x78, 0xe2, 0x36, 0xcd, 0xc1, 0x1f, 0xbe, 0xea, 0xe0, 0xcf, 0xb9, 0x62,
+	0x6a, 0x3e, 0x02, 0x9e, 0xd8, 0x53, 0xdb, 0x56, 0x41, 0x43, 0xf8, 0x4b, 0xd4, 0xca, 0x74, 0xe6,
+	0x46, 0xad, 0xf5, 0x1a, 0x6a, 0x4d, 0x1d, 0x6e, 0xa4, 0xf6, 0xd0, 0x5b, 0x25, 0xd7, 0x3a, 0x8c,
+	0xa7, 0x51, 0x41, 0x05, 0x83, 0xc4, 0x45, 0x26, 0x85, 0x37, 0x17, 0xf8, 0x99, 0x81, 0xf1, 0x21,
+	0x7a, 0x47, 0xbf, 0xa2, 0xa0, 0x29, 0x93, 0x4a, 0x10, 0x5d, 0xd6, 0xe8, 0x92, 0xf1, 0x04, 0x2e,
+	0xdd, 0xb6, 0x89, 0x78, 0x3b, 0x27, 0xb3, 0x70, 0x89, 0xfd, 0xd6, 0x90, 0xbb, 0xbf, 0x39, 0x08,
+	0x3d, 0xdf, 0x00, 0x67, 0xa8, 0x41, 0x72, 0x28, 0xb9, 0x72, 0x9d, 0xc1, 0x8a, 0xd7, 0x3e, 0xd8,
+	0xf6, 0x6d, 0x83, 0xe9, 0x7e, 0xf6, 0x6d, 0x3f, 0xfb, 0xc7, 0xc0, 0xf8, 0xe8, 0x33, 0x7d, 0xd9,
+	0x5f, 0xfe, 0xda, 0xf1, 0x52, 0xa6, 0x74, 0x2a, 0x31, 0xe4, 0xb6, 0x9f, 0xed, 0xdf, 0xbe, 0x4c,
+	0x2e, 0x02, 0x35, 0x2f, 0xa8, 0x34, 0x01, 0xf2, 0xe7, 0x7f, 0x7e, 0xfd, 0xd8, 0x09, 0xad, 0x3e,
+	0xfe, 0x1e, 0x75, 0xe5, 0x84, 0xc8, 0x2c, 0xfa, 0x41, 0x10, 0xd3, 0x05, 0xa6, 0x39, 0x5b, 0xa3,
+	0x43, 0x2d, 0xfb, 0xc7, 0xfd, 0xce, 0xbb, 0x95, 0x88, 0x4c, 0x2e, 0x7c, 0x06, 0x41, 0x4e, 0x54,
+	0xe6, 0x9f, 0xd2, 0x94, 0xc4, 0xf3, 0x13, 0x1a, 0xdf, 0x5d, 0xef, 0x23, 0x7b, 0xaf, 0x13, 0x1a,
+	0x57, 0xba, 0x1d, 0xa3, 0xf6, 0x85, 0x15, 0xdb, 0xfd, 0xd1, 0x41, 0x9d, 0x17, 0x0d, 0x82, 0x5d,
+	0xb4, 0x16, 0x0b, 0x4a, 0x14, 0x08, 0x33, 0x3f, 0x9d, 0xf0, 0xc9, 0xc4, 0x5b, 0x68, 0x55, 0x97,
+	0x5c, 0xda, 0xf1, 0xa8, 0x0c, 0xdc, 0x43, 0x4d, 0xfb, 0xac, 0x4f, 0x33, 0xb1, 0xb0, 0xf1, 0x07,
+	0xa8, 0x1b, 0x43, 0x9e, 0x97, 0x9c, 0xa9, 0x79, 0x54, 0x00, 0x54, 0xb3, 0xd0, 0x09, 0x3b, 0x0b,
+	0xf4, 0x0c, 0x60, 0x32, 0xfa, 0xea, 0xe6, 0xa1, 0xef, 0xdc, 0x3e, 0xf4, 0x9d, 0xbf, 0x1f, 0xfa,
+	0xce, 0x4f, 0x8f, 0xfd, 0xda, 0xed, 0x63, 0xbf, 0xf6, 0xfb, 0x63, 0xbf, 0xf6, 0xdd, 0xa7, 0x4b,
+	0x45, 0xfb, 0xcf, 0x26, 0x32, 0x96, 0xde, 0x44, 0xb3, 0xa5, 0xad, 0x64, 0xca, 0x38, 0x6e, 0x98,
+	0x95, 0xf0, 0xc9, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x33, 0x58, 0x42, 0x70, 0xbd, 0x04, 0x00,
+	0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -113,6 +375,80 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxRegistrationWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxRegistrationWindow))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.UnbondingPeriod != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.UnbondingPeriod))
+		i--
+		dAtA[i] = 0x50
+	}
+	{
+		size, err := m.HostBond.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size, err := m.IndexerBond.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.EpochLength != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.EpochLength))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size, err := m.RevenueShares.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.OutpostConnections) > 0 {
+		for iNdEx := len(m.OutpostConnections) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.OutpostConnections[iNdEx])
+			copy(dAtA[i:], m.OutpostConnections[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.OutpostConnections[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.AutoReopenChannel {
+		i--
+		if m.AutoReopenChannel {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxAttempts != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxAttempts))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxBatchSize != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxBatchSize))
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.Admin) > 0 {
 		i -= len(m.Admin)
 		copy(dAtA[i:], m.Admin)
@@ -123,6 +459,96 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EntityBond) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EntityBond) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EntityBond) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SlashFraction.Size()
+		i -= size
+		if _, err := m.SlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RevenueShares) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RevenueShares) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RevenueShares) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CommunityPool != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.CommunityPool))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Indexers != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Indexers))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Hosts != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Hosts))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Creator != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Creator))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParams(v)
 	base := offset
@@ -144,6 +570,74 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovParams(uint64(l))
 	}
+	if m.MaxBatchSize != 0 {
+		n += 1 + sovParams(uint64(m.MaxBatchSize))
+	}
+	if m.MaxAttempts != 0 {
+		n += 1 + sovParams(uint64(m.MaxAttempts))
+	}
+	if m.AutoReopenChannel {
+		n += 2
+	}
+	if len(m.OutpostConnections) > 0 {
+		for _, s := range m.OutpostConnections {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = m.RevenueShares.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.EpochLength != 0 {
+		n += 1 + sovParams(uint64(m.EpochLength))
+	}
+	l = m.IndexerBond.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.HostBond.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.UnbondingPeriod != 0 {
+		n += 1 + sovParams(uint64(m.UnbondingPeriod))
+	}
+	if m.MaxRegistrationWindow != 0 {
+		n += 1 + sovParams(uint64(m.MaxRegistrationWindow))
+	}
+	return n
+}
+
+func (m *EntityBond) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = m.SlashFraction.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *RevenueShares) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Creator != 0 {
+		n += 1 + sovParams(uint64(m.Creator))
+	}
+	if m.Hosts != 0 {
+		n += 1 + sovParams(uint64(m.Hosts))
+	}
+	if m.Indexers != 0 {
+		n += 1 + sovParams(uint64(m.Indexers))
+	}
+	if m.CommunityPool != 0 {
+		n += 1 + sovParams(uint64(m.CommunityPool))
+	}
 	return n
 }
 
@@ -214,6 +708,496 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.Admin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBatchSize", wireType)
+			}
+			m.MaxBatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBatchSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAttempts", wireType)
+			}
+			m.MaxAttempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAttempts |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoReopenChannel", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoReopenChannel = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutpostConnections", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutpostConnections = append(m.OutpostConnections, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevenueShares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RevenueShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochLength", wireType)
+			}
+			m.EpochLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexerBond", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.IndexerBond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostBond", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.HostBond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingPeriod", wireType)
+			}
+			m.UnbondingPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRegistrationWindow", wireType)
+			}
+			m.MaxRegistrationWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRegistrationWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EntityBond) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EntityBond: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EntityBond: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RevenueShares) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RevenueShares: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RevenueShares: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			m.Creator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Creator |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hosts", wireType)
+			}
+			m.Hosts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Hosts |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Indexers", wireType)
+			}
+			m.Indexers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Indexers |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+			}
+			m.CommunityPool = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommunityPool |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
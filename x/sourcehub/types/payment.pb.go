@@ -0,0 +1,973 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: shinzonetwork/sourcehub/v1/payment.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// StreamPrice is the price of access to a stream bought through
+// MsgBuyStreamAccess.
+type StreamPrice struct {
+	Resource Resource `protobuf:"varint,1,opt,name=resource,proto3,enum=shinzonetwork.sourcehub.v1.Resource" json:"resource,omitempty"`
+	StreamId string   `protobuf:"bytes,2,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	// Amount paid for one access period
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// Length in seconds of the access bought with one payment, 0 never expires
+	Duration uint64 `protobuf:"varint,4,opt,name=duration,proto3" json:"duration,omitempty"`
+	// Account that set the price
+	Setter string `protobuf:"bytes,5,opt,name=setter,proto3" json:"setter,omitempty"`
+}
+
+func (m *StreamPrice) Reset()         { *m = StreamPrice{} }
+func (m *StreamPrice) String() string { return proto.CompactTextString(m) }
+func (*StreamPrice) ProtoMessage()    {}
+func (*StreamPrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_056d884fbaf159e5, []int{0}
+}
+func (m *StreamPrice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StreamPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamPrice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StreamPrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamPrice.Merge(m, src)
+}
+func (m *StreamPrice) XXX_Size() int {
+	return m.Size()
+}
+func (m *StreamPrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamPrice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamPrice proto.InternalMessageInfo
+
+func (m *StreamPrice) GetResource() Resource {
+	if m != nil {
+		return m.Resource
+	}
+	return Resource_RESOURCE_PRIMITIVE
+}
+
+func (m *StreamPrice) GetStreamId() string {
+	if m != nil {
+		return m.StreamId
+	}
+	return ""
+}
+
+func (m *StreamPrice) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *StreamPrice) GetDuration() uint64 {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *StreamPrice) GetSetter() string {
+	if m != nil {
+		return m.Setter
+	}
+	return ""
+}
+
+// StreamPayment is a payment for stream access held in the module account
+// until SourceHub acknowledges the subscriber relationship it bought.
+type StreamPayment struct {
+	// Outbox command carrying the subscriber relationship
+	CommandId uint64   `protobuf:"varint,1,opt,name=command_id,json=commandId,proto3" json:"command_id,omitempty"`
+	Payer     string   `protobuf:"bytes,2,opt,name=payer,proto3" json:"payer,omitempty"`
+	Resource  Resource `protobuf:"varint,3,opt,name=resource,proto3,enum=shinzonetwork.sourcehub.v1.Resource" json:"resource,omitempty"`
+	StreamId  string   `protobuf:"bytes,4,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	// DID of the subscriber
+	Did    string                                   `protobuf:"bytes,5,opt,name=did,proto3" json:"did,omitempty"`
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// Block height the payment was made at
+	Height int64 `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *StreamPayment) Reset()         { *m = StreamPayment{} }
+func (m *StreamPayment) String() string { return proto.CompactTextString(m) }
+func (*StreamPayment) ProtoMessage()    {}
+func (*StreamPayment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_056d884fbaf159e5, []int{1}
+}
+func (m *StreamPayment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StreamPayment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamPayment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StreamPayment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamPayment.Merge(m, src)
+}
+func (m *StreamPayment) XXX_Size() int {
+	return m.Size()
+}
+func (m *StreamPayment) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamPayment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamPayment proto.InternalMessageInfo
+
+func (m *StreamPayment) GetCommandId() uint64 {
+	if m != nil {
+		return m.CommandId
+	}
+	return 0
+}
+
+func (m *StreamPayment) GetPayer() string {
+	if m != nil {
+		return m.Payer
+	}
+	return ""
+}
+
+func (m *StreamPayment) GetResource() Resource {
+	if m != nil {
+		return m.Resource
+	}
+	return Resource_RESOURCE_PRIMITIVE
+}
+
+func (m *StreamPayment) GetStreamId() string {
+	if m != nil {
+		return m.StreamId
+	}
+	return ""
+}
+
+func (m *StreamPayment) GetDid() string {
+	if m != nil {
+		return m.Did
+	}
+	return ""
+}
+
+func (m *StreamPayment) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *StreamPayment) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*StreamPrice)(nil), "shinzonetwork.sourcehub.v1.StreamPrice")
+	proto.RegisterType((*StreamPayment)(nil), "shinzonetwork.sourcehub.v1.StreamPayment")
+}
+
+func init() {
+	proto.RegisterFile("shinzonetwork/sourcehub/v1/payment.proto", fileDescriptor_056d884fbaf159e5)
+}
+
+var fileDescriptor_056d884fbaf159e5 = []byte{
+	// 468 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x52, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0xae, 0x9b, 0x2e, 0xb4, 0x9e, 0x40, 0x10, 0x4d, 0x28, 0x2b, 0x22, 0x8b, 0x06, 0x87, 0x08,
+	0x69, 0x36, 0x1d, 0x70, 0x87, 0x72, 0xda, 0x05, 0xa1, 0xec, 0xc6, 0x65, 0x72, 0x63, 0x2b, 0xb1,
+	0xa6, 0xf8, 0x55, 0xb6, 0x5b, 0x56, 0x7e, 0x05, 0x3f, 0x82, 0x03, 0xe2, 0xc4, 0x81, 0x1f, 0xb1,
+	0xe3, 0xe0, 0xc4, 0x09, 0x50, 0x7b, 0xe0, 0x6f, 0xa0, 0xc4, 0x56, 0xd5, 0x4d, 0x62, 0x17, 0xc4,
+	0x25, 0xf1, 0x7b, 0xef, 0xb3, 0xbf, 0xf7, 0x7d, 0xef, 0xe1, 0xcc, 0x54, 0x52, 0xbd, 0x03, 0x25,
+	0xec, 0x5b, 0xd0, 0xa7, 0xd4, 0xc0, 0x4c, 0x17, 0xa2, 0x9a, 0x4d, 0xe8, 0x7c, 0x44, 0xa7, 0x6c,
+	0x51, 0x0b, 0x65, 0xc9, 0x54, 0x83, 0x85, 0x68, 0x78, 0x09, 0x49, 0xd6, 0x48, 0x32, 0x1f, 0x0d,
+	0xef, 0xb0, 0x5a, 0x2a, 0xa0, 0xed, 0xd7, 0xc1, 0x87, 0x49, 0x01, 0xa6, 0x06, 0x43, 0x27, 0xcc,
+	0x08, 0x3a, 0x1f, 0x4d, 0x84, 0x65, 0x23, 0x5a, 0x80, 0x54, 0xbe, 0xbe, 0xeb, 0xea, 0x27, 0x6d,
+	0x44, 0x5d, 0xe0, 0x4b, 0x3b, 0x25, 0x94, 0xe0, 0xf2, 0xcd, 0xc9, 0x67, 0x1f, 0x5c, 0xd3, 0xa9,
+	0x3d, 0x73, 0xa0, 0xfd, 0x0f, 0x5d, 0xbc, 0x7d, 0x6c, 0xb5, 0x60, 0xf5, 0x6b, 0x2d, 0x0b, 0x11,
+	0x3d, 0xc7, 0x7d, 0x2d, 0x1c, 0x34, 0x46, 0x29, 0xca, 0x6e, 0x1d, 0x3e, 0x24, 0x7f, 0xd7, 0x41,
+	0x72, 0x8f, 0xcd, 0xd7, 0xb7, 0xa2, 0x7b, 0x78, 0x60, 0xda, 0x07, 0x4f, 0x24, 0x8f, 0xbb, 0x29,
+	0xca, 0x06, 0x79, 0xdf, 0x25, 0x8e, 0x78, 0x54, 0xe1, 0x90, 0xd5, 0x30, 0x53, 0x36, 0x0e, 0xd2,
+	0x20, 0xdb, 0x3e, 0xdc, 0x25, 0x5e, 0x48, 0xa3, 0x9a, 0x78, 0xd5, 0xe4, 0x25, 0x48, 0x35, 0x7e,
+	0x76, 0xfe, 0x63, 0xaf, 0xf3, 0xe9, 0xe7, 0x5e, 0x56, 0x4a, 0xdb, 0xb0, 0x15, 0x50, 0x7b, 0xd5,
+	0xfe, 0x77, 0x60, 0xf8, 0x29, 0xb5, 0x8b, 0xa9, 0x30, 0xed, 0x05, 0xf3, 0xf1, 0xf7, 0xe7, 0x47,
+	0x28, 0xf7, 0xef, 0x47, 0x43, 0xdc, 0xe7, 0x33, 0xcd, 0xac, 0x04, 0x15, 0xf7, 0x52, 0x94, 0xf5,
+	0xf2, 0x75, 0x1c, 0x3d, 0xc6, 0xa1, 0x11, 0xd6, 0x0a, 0x1d, 0x6f, 0x35, 0xfd, 0x8d, 0xe3, 0x6f,
+	0x5f, 0x0e, 0x76, 0x7c, 0x23, 0x2f, 0x38, 0xd7, 0xc2, 0x98, 0x63, 0xab, 0xa5, 0x2a, 0x73, 0x8f,
+	0xdb, 0xff, 0xda, 0xc5, 0x37, 0xbd, 0x4d, 0x6e, 0xc6, 0xd1, 0x7d, 0x8c, 0x0b, 0xa8, 0x6b, 0xa6,
+	0x78, 0xa3, 0x13, 0xb5, 0x0c, 0x03, 0x9f, 0x39, 0xe2, 0x11, 0xc1, 0x5b, 0x53, 0xb6, 0x10, 0xda,
+	0x39, 0x70, 0x0d, 0x83, 0x83, 0x5d, 0xf2, 0x3d, 0xf8, 0x77, 0xdf, 0x7b, 0x57, 0x7c, 0xbf, 0x8d,
+	0x03, 0x2e, 0xb9, 0x93, 0x9b, 0x37, 0xc7, 0x8d, 0x49, 0x84, 0xff, 0x79, 0x12, 0x77, 0x71, 0x58,
+	0x09, 0x59, 0x56, 0x36, 0xbe, 0x91, 0xa2, 0x2c, 0xc8, 0x7d, 0x34, 0x7e, 0x75, 0xbe, 0x4c, 0xd0,
+	0xc5, 0x32, 0x41, 0xbf, 0x96, 0x09, 0x7a, 0xbf, 0x4a, 0x3a, 0x17, 0xab, 0xa4, 0xf3, 0x7d, 0x95,
+	0x74, 0xde, 0x3c, 0xdd, 0x20, 0xba, 0xb2, 0xc4, 0x6d, 0xd4, 0x2c, 0xf1, 0xd9, 0xc6, 0x42, 0xb7,
+	0xd4, 0x93, 0xb0, 0xdd, 0xe8, 0x27, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0xd4, 0x20, 0x68, 0x55,
+	0xa2, 0x03, 0x00, 0x00,
+}
+
+func (m *StreamPrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamPrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Setter) > 0 {
+		i -= len(m.Setter)
+		copy(dAtA[i:], m.Setter)
+		i = encodeVarintPayment(dAtA, i, uint64(len(m.Setter)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Duration != 0 {
+		i = encodeVarintPayment(dAtA, i, uint64(m.Duration))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPayment(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.StreamId) > 0 {
+		i -= len(m.StreamId)
+		copy(dAtA[i:], m.StreamId)
+		i = encodeVarintPayment(dAtA, i, uint64(len(m.StreamId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Resource != 0 {
+		i = encodeVarintPayment(dAtA, i, uint64(m.Resource))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StreamPayment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamPayment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamPayment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintPayment(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPayment(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintPayment(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.StreamId) > 0 {
+		i -= len(m.StreamId)
+		copy(dAtA[i:], m.StreamId)
+		i = encodeVarintPayment(dAtA, i, uint64(len(m.StreamId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Resource != 0 {
+		i = encodeVarintPayment(dAtA, i, uint64(m.Resource))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Payer) > 0 {
+		i -= len(m.Payer)
+		copy(dAtA[i:], m.Payer)
+		i = encodeVarintPayment(dAtA, i, uint64(len(m.Payer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CommandId != 0 {
+		i = encodeVarintPayment(dAtA, i, uint64(m.CommandId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPayment(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPayment(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *StreamPrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Resource != 0 {
+		n += 1 + sovPayment(uint64(m.Resource))
+	}
+	l = len(m.StreamId)
+	if l > 0 {
+		n += 1 + l + sovPayment(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovPayment(uint64(l))
+		}
+	}
+	if m.Duration != 0 {
+		n += 1 + sovPayment(uint64(m.Duration))
+	}
+	l = len(m.Setter)
+	if l > 0 {
+		n += 1 + l + sovPayment(uint64(l))
+	}
+	return n
+}
+
+func (m *StreamPayment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CommandId != 0 {
+		n += 1 + sovPayment(uint64(m.CommandId))
+	}
+	l = len(m.Payer)
+	if l > 0 {
+		n += 1 + l + sovPayment(uint64(l))
+	}
+	if m.Resource != 0 {
+		n += 1 + sovPayment(uint64(m.Resource))
+	}
+	l = len(m.StreamId)
+	if l > 0 {
+		n += 1 + l + sovPayment(uint64(l))
+	}
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovPayment(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovPayment(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovPayment(uint64(m.Height))
+	}
+	return n
+}
+
+func sovPayment(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPayment(x uint64) (n int) {
+	return sovPayment(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *StreamPrice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPayment
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StreamPrice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StreamPrice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+			}
+			m.Resource = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Resource |= Resource(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPayment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StreamId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPayment
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			m.Duration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Duration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Setter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPayment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Setter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPayment(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPayment
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StreamPayment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPayment
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StreamPayment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StreamPayment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommandId", wireType)
+			}
+			m.CommandId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommandId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPayment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+			}
+			m.Resource = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Resource |= Resource(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPayment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StreamId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPayment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPayment
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPayment(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPayment
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPayment(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPayment
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPayment
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPayment
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPayment
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPayment
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPayment
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPayment        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPayment          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPayment = fmt.Errorf("proto: unexpected end of group")
+)
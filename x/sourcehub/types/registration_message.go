@@ -0,0 +1,73 @@
+package types
+
+import (
+	"crypto/sha256"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogoproto "github.com/cosmos/gogoproto/proto"
+)
+
+// RegistrationDomain separates entity registration signatures from any other
+// message the entity keys sign.
+const RegistrationDomain = "shinzohub/entity-registration/v1"
+
+// NewRegistrationMessage returns the registration message for the given
+// caller and role on chainId.
+func NewRegistrationMessage(chainId string, address sdk.AccAddress, role EntityRole, nonce uint64, expiryHeight int64) RegistrationMessage {
+	return RegistrationMessage{
+		Domain:       RegistrationDomain,
+		ChainId:      chainId,
+		Address:      address.String(),
+		Role:         role,
+		Nonce:        nonce,
+		ExpiryHeight: expiryHeight,
+	}
+}
+
+// GetSignBytes returns the bytes the entity keys sign.
+func (m RegistrationMessage) GetSignBytes() []byte {
+	bz, err := gogoproto.Marshal(&m)
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+// RegistrationMessageHash returns the hash a used registration message is
+// recorded under.
+func RegistrationMessageHash(message []byte) []byte {
+	h := sha256.Sum256(message)
+	return h[:]
+}
+
+// ParseRegistrationMessage decodes a signed registration message and checks
+// that it was issued for address and role on chainId, has not expired at
+// height and does not expire more than window blocks after it.
+func ParseRegistrationMessage(message []byte, chainId string, address sdk.AccAddress, role EntityRole, height int64, window uint64) (RegistrationMessage, error) {
+	var m RegistrationMessage
+	if err := gogoproto.Unmarshal(message, &m); err != nil {
+		return m, fmt.Errorf("invalid registration message: %w", err)
+	}
+
+	if m.Domain != RegistrationDomain {
+		return m, fmt.Errorf("invalid registration message domain %q, expected %q", m.Domain, RegistrationDomain)
+	}
+	if m.ChainId != chainId {
+		return m, fmt.Errorf("registration message is for chain %q, not %q", m.ChainId, chainId)
+	}
+	if m.Address != address.String() {
+		return m, fmt.Errorf("registration message is for address %s, not %s", m.Address, address)
+	}
+	if m.Role != role {
+		return m, fmt.Errorf("registration message is for role %s, not %s", m.Role, role)
+	}
+	if m.ExpiryHeight < height {
+		return m, fmt.Errorf("registration message expired at height %d", m.ExpiryHeight)
+	}
+	if uint64(m.ExpiryHeight-height) > window {
+		return m, fmt.Errorf("registration message expires at height %d, more than %d blocks after height %d", m.ExpiryHeight, window, height)
+	}
+
+	return m, nil
+}
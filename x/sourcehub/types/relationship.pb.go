@@ -0,0 +1,688 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: shinzonetwork/sourcehub/v1/relationship.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type RelationshipStatus int32
+
+const (
+	RelationshipStatus_RELATIONSHIP_STATUS_UNSPECIFIED RelationshipStatus = 0
+	// The command creating the relationship is awaiting acknowledgement
+	RelationshipStatus_RELATIONSHIP_STATUS_PENDING RelationshipStatus = 1
+	// SourceHub acknowledged the relationship
+	RelationshipStatus_RELATIONSHIP_STATUS_CONFIRMED RelationshipStatus = 2
+	// The command creating the relationship exhausted its attempts
+	RelationshipStatus_RELATIONSHIP_STATUS_FAILED RelationshipStatus = 3
+	// The command deleting the relationship is awaiting acknowledgement
+	RelationshipStatus_RELATIONSHIP_STATUS_DELETING RelationshipStatus = 4
+)
+
+var RelationshipStatus_name = map[int32]string{
+	0: "RELATIONSHIP_STATUS_UNSPECIFIED",
+	1: "RELATIONSHIP_STATUS_PENDING",
+	2: "RELATIONSHIP_STATUS_CONFIRMED",
+	3: "RELATIONSHIP_STATUS_FAILED",
+	4: "RELATIONSHIP_STATUS_DELETING",
+}
+
+var RelationshipStatus_value = map[string]int32{
+	"RELATIONSHIP_STATUS_UNSPECIFIED": 0,
+	"RELATIONSHIP_STATUS_PENDING":     1,
+	"RELATIONSHIP_STATUS_CONFIRMED":   2,
+	"RELATIONSHIP_STATUS_FAILED":      3,
+	"RELATIONSHIP_STATUS_DELETING":    4,
+}
+
+func (x RelationshipStatus) String() string {
+	return proto.EnumName(RelationshipStatus_name, int32(x))
+}
+
+func (RelationshipStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f29da2d635c69257, []int{0}
+}
+
+// Relationship mirrors an ACP relationship the module asked SourceHub to
+// create. Registering an object is mirrored as its owner relationship with
+// the module's interchain account.
+type Relationship struct {
+	Resource string `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	ObjectId string `protobuf:"bytes,2,opt,name=object_id,json=objectId,proto3" json:"object_id,omitempty"`
+	Relation string `protobuf:"bytes,3,opt,name=relation,proto3" json:"relation,omitempty"`
+	// Subject of the relationship: an actor ID such as a DID, <resource>:<id>
+	// for an object, <resource>:<id>#<relation> for an actor set and * for all
+	// actors
+	Actor  string             `protobuf:"bytes,4,opt,name=actor,proto3" json:"actor,omitempty"`
+	Status RelationshipStatus `protobuf:"varint,5,opt,name=status,proto3,enum=shinzonetwork.sourcehub.v1.RelationshipStatus" json:"status,omitempty"`
+	// Outbox command that last changed the relationship
+	CommandId uint64 `protobuf:"varint,6,opt,name=command_id,json=commandId,proto3" json:"command_id,omitempty"`
+	// Block height of the last status change
+	Height int64 `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
+	// Chain ID of the SourceHub target holding the relationship
+	Target string `protobuf:"bytes,8,opt,name=target,proto3" json:"target,omitempty"`
+}
+
+func (m *Relationship) Reset()         { *m = Relationship{} }
+func (m *Relationship) String() string { return proto.CompactTextString(m) }
+func (*Relationship) ProtoMessage()    {}
+func (*Relationship) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f29da2d635c69257, []int{0}
+}
+func (m *Relationship) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Relationship) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Relationship.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Relationship) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Relationship.Merge(m, src)
+}
+func (m *Relationship) XXX_Size() int {
+	return m.Size()
+}
+func (m *Relationship) XXX_DiscardUnknown() {
+	xxx_messageInfo_Relationship.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Relationship proto.InternalMessageInfo
+
+func (m *Relationship) GetResource() string {
+	if m != nil {
+		return m.Resource
+	}
+	return ""
+}
+
+func (m *Relationship) GetObjectId() string {
+	if m != nil {
+		return m.ObjectId
+	}
+	return ""
+}
+
+func (m *Relationship) GetRelation() string {
+	if m != nil {
+		return m.Relation
+	}
+	return ""
+}
+
+func (m *Relationship) GetActor() string {
+	if m != nil {
+		return m.Actor
+	}
+	return ""
+}
+
+func (m *Relationship) GetStatus() RelationshipStatus {
+	if m != nil {
+		return m.Status
+	}
+	return RelationshipStatus_RELATIONSHIP_STATUS_UNSPECIFIED
+}
+
+func (m *Relationship) GetCommandId() uint64 {
+	if m != nil {
+		return m.CommandId
+	}
+	return 0
+}
+
+func (m *Relationship) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *Relationship) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("shinzonetwork.sourcehub.v1.RelationshipStatus", RelationshipStatus_name, RelationshipStatus_value)
+	proto.RegisterType((*Relationship)(nil), "shinzonetwork.sourcehub.v1.Relationship")
+}
+
+func init() {
+	proto.RegisterFile("shinzonetwork/sourcehub/v1/relationship.proto", fileDescriptor_f29da2d635c69257)
+}
+
+var fileDescriptor_f29da2d635c69257 = []byte{
+	// 395 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xcd, 0xae, 0xd2, 0x40,
+	0x1c, 0xc5, 0x3b, 0x7c, 0x54, 0x98, 0x18, 0xd3, 0x4c, 0x8c, 0x69, 0x40, 0x4a, 0xd5, 0x4d, 0x63,
+	0x62, 0x1b, 0xd4, 0x17, 0x40, 0x3a, 0xd5, 0x49, 0xb0, 0x90, 0xb6, 0x6c, 0xdc, 0x90, 0xd2, 0x4e,
+	0x68, 0x55, 0x3a, 0xa4, 0x1d, 0xf0, 0xe3, 0x29, 0x78, 0x23, 0xb7, 0x2e, 0x59, 0xba, 0x34, 0xf0,
+	0x22, 0xa6, 0x1f, 0x12, 0xee, 0xbd, 0xbd, 0xcb, 0xf3, 0xff, 0xff, 0xce, 0xfc, 0x4f, 0x26, 0x07,
+	0xbe, 0xca, 0xa2, 0x38, 0xf9, 0xc9, 0x12, 0xca, 0xbf, 0xb1, 0xf4, 0x8b, 0x91, 0xb1, 0x5d, 0x1a,
+	0xd0, 0x68, 0xb7, 0x32, 0xf6, 0x23, 0x23, 0xa5, 0x5f, 0x7d, 0x1e, 0xb3, 0x24, 0x8b, 0xe2, 0xad,
+	0xbe, 0x4d, 0x19, 0x67, 0xa8, 0x77, 0x03, 0xd7, 0x2f, 0xb8, 0xbe, 0x1f, 0x3d, 0x3f, 0x34, 0xe0,
+	0x43, 0xe7, 0xca, 0x82, 0x7a, 0xb0, 0x93, 0xd2, 0x12, 0x91, 0x81, 0x0a, 0xb4, 0xae, 0x73, 0xd1,
+	0xa8, 0x0f, 0xbb, 0x6c, 0xf5, 0x99, 0x06, 0x7c, 0x19, 0x87, 0x72, 0xa3, 0x5c, 0x96, 0x03, 0x12,
+	0x96, 0xc6, 0xf2, 0x21, 0xb9, 0xf9, 0xdf, 0x58, 0x6a, 0xf4, 0x18, 0xb6, 0xfd, 0x80, 0xb3, 0x54,
+	0x6e, 0x15, 0x8b, 0x52, 0x20, 0x0b, 0x8a, 0x19, 0xf7, 0xf9, 0x2e, 0x93, 0xdb, 0x2a, 0xd0, 0x1e,
+	0xbd, 0xd6, 0xf5, 0xfb, 0x83, 0xea, 0xd7, 0x21, 0xdd, 0xc2, 0xe5, 0x54, 0x6e, 0x34, 0x80, 0x30,
+	0x60, 0x9b, 0x8d, 0x9f, 0x84, 0x79, 0x2e, 0x51, 0x05, 0x5a, 0xcb, 0xe9, 0x56, 0x13, 0x12, 0xa2,
+	0x27, 0x50, 0x8c, 0x68, 0xbc, 0x8e, 0xb8, 0xfc, 0x40, 0x05, 0x5a, 0xd3, 0xa9, 0x54, 0x3e, 0xe7,
+	0x7e, 0xba, 0xa6, 0x5c, 0xee, 0x14, 0xa9, 0x2a, 0xf5, 0xf2, 0x17, 0x80, 0xe8, 0xee, 0x35, 0xf4,
+	0x02, 0x0e, 0x1d, 0x3c, 0x1d, 0x7b, 0x64, 0x66, 0xbb, 0x1f, 0xc8, 0x7c, 0xe9, 0x7a, 0x63, 0x6f,
+	0xe1, 0x2e, 0x17, 0xb6, 0x3b, 0xc7, 0x13, 0x62, 0x11, 0x6c, 0x4a, 0x02, 0x1a, 0xc2, 0x7e, 0x1d,
+	0x34, 0xc7, 0xb6, 0x49, 0xec, 0xf7, 0x12, 0x40, 0xcf, 0xe0, 0xa0, 0x0e, 0x98, 0xcc, 0x6c, 0x8b,
+	0x38, 0x1f, 0xb1, 0x29, 0x35, 0x90, 0x02, 0x7b, 0x75, 0x88, 0x35, 0x26, 0x53, 0x6c, 0x4a, 0x4d,
+	0xa4, 0xc2, 0xa7, 0x75, 0x7b, 0x13, 0x4f, 0xb1, 0x97, 0x1f, 0x69, 0xbd, 0xb3, 0x7f, 0x9f, 0x14,
+	0x70, 0x3c, 0x29, 0xe0, 0xef, 0x49, 0x01, 0x87, 0xb3, 0x22, 0x1c, 0xcf, 0x8a, 0xf0, 0xe7, 0xac,
+	0x08, 0x9f, 0xde, 0xae, 0x63, 0x9e, 0x7f, 0x68, 0xc0, 0x36, 0xc6, 0xad, 0x12, 0x15, 0x2a, 0x2f,
+	0xd1, 0xf7, 0xab, 0x42, 0xf1, 0x1f, 0x5b, 0x9a, 0xad, 0xc4, 0xa2, 0x47, 0x6f, 0xfe, 0x05, 0x00,
+	0x00, 0xff, 0xff, 0x2b, 0x97, 0x0e, 0x88, 0x78, 0x02, 0x00, 0x00,
+}
+
+func (m *Relationship) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Relationship) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Relationship) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Target) > 0 {
+		i -= len(m.Target)
+		copy(dAtA[i:], m.Target)
+		i = encodeVarintRelationship(dAtA, i, uint64(len(m.Target)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Height != 0 {
+		i = encodeVarintRelationship(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.CommandId != 0 {
+		i = encodeVarintRelationship(dAtA, i, uint64(m.CommandId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Status != 0 {
+		i = encodeVarintRelationship(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Actor) > 0 {
+		i -= len(m.Actor)
+		copy(dAtA[i:], m.Actor)
+		i = encodeVarintRelationship(dAtA, i, uint64(len(m.Actor)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Relation) > 0 {
+		i -= len(m.Relation)
+		copy(dAtA[i:], m.Relation)
+		i = encodeVarintRelationship(dAtA, i, uint64(len(m.Relation)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ObjectId) > 0 {
+		i -= len(m.ObjectId)
+		copy(dAtA[i:], m.ObjectId)
+		i = encodeVarintRelationship(dAtA, i, uint64(len(m.ObjectId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Resource) > 0 {
+		i -= len(m.Resource)
+		copy(dAtA[i:], m.Resource)
+		i = encodeVarintRelationship(dAtA, i, uint64(len(m.Resource)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRelationship(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRelationship(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Relationship) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Resource)
+	if l > 0 {
+		n += 1 + l + sovRelationship(uint64(l))
+	}
+	l = len(m.ObjectId)
+	if l > 0 {
+		n += 1 + l + sovRelationship(uint64(l))
+	}
+	l = len(m.Relation)
+	if l > 0 {
+		n += 1 + l + sovRelationship(uint64(l))
+	}
+	l = len(m.Actor)
+	if l > 0 {
+		n += 1 + l + sovRelationship(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovRelationship(uint64(m.Status))
+	}
+	if m.CommandId != 0 {
+		n += 1 + sovRelationship(uint64(m.CommandId))
+	}
+	if m.Height != 0 {
+		n += 1 + sovRelationship(uint64(m.Height))
+	}
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovRelationship(uint64(l))
+	}
+	return n
+}
+
+func sovRelationship(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRelationship(x uint64) (n int) {
+	return sovRelationship(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Relationship) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRelationship
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Relationship: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Relationship: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelationship
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRelationship
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelationship
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resource = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelationship
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRelationship
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelationship
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObjectId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelationship
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRelationship
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelationship
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelationship
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRelationship
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelationship
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Actor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelationship
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= RelationshipStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommandId", wireType)
+			}
+			m.CommandId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelationship
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommandId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelationship
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelationship
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRelationship
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelationship
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Target = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRelationship(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRelationship
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRelationship(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRelationship
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRelationship
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRelationship
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRelationship
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRelationship
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRelationship
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRelationship        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRelationship          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRelationship = fmt.Errorf("proto: unexpected end of group")
+)
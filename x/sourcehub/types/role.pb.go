@@ -0,0 +1,401 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: shinzonetwork/sourcehub/v1/role.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ModuleRole is a permission to send a subset of the module messages. The
+// module admin and the governance authority hold every role implicitly.
+type ModuleRole int32
+
+const (
+	ModuleRole_MODULE_ROLE_UNSPECIFIED ModuleRole = 0
+	// Can register the ICA and the shinzohub policy on SourceHub
+	ModuleRole_MODULE_ROLE_POLICY_ADMIN ModuleRole = 1
+	// Can register shinzohub objects on SourceHub
+	ModuleRole_MODULE_ROLE_OBJECT_REGISTRAR ModuleRole = 2
+	// Can grant and renew stream access
+	ModuleRole_MODULE_ROLE_ACCESS_GRANTER ModuleRole = 3
+)
+
+var ModuleRole_name = map[int32]string{
+	0: "MODULE_ROLE_UNSPECIFIED",
+	1: "MODULE_ROLE_POLICY_ADMIN",
+	2: "MODULE_ROLE_OBJECT_REGISTRAR",
+	3: "MODULE_ROLE_ACCESS_GRANTER",
+}
+
+var ModuleRole_value = map[string]int32{
+	"MODULE_ROLE_UNSPECIFIED":      0,
+	"MODULE_ROLE_POLICY_ADMIN":     1,
+	"MODULE_ROLE_OBJECT_REGISTRAR": 2,
+	"MODULE_ROLE_ACCESS_GRANTER":   3,
+}
+
+func (x ModuleRole) String() string {
+	return proto.EnumName(ModuleRole_name, int32(x))
+}
+
+func (ModuleRole) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a935753cfcf10c3d, []int{0}
+}
+
+// RoleHolder assigns a module role to an account.
+type RoleHolder struct {
+	Role    ModuleRole `protobuf:"varint,1,opt,name=role,proto3,enum=shinzonetwork.sourcehub.v1.ModuleRole" json:"role,omitempty"`
+	Address string     `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *RoleHolder) Reset()         { *m = RoleHolder{} }
+func (m *RoleHolder) String() string { return proto.CompactTextString(m) }
+func (*RoleHolder) ProtoMessage()    {}
+func (*RoleHolder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a935753cfcf10c3d, []int{0}
+}
+func (m *RoleHolder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RoleHolder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RoleHolder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RoleHolder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoleHolder.Merge(m, src)
+}
+func (m *RoleHolder) XXX_Size() int {
+	return m.Size()
+}
+func (m *RoleHolder) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoleHolder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoleHolder proto.InternalMessageInfo
+
+func (m *RoleHolder) GetRole() ModuleRole {
+	if m != nil {
+		return m.Role
+	}
+	return ModuleRole_MODULE_ROLE_UNSPECIFIED
+}
+
+func (m *RoleHolder) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("shinzonetwork.sourcehub.v1.ModuleRole", ModuleRole_name, ModuleRole_value)
+	proto.RegisterType((*RoleHolder)(nil), "shinzonetwork.sourcehub.v1.RoleHolder")
+}
+
+func init() {
+	proto.RegisterFile("shinzonetwork/sourcehub/v1/role.proto", fileDescriptor_a935753cfcf10c3d)
+}
+
+var fileDescriptor_a935753cfcf10c3d = []byte{
+	// 331 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2d, 0xce, 0xc8, 0xcc,
+	0xab, 0xca, 0xcf, 0x4b, 0x2d, 0x29, 0xcf, 0x2f, 0xca, 0xd6, 0x2f, 0xce, 0x2f, 0x2d, 0x4a, 0x4e,
+	0xcd, 0x28, 0x4d, 0xd2, 0x2f, 0x33, 0xd4, 0x2f, 0xca, 0xcf, 0x49, 0xd5, 0x2b, 0x28, 0xca, 0x2f,
+	0xc9, 0x17, 0x92, 0x42, 0x51, 0xa6, 0x07, 0x57, 0xa6, 0x57, 0x66, 0x28, 0x25, 0x99, 0x9c, 0x5f,
+	0x9c, 0x9b, 0x5f, 0x1c, 0x0f, 0x56, 0xa9, 0x0f, 0xe1, 0x40, 0xb4, 0x29, 0xd5, 0x70, 0x71, 0x05,
+	0xe5, 0xe7, 0xa4, 0x7a, 0xe4, 0xe7, 0xa4, 0xa4, 0x16, 0x09, 0x59, 0x71, 0xb1, 0x80, 0x8c, 0x94,
+	0x60, 0x54, 0x60, 0xd4, 0xe0, 0x33, 0x52, 0xd3, 0xc3, 0x6d, 0xa6, 0x9e, 0x6f, 0x7e, 0x4a, 0x69,
+	0x4e, 0x2a, 0x48, 0x6f, 0x10, 0x58, 0x8f, 0x90, 0x11, 0x17, 0x7b, 0x62, 0x4a, 0x4a, 0x51, 0x6a,
+	0x71, 0xb1, 0x04, 0x93, 0x02, 0xa3, 0x06, 0xa7, 0x93, 0xc4, 0xa5, 0x2d, 0xba, 0x22, 0x50, 0xcb,
+	0x1c, 0x21, 0x32, 0xc1, 0x25, 0x45, 0x99, 0x79, 0xe9, 0x41, 0x30, 0x85, 0x5a, 0x9d, 0x8c, 0x5c,
+	0x5c, 0x08, 0x83, 0x84, 0xa4, 0xb9, 0xc4, 0x7d, 0xfd, 0x5d, 0x42, 0x7d, 0x5c, 0xe3, 0x83, 0xfc,
+	0x7d, 0x5c, 0xe3, 0x43, 0xfd, 0x82, 0x03, 0x5c, 0x9d, 0x3d, 0xdd, 0x3c, 0x5d, 0x5d, 0x04, 0x18,
+	0x84, 0x64, 0xb8, 0x24, 0x90, 0x25, 0x03, 0xfc, 0x7d, 0x3c, 0x9d, 0x23, 0xe3, 0x1d, 0x5d, 0x7c,
+	0x3d, 0xfd, 0x04, 0x18, 0x85, 0x14, 0xb8, 0x64, 0x90, 0x65, 0xfd, 0x9d, 0xbc, 0x5c, 0x9d, 0x43,
+	0xe2, 0x83, 0x5c, 0xdd, 0x3d, 0x83, 0x43, 0x82, 0x1c, 0x83, 0x04, 0x98, 0x84, 0xe4, 0xb8, 0xa4,
+	0x90, 0x55, 0x38, 0x3a, 0x3b, 0xbb, 0x06, 0x07, 0xc7, 0xbb, 0x07, 0x39, 0xfa, 0x85, 0xb8, 0x06,
+	0x09, 0x30, 0x3b, 0xf9, 0x9d, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72,
+	0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x49,
+	0x7a, 0x66, 0x09, 0xc8, 0xd7, 0xc9, 0xf9, 0xb9, 0xfa, 0x68, 0x91, 0x01, 0xe6, 0x81, 0x22, 0xa3,
+	0x02, 0x29, 0x62, 0x4a, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0x01, 0x6c, 0x0c, 0x08, 0x00,
+	0x00, 0xff, 0xff, 0xa9, 0x60, 0x41, 0x32, 0xc0, 0x01, 0x00, 0x00,
+}
+
+func (m *RoleHolder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RoleHolder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RoleHolder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintRole(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Role != 0 {
+		i = encodeVarintRole(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRole(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRole(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RoleHolder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Role != 0 {
+		n += 1 + sovRole(uint64(m.Role))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovRole(uint64(l))
+	}
+	return n
+}
+
+func sovRole(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRole(x uint64) (n int) {
+	return sovRole(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RoleHolder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRole
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RoleHolder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RoleHolder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRole
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= ModuleRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRole
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRole
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRole
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRole(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRole
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRole(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRole
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRole
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRole
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRole
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRole
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRole
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRole        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRole          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRole = fmt.Errorf("proto: unexpected end of group")
+)
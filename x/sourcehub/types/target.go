@@ -0,0 +1,71 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+const (
+	// LegacyTargetName names the single SourceHub link of v2 state and genesis
+	// files once it is migrated to a target.
+	LegacyTargetName = "sourcehub"
+
+	DefaultIcaVersion  = "ics27-1"
+	DefaultIcaEncoding = "proto3"
+	DefaultIcaTxType   = "sdk_multi_msg"
+)
+
+// NewSourcehubTarget returns a target on the given connections with the
+// default ICS-27 metadata.
+func NewSourcehubTarget(chainId, controllerConnectionId, hostConnectionId string) SourcehubTarget {
+	return SourcehubTarget{
+		ChainId:                chainId,
+		ControllerConnectionId: controllerConnectionId,
+		HostConnectionId:       hostConnectionId,
+		Version:                DefaultIcaVersion,
+		Encoding:               DefaultIcaEncoding,
+		TxType:                 DefaultIcaTxType,
+	}
+}
+
+// IcaMetadata returns the ICS-27 metadata used to open the target's channel.
+func (t SourcehubTarget) IcaMetadata() string {
+	meta := map[string]string{
+		"version":                  t.Version,
+		"controller_connection_id": t.ControllerConnectionId,
+		"host_connection_id":       t.HostConnectionId,
+		"encoding":                 t.Encoding,
+		"tx_type":                  t.TxType,
+	}
+
+	bz, err := json.Marshal(meta)
+	if err != nil {
+		return ""
+	}
+
+	return string(bz)
+}
+
+// Validate checks that the target names a chain and a controller connection.
+func (t SourcehubTarget) Validate() error {
+	if t.ChainId == "" {
+		return fmt.Errorf("SourceHub target must have a chain ID")
+	}
+	if t.ControllerConnectionId == "" {
+		return fmt.Errorf("SourceHub target %s has no controller connection ID", t.ChainId)
+	}
+	if _, ok := IcaChannelState_name[int32(t.Channel.State)]; !ok {
+		return fmt.Errorf("SourceHub target %s has invalid ICA channel state %d", t.ChainId, t.Channel.State)
+	}
+	if t.Channel.State == IcaChannelState_ICA_CHANNEL_STATE_OPEN && t.Channel.ChannelId == "" {
+		return fmt.Errorf("SourceHub target %s has an open ICA channel without channel ID", t.ChainId)
+	}
+	return nil
+}
+
+// ChannelUsable reports whether packets can be sent to the target. A channel
+// opened before the module tracked its state is assumed to be open.
+func (t SourcehubTarget) ChannelUsable() bool {
+	state := t.Channel.State
+	return state == IcaChannelState_ICA_CHANNEL_STATE_UNSPECIFIED || state == IcaChannelState_ICA_CHANNEL_STATE_OPEN
+}
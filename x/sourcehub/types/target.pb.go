@@ -0,0 +1,737 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: shinzonetwork/sourcehub/v1/target.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SourcehubTarget is a SourceHub chain the module controls an interchain
+// account on.
+type SourcehubTarget struct {
+	// Chain ID of the SourceHub chain, names the target
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// IBC controller connection to use
+	ControllerConnectionId string `protobuf:"bytes,2,opt,name=controller_connection_id,json=controllerConnectionId,proto3" json:"controller_connection_id,omitempty"`
+	// IBC host connection to use
+	HostConnectionId string `protobuf:"bytes,3,opt,name=host_connection_id,json=hostConnectionId,proto3" json:"host_connection_id,omitempty"`
+	// ICS-27 version identifier
+	Version string `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
+	// Encoding type (e.g., "proto3")
+	Encoding string `protobuf:"bytes,5,opt,name=encoding,proto3" json:"encoding,omitempty"`
+	// Transaction type (e.g., "sdk_multi_msg")
+	TxType string `protobuf:"bytes,6,opt,name=tx_type,json=txType,proto3" json:"tx_type,omitempty"`
+	// Policy ID for shinzohub on the target
+	PolicyId string `protobuf:"bytes,7,opt,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty"`
+	// State of the ICA channel to the target
+	Channel IcaChannel `protobuf:"bytes,8,opt,name=channel,proto3" json:"channel"`
+	// Version of the shinzohub policy SourceHub last acknowledged for the
+	// target's policy ID
+	PolicyVersion uint64 `protobuf:"varint,9,opt,name=policy_version,json=policyVersion,proto3" json:"policy_version,omitempty"`
+}
+
+func (m *SourcehubTarget) Reset()         { *m = SourcehubTarget{} }
+func (m *SourcehubTarget) String() string { return proto.CompactTextString(m) }
+func (*SourcehubTarget) ProtoMessage()    {}
+func (*SourcehubTarget) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b517feb817674875, []int{0}
+}
+func (m *SourcehubTarget) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SourcehubTarget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SourcehubTarget.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SourcehubTarget) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SourcehubTarget.Merge(m, src)
+}
+func (m *SourcehubTarget) XXX_Size() int {
+	return m.Size()
+}
+func (m *SourcehubTarget) XXX_DiscardUnknown() {
+	xxx_messageInfo_SourcehubTarget.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SourcehubTarget proto.InternalMessageInfo
+
+func (m *SourcehubTarget) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *SourcehubTarget) GetControllerConnectionId() string {
+	if m != nil {
+		return m.ControllerConnectionId
+	}
+	return ""
+}
+
+func (m *SourcehubTarget) GetHostConnectionId() string {
+	if m != nil {
+		return m.HostConnectionId
+	}
+	return ""
+}
+
+func (m *SourcehubTarget) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *SourcehubTarget) GetEncoding() string {
+	if m != nil {
+		return m.Encoding
+	}
+	return ""
+}
+
+func (m *SourcehubTarget) GetTxType() string {
+	if m != nil {
+		return m.TxType
+	}
+	return ""
+}
+
+func (m *SourcehubTarget) GetPolicyId() string {
+	if m != nil {
+		return m.PolicyId
+	}
+	return ""
+}
+
+func (m *SourcehubTarget) GetChannel() IcaChannel {
+	if m != nil {
+		return m.Channel
+	}
+	return IcaChannel{}
+}
+
+func (m *SourcehubTarget) GetPolicyVersion() uint64 {
+	if m != nil {
+		return m.PolicyVersion
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*SourcehubTarget)(nil), "shinzonetwork.sourcehub.v1.SourcehubTarget")
+}
+
+func init() {
+	proto.RegisterFile("shinzonetwork/sourcehub/v1/target.proto", fileDescriptor_b517feb817674875)
+}
+
+var fileDescriptor_b517feb817674875 = []byte{
+	// 369 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xcf, 0x4e, 0xf2, 0x40,
+	0x14, 0xc5, 0x5b, 0x3e, 0x3e, 0x0a, 0x63, 0xfc, 0x93, 0x89, 0xd1, 0x5a, 0x93, 0x4a, 0x4c, 0xd4,
+	0x2e, 0x4c, 0x1b, 0xd4, 0x85, 0x6b, 0x48, 0x4c, 0xba, 0x71, 0x81, 0xc4, 0x85, 0x1b, 0x52, 0xa6,
+	0x93, 0x76, 0x62, 0x9d, 0xdb, 0xb4, 0x03, 0x82, 0x4f, 0xe1, 0x63, 0xb1, 0x64, 0xe9, 0xca, 0x18,
+	0x78, 0x00, 0x5f, 0xc1, 0x74, 0x06, 0x50, 0x48, 0x74, 0xd7, 0x7b, 0xcf, 0xef, 0xf4, 0xcc, 0xcc,
+	0x41, 0x67, 0x79, 0xcc, 0xf8, 0x0b, 0x70, 0x2a, 0x9e, 0x21, 0x7b, 0xf4, 0x72, 0xe8, 0x67, 0x84,
+	0xc6, 0xfd, 0x9e, 0x37, 0x68, 0x78, 0x22, 0xc8, 0x22, 0x2a, 0xdc, 0x34, 0x03, 0x01, 0xd8, 0x5a,
+	0x01, 0xdd, 0x25, 0xe8, 0x0e, 0x1a, 0xd6, 0x6e, 0x04, 0x11, 0x48, 0xcc, 0x2b, 0xbe, 0x94, 0xc3,
+	0x72, 0xfe, 0xf8, 0x35, 0x89, 0x03, 0xce, 0x69, 0xa2, 0xc8, 0xe3, 0xcf, 0x12, 0xda, 0xbe, 0x5b,
+	0xc8, 0x1d, 0x99, 0x8a, 0x0f, 0x50, 0x95, 0xc4, 0x01, 0xe3, 0x5d, 0x16, 0x9a, 0x7a, 0x5d, 0x77,
+	0x6a, 0x6d, 0x43, 0xce, 0x7e, 0x88, 0xaf, 0x91, 0x49, 0x80, 0x8b, 0x0c, 0x92, 0x84, 0x66, 0x5d,
+	0x02, 0x9c, 0x53, 0x22, 0x18, 0x48, 0xb4, 0x24, 0xd1, 0xbd, 0x6f, 0xbd, 0xb5, 0x94, 0xfd, 0x10,
+	0x9f, 0x23, 0x1c, 0x43, 0x2e, 0xd6, 0x3c, 0xff, 0xa4, 0x67, 0xa7, 0x50, 0x56, 0x68, 0x13, 0x19,
+	0x03, 0x9a, 0xe5, 0x0c, 0xb8, 0x59, 0x56, 0x27, 0x98, 0x8f, 0xd8, 0x42, 0x55, 0xca, 0x09, 0x84,
+	0x8c, 0x47, 0xe6, 0x7f, 0x29, 0x2d, 0x67, 0xbc, 0x8f, 0x0c, 0x31, 0xec, 0x8a, 0x51, 0x4a, 0xcd,
+	0x8a, 0x94, 0x2a, 0x62, 0xd8, 0x19, 0xa5, 0x14, 0x1f, 0xa2, 0x5a, 0x0a, 0x09, 0x23, 0xa3, 0x22,
+	0xd3, 0x50, 0x2e, 0xb5, 0xf0, 0x43, 0x7c, 0x83, 0x8c, 0xf9, 0x9b, 0x98, 0xd5, 0xba, 0xee, 0x6c,
+	0x5c, 0x9c, 0xba, 0xbf, 0x3f, 0xb8, 0xeb, 0x93, 0xa0, 0xa5, 0xe8, 0x66, 0x79, 0xfc, 0x7e, 0xa4,
+	0xb5, 0x17, 0x66, 0x7c, 0x82, 0xb6, 0xe6, 0x21, 0x8b, 0xa3, 0xd7, 0xea, 0xba, 0x53, 0x6e, 0x6f,
+	0xaa, 0xed, 0xbd, 0x5a, 0x36, 0x6f, 0xc7, 0x53, 0x5b, 0x9f, 0x4c, 0x6d, 0xfd, 0x63, 0x6a, 0xeb,
+	0xaf, 0x33, 0x5b, 0x9b, 0xcc, 0x6c, 0xed, 0x6d, 0x66, 0x6b, 0x0f, 0x57, 0x11, 0x13, 0x45, 0x0a,
+	0x81, 0x27, 0x6f, 0xad, 0x40, 0x39, 0x15, 0x05, 0x0e, 0x7f, 0x94, 0x59, 0x5c, 0x34, 0xef, 0x55,
+	0x64, 0x91, 0x97, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x7c, 0x07, 0xd7, 0x39, 0x4f, 0x02, 0x00,
+	0x00,
+}
+
+func (m *SourcehubTarget) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SourcehubTarget) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SourcehubTarget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PolicyVersion != 0 {
+		i = encodeVarintTarget(dAtA, i, uint64(m.PolicyVersion))
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size, err := m.Channel.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTarget(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if len(m.PolicyId) > 0 {
+		i -= len(m.PolicyId)
+		copy(dAtA[i:], m.PolicyId)
+		i = encodeVarintTarget(dAtA, i, uint64(len(m.PolicyId)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.TxType) > 0 {
+		i -= len(m.TxType)
+		copy(dAtA[i:], m.TxType)
+		i = encodeVarintTarget(dAtA, i, uint64(len(m.TxType)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Encoding) > 0 {
+		i -= len(m.Encoding)
+		copy(dAtA[i:], m.Encoding)
+		i = encodeVarintTarget(dAtA, i, uint64(len(m.Encoding)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintTarget(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.HostConnectionId) > 0 {
+		i -= len(m.HostConnectionId)
+		copy(dAtA[i:], m.HostConnectionId)
+		i = encodeVarintTarget(dAtA, i, uint64(len(m.HostConnectionId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ControllerConnectionId) > 0 {
+		i -= len(m.ControllerConnectionId)
+		copy(dAtA[i:], m.ControllerConnectionId)
+		i = encodeVarintTarget(dAtA, i, uint64(len(m.ControllerConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTarget(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTarget(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTarget(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SourcehubTarget) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTarget(uint64(l))
+	}
+	l = len(m.ControllerConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTarget(uint64(l))
+	}
+	l = len(m.HostConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTarget(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovTarget(uint64(l))
+	}
+	l = len(m.Encoding)
+	if l > 0 {
+		n += 1 + l + sovTarget(uint64(l))
+	}
+	l = len(m.TxType)
+	if l > 0 {
+		n += 1 + l + sovTarget(uint64(l))
+	}
+	l = len(m.PolicyId)
+	if l > 0 {
+		n += 1 + l + sovTarget(uint64(l))
+	}
+	l = m.Channel.Size()
+	n += 1 + l + sovTarget(uint64(l))
+	if m.PolicyVersion != 0 {
+		n += 1 + sovTarget(uint64(m.PolicyVersion))
+	}
+	return n
+}
+
+func sovTarget(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTarget(x uint64) (n int) {
+	return sovTarget(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SourcehubTarget) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTarget
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SourcehubTarget: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SourcehubTarget: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTarget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTarget
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTarget
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ControllerConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTarget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTarget
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTarget
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ControllerConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTarget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTarget
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTarget
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTarget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTarget
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTarget
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Encoding", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTarget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTarget
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTarget
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Encoding = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTarget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTarget
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTarget
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTarget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTarget
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTarget
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PolicyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTarget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTarget
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTarget
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Channel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyVersion", wireType)
+			}
+			m.PolicyVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTarget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PolicyVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTarget(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTarget
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTarget(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTarget
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTarget
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTarget
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTarget
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTarget
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTarget
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTarget        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTarget          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTarget = fmt.Errorf("proto: unexpected end of group")
+)